@@ -0,0 +1,4029 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.3
+// source: wallets.private.proto
+
+package __
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Provider int32
+
+const (
+	Provider_PROVIDER_UNDEFINED       Provider = 0
+	Provider_PROVIDER_PHANTOM         Provider = 1
+	Provider_PROVIDER_STELLAR         Provider = 2
+	Provider_PROVIDER_COSMOS          Provider = 3
+	Provider_PROVIDER_APTOS           Provider = 4
+	Provider_PROVIDER_SUI             Provider = 5
+	Provider_PROVIDER_SOLFLARE        Provider = 6
+	Provider_PROVIDER_BACKPACK        Provider = 7
+	Provider_PROVIDER_GLOW            Provider = 8
+	Provider_PROVIDER_LEDGER          Provider = 9
+	Provider_PROVIDER_WALLET_STANDARD Provider = 10
+)
+
+// Enum value maps for Provider.
+var (
+	Provider_name = map[int32]string{
+		0:  "PROVIDER_UNDEFINED",
+		1:  "PROVIDER_PHANTOM",
+		2:  "PROVIDER_STELLAR",
+		3:  "PROVIDER_COSMOS",
+		4:  "PROVIDER_APTOS",
+		5:  "PROVIDER_SUI",
+		6:  "PROVIDER_SOLFLARE",
+		7:  "PROVIDER_BACKPACK",
+		8:  "PROVIDER_GLOW",
+		9:  "PROVIDER_LEDGER",
+		10: "PROVIDER_WALLET_STANDARD",
+	}
+	Provider_value = map[string]int32{
+		"PROVIDER_UNDEFINED":       0,
+		"PROVIDER_PHANTOM":         1,
+		"PROVIDER_STELLAR":         2,
+		"PROVIDER_COSMOS":          3,
+		"PROVIDER_APTOS":           4,
+		"PROVIDER_SUI":             5,
+		"PROVIDER_SOLFLARE":        6,
+		"PROVIDER_BACKPACK":        7,
+		"PROVIDER_GLOW":            8,
+		"PROVIDER_LEDGER":          9,
+		"PROVIDER_WALLET_STANDARD": 10,
+	}
+)
+
+func (x Provider) Enum() *Provider {
+	p := new(Provider)
+	*p = x
+	return p
+}
+
+func (x Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallets_private_proto_enumTypes[0].Descriptor()
+}
+
+func (Provider) Type() protoreflect.EnumType {
+	return &file_wallets_private_proto_enumTypes[0]
+}
+
+func (x Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Provider.Descriptor instead.
+func (Provider) EnumDescriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{0}
+}
+
+type VerificationStatus int32
+
+const (
+	VerificationStatus_VERIFICATION_STATUS_UNDEFINED     VerificationStatus = 0
+	VerificationStatus_VERIFICATION_STATUS_UNVERIFIED    VerificationStatus = 1
+	VerificationStatus_VERIFICATION_STATUS_VERIFIED      VerificationStatus = 2
+	VerificationStatus_VERIFICATION_STATUS_EXPIRING_SOON VerificationStatus = 3
+	VerificationStatus_VERIFICATION_STATUS_EXPIRED       VerificationStatus = 4
+)
+
+// Enum value maps for VerificationStatus.
+var (
+	VerificationStatus_name = map[int32]string{
+		0: "VERIFICATION_STATUS_UNDEFINED",
+		1: "VERIFICATION_STATUS_UNVERIFIED",
+		2: "VERIFICATION_STATUS_VERIFIED",
+		3: "VERIFICATION_STATUS_EXPIRING_SOON",
+		4: "VERIFICATION_STATUS_EXPIRED",
+	}
+	VerificationStatus_value = map[string]int32{
+		"VERIFICATION_STATUS_UNDEFINED":     0,
+		"VERIFICATION_STATUS_UNVERIFIED":    1,
+		"VERIFICATION_STATUS_VERIFIED":      2,
+		"VERIFICATION_STATUS_EXPIRING_SOON": 3,
+		"VERIFICATION_STATUS_EXPIRED":       4,
+	}
+)
+
+func (x VerificationStatus) Enum() *VerificationStatus {
+	p := new(VerificationStatus)
+	*p = x
+	return p
+}
+
+func (x VerificationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VerificationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallets_private_proto_enumTypes[1].Descriptor()
+}
+
+func (VerificationStatus) Type() protoreflect.EnumType {
+	return &file_wallets_private_proto_enumTypes[1]
+}
+
+func (x VerificationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VerificationStatus.Descriptor instead.
+func (VerificationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{1}
+}
+
+type WalletChangeType int32
+
+const (
+	WalletChangeType_WALLET_CHANGE_TYPE_UNDEFINED    WalletChangeType = 0
+	WalletChangeType_WALLET_CHANGE_TYPE_SNAPSHOT     WalletChangeType = 1
+	WalletChangeType_WALLET_CHANGE_TYPE_SNAPSHOT_END WalletChangeType = 2
+	WalletChangeType_WALLET_CHANGE_TYPE_ADDED        WalletChangeType = 3
+	WalletChangeType_WALLET_CHANGE_TYPE_VERIFIED     WalletChangeType = 4
+	WalletChangeType_WALLET_CHANGE_TYPE_UNLINKED     WalletChangeType = 5
+)
+
+// Enum value maps for WalletChangeType.
+var (
+	WalletChangeType_name = map[int32]string{
+		0: "WALLET_CHANGE_TYPE_UNDEFINED",
+		1: "WALLET_CHANGE_TYPE_SNAPSHOT",
+		2: "WALLET_CHANGE_TYPE_SNAPSHOT_END",
+		3: "WALLET_CHANGE_TYPE_ADDED",
+		4: "WALLET_CHANGE_TYPE_VERIFIED",
+		5: "WALLET_CHANGE_TYPE_UNLINKED",
+	}
+	WalletChangeType_value = map[string]int32{
+		"WALLET_CHANGE_TYPE_UNDEFINED":    0,
+		"WALLET_CHANGE_TYPE_SNAPSHOT":     1,
+		"WALLET_CHANGE_TYPE_SNAPSHOT_END": 2,
+		"WALLET_CHANGE_TYPE_ADDED":        3,
+		"WALLET_CHANGE_TYPE_VERIFIED":     4,
+		"WALLET_CHANGE_TYPE_UNLINKED":     5,
+	}
+)
+
+func (x WalletChangeType) Enum() *WalletChangeType {
+	p := new(WalletChangeType)
+	*p = x
+	return p
+}
+
+func (x WalletChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WalletChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallets_private_proto_enumTypes[2].Descriptor()
+}
+
+func (WalletChangeType) Type() protoreflect.EnumType {
+	return &file_wallets_private_proto_enumTypes[2]
+}
+
+func (x WalletChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WalletChangeType.Descriptor instead.
+func (WalletChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{2}
+}
+
+type SnapshotFormat int32
+
+const (
+	SnapshotFormat_SNAPSHOT_FORMAT_UNDEFINED  SnapshotFormat = 0
+	SnapshotFormat_SNAPSHOT_FORMAT_CSV        SnapshotFormat = 1
+	SnapshotFormat_SNAPSHOT_FORMAT_JSON_LINES SnapshotFormat = 2
+)
+
+// Enum value maps for SnapshotFormat.
+var (
+	SnapshotFormat_name = map[int32]string{
+		0: "SNAPSHOT_FORMAT_UNDEFINED",
+		1: "SNAPSHOT_FORMAT_CSV",
+		2: "SNAPSHOT_FORMAT_JSON_LINES",
+	}
+	SnapshotFormat_value = map[string]int32{
+		"SNAPSHOT_FORMAT_UNDEFINED":  0,
+		"SNAPSHOT_FORMAT_CSV":        1,
+		"SNAPSHOT_FORMAT_JSON_LINES": 2,
+	}
+)
+
+func (x SnapshotFormat) Enum() *SnapshotFormat {
+	p := new(SnapshotFormat)
+	*p = x
+	return p
+}
+
+func (x SnapshotFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SnapshotFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallets_private_proto_enumTypes[3].Descriptor()
+}
+
+func (SnapshotFormat) Type() protoreflect.EnumType {
+	return &file_wallets_private_proto_enumTypes[3]
+}
+
+func (x SnapshotFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SnapshotFormat.Descriptor instead.
+func (SnapshotFormat) EnumDescriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{3}
+}
+
+type MerkleHash int32
+
+const (
+	MerkleHash_MERKLE_HASH_UNDEFINED MerkleHash = 0
+	// keccak256 is the legacy Keccak-256 of the EVM.
+	MerkleHash_MERKLE_HASH_KECCAK256 MerkleHash = 1
+	MerkleHash_MERKLE_HASH_SHA256    MerkleHash = 2
+)
+
+// Enum value maps for MerkleHash.
+var (
+	MerkleHash_name = map[int32]string{
+		0: "MERKLE_HASH_UNDEFINED",
+		1: "MERKLE_HASH_KECCAK256",
+		2: "MERKLE_HASH_SHA256",
+	}
+	MerkleHash_value = map[string]int32{
+		"MERKLE_HASH_UNDEFINED": 0,
+		"MERKLE_HASH_KECCAK256": 1,
+		"MERKLE_HASH_SHA256":    2,
+	}
+)
+
+func (x MerkleHash) Enum() *MerkleHash {
+	p := new(MerkleHash)
+	*p = x
+	return p
+}
+
+func (x MerkleHash) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MerkleHash) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallets_private_proto_enumTypes[4].Descriptor()
+}
+
+func (MerkleHash) Type() protoreflect.EnumType {
+	return &file_wallets_private_proto_enumTypes[4]
+}
+
+func (x MerkleHash) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MerkleHash.Descriptor instead.
+func (MerkleHash) EnumDescriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{4}
+}
+
+type LeafEncoding int32
+
+const (
+	LeafEncoding_LEAF_ENCODING_UNDEFINED LeafEncoding = 0
+	// The leaf is the hash of the raw address bytes.
+	LeafEncoding_LEAF_ENCODING_ADDRESS LeafEncoding = 1
+	// The leaf is the hash of the address followed by the amount as a little-endian uint64.
+	LeafEncoding_LEAF_ENCODING_ADDRESS_AMOUNT_U64LE LeafEncoding = 2
+	// The leaf is the hash of the address followed by the amount as a big-endian uint256.
+	LeafEncoding_LEAF_ENCODING_ADDRESS_AMOUNT_U256BE LeafEncoding = 3
+)
+
+// Enum value maps for LeafEncoding.
+var (
+	LeafEncoding_name = map[int32]string{
+		0: "LEAF_ENCODING_UNDEFINED",
+		1: "LEAF_ENCODING_ADDRESS",
+		2: "LEAF_ENCODING_ADDRESS_AMOUNT_U64LE",
+		3: "LEAF_ENCODING_ADDRESS_AMOUNT_U256BE",
+	}
+	LeafEncoding_value = map[string]int32{
+		"LEAF_ENCODING_UNDEFINED":             0,
+		"LEAF_ENCODING_ADDRESS":               1,
+		"LEAF_ENCODING_ADDRESS_AMOUNT_U64LE":  2,
+		"LEAF_ENCODING_ADDRESS_AMOUNT_U256BE": 3,
+	}
+)
+
+func (x LeafEncoding) Enum() *LeafEncoding {
+	p := new(LeafEncoding)
+	*p = x
+	return p
+}
+
+func (x LeafEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeafEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallets_private_proto_enumTypes[5].Descriptor()
+}
+
+func (LeafEncoding) Type() protoreflect.EnumType {
+	return &file_wallets_private_proto_enumTypes[5]
+}
+
+func (x LeafEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeafEncoding.Descriptor instead.
+func (LeafEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{5}
+}
+
+type MessageFormat int32
+
+const (
+	MessageFormat_MESSAGE_FORMAT_NATIVE          MessageFormat = 0
+	MessageFormat_MESSAGE_FORMAT_TEXT            MessageFormat = 1
+	MessageFormat_MESSAGE_FORMAT_SIWS            MessageFormat = 2
+	MessageFormat_MESSAGE_FORMAT_SOLANA_OFFCHAIN MessageFormat = 3
+)
+
+// Enum value maps for MessageFormat.
+var (
+	MessageFormat_name = map[int32]string{
+		0: "MESSAGE_FORMAT_NATIVE",
+		1: "MESSAGE_FORMAT_TEXT",
+		2: "MESSAGE_FORMAT_SIWS",
+		3: "MESSAGE_FORMAT_SOLANA_OFFCHAIN",
+	}
+	MessageFormat_value = map[string]int32{
+		"MESSAGE_FORMAT_NATIVE":          0,
+		"MESSAGE_FORMAT_TEXT":            1,
+		"MESSAGE_FORMAT_SIWS":            2,
+		"MESSAGE_FORMAT_SOLANA_OFFCHAIN": 3,
+	}
+)
+
+func (x MessageFormat) Enum() *MessageFormat {
+	p := new(MessageFormat)
+	*p = x
+	return p
+}
+
+func (x MessageFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallets_private_proto_enumTypes[6].Descriptor()
+}
+
+func (MessageFormat) Type() protoreflect.EnumType {
+	return &file_wallets_private_proto_enumTypes[6]
+}
+
+func (x MessageFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageFormat.Descriptor instead.
+func (MessageFormat) EnumDescriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{6}
+}
+
+type WalletEventType int32
+
+const (
+	WalletEventType_WALLET_EVENT_TYPE_UNDEFINED WalletEventType = 0
+	WalletEventType_WALLET_EVENT_TYPE_ADDED     WalletEventType = 1
+	WalletEventType_WALLET_EVENT_TYPE_VERIFIED  WalletEventType = 2
+	WalletEventType_WALLET_EVENT_TYPE_UNLINKED  WalletEventType = 3
+)
+
+// Enum value maps for WalletEventType.
+var (
+	WalletEventType_name = map[int32]string{
+		0: "WALLET_EVENT_TYPE_UNDEFINED",
+		1: "WALLET_EVENT_TYPE_ADDED",
+		2: "WALLET_EVENT_TYPE_VERIFIED",
+		3: "WALLET_EVENT_TYPE_UNLINKED",
+	}
+	WalletEventType_value = map[string]int32{
+		"WALLET_EVENT_TYPE_UNDEFINED": 0,
+		"WALLET_EVENT_TYPE_ADDED":     1,
+		"WALLET_EVENT_TYPE_VERIFIED":  2,
+		"WALLET_EVENT_TYPE_UNLINKED":  3,
+	}
+)
+
+func (x WalletEventType) Enum() *WalletEventType {
+	p := new(WalletEventType)
+	*p = x
+	return p
+}
+
+func (x WalletEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WalletEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallets_private_proto_enumTypes[7].Descriptor()
+}
+
+func (WalletEventType) Type() protoreflect.EnumType {
+	return &file_wallets_private_proto_enumTypes[7]
+}
+
+func (x WalletEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WalletEventType.Descriptor instead.
+func (WalletEventType) EnumDescriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{7}
+}
+
+type WebhookDeliveryStatus int32
+
+const (
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNDEFINED WebhookDeliveryStatus = 0
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING   WebhookDeliveryStatus = 1
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED WebhookDeliveryStatus = 2
+	WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD      WebhookDeliveryStatus = 3
+)
+
+// Enum value maps for WebhookDeliveryStatus.
+var (
+	WebhookDeliveryStatus_name = map[int32]string{
+		0: "WEBHOOK_DELIVERY_STATUS_UNDEFINED",
+		1: "WEBHOOK_DELIVERY_STATUS_PENDING",
+		2: "WEBHOOK_DELIVERY_STATUS_DELIVERED",
+		3: "WEBHOOK_DELIVERY_STATUS_DEAD",
+	}
+	WebhookDeliveryStatus_value = map[string]int32{
+		"WEBHOOK_DELIVERY_STATUS_UNDEFINED": 0,
+		"WEBHOOK_DELIVERY_STATUS_PENDING":   1,
+		"WEBHOOK_DELIVERY_STATUS_DELIVERED": 2,
+		"WEBHOOK_DELIVERY_STATUS_DEAD":      3,
+	}
+)
+
+func (x WebhookDeliveryStatus) Enum() *WebhookDeliveryStatus {
+	p := new(WebhookDeliveryStatus)
+	*p = x
+	return p
+}
+
+func (x WebhookDeliveryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallets_private_proto_enumTypes[8].Descriptor()
+}
+
+func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
+	return &file_wallets_private_proto_enumTypes[8]
+}
+
+func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
+func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{8}
+}
+
+type GetWalletByUserIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletByUserIDRequest) Reset() {
+	*x = GetWalletByUserIDRequest{}
+	mi := &file_wallets_private_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletByUserIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletByUserIDRequest) ProtoMessage() {}
+
+func (x *GetWalletByUserIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletByUserIDRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletByUserIDRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetWalletByUserIDRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetWalletByUserIDResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pubkey   string                 `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Provider Provider               `protobuf:"varint,3,opt,name=provider,proto3,enum=wallets.private.Provider" json:"provider,omitempty"`
+	// is_verified is true while the verification is valid, including when it is expiring soon.
+	IsVerified         bool               `protobuf:"varint,4,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	VerificationStatus VerificationStatus `protobuf:"varint,5,opt,name=verification_status,json=verificationStatus,proto3,enum=wallets.private.VerificationStatus" json:"verification_status,omitempty"`
+	// verification_expires_at is a unix timestamp (seconds); 0 if the wallet is unverified or verifications never expire.
+	VerificationExpiresAt int64 `protobuf:"varint,6,opt,name=verification_expires_at,json=verificationExpiresAt,proto3" json:"verification_expires_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetWalletByUserIDResponse) Reset() {
+	*x = GetWalletByUserIDResponse{}
+	mi := &file_wallets_private_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletByUserIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletByUserIDResponse) ProtoMessage() {}
+
+func (x *GetWalletByUserIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletByUserIDResponse.ProtoReflect.Descriptor instead.
+func (*GetWalletByUserIDResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetWalletByUserIDResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetWalletByUserIDResponse) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *GetWalletByUserIDResponse) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_PROVIDER_UNDEFINED
+}
+
+func (x *GetWalletByUserIDResponse) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *GetWalletByUserIDResponse) GetVerificationStatus() VerificationStatus {
+	if x != nil {
+		return x.VerificationStatus
+	}
+	return VerificationStatus_VERIFICATION_STATUS_UNDEFINED
+}
+
+func (x *GetWalletByUserIDResponse) GetVerificationExpiresAt() int64 {
+	if x != nil {
+		return x.VerificationExpiresAt
+	}
+	return 0
+}
+
+type Wallet struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId   uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Pubkey   string                 `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Provider Provider               `protobuf:"varint,4,opt,name=provider,proto3,enum=wallets.private.Provider" json:"provider,omitempty"`
+	// is_verified is true while the verification is valid, including when it is expiring soon.
+	IsVerified         bool               `protobuf:"varint,5,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	VerificationStatus VerificationStatus `protobuf:"varint,6,opt,name=verification_status,json=verificationStatus,proto3,enum=wallets.private.VerificationStatus" json:"verification_status,omitempty"`
+	// verified_at is a unix timestamp (seconds); 0 if the wallet is unverified.
+	VerifiedAt int64 `protobuf:"varint,7,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	// verification_expires_at is a unix timestamp (seconds); 0 if the wallet is unverified or verifications never expire.
+	VerificationExpiresAt int64 `protobuf:"varint,8,opt,name=verification_expires_at,json=verificationExpiresAt,proto3" json:"verification_expires_at,omitempty"`
+	// created_at is a unix timestamp (seconds) of when the wallet was linked.
+	CreatedAt     int64 `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Wallet) Reset() {
+	*x = Wallet{}
+	mi := &file_wallets_private_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Wallet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wallet) ProtoMessage() {}
+
+func (x *Wallet) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wallet.ProtoReflect.Descriptor instead.
+func (*Wallet) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Wallet) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Wallet) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Wallet) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *Wallet) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_PROVIDER_UNDEFINED
+}
+
+func (x *Wallet) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *Wallet) GetVerificationStatus() VerificationStatus {
+	if x != nil {
+		return x.VerificationStatus
+	}
+	return VerificationStatus_VERIFICATION_STATUS_UNDEFINED
+}
+
+func (x *Wallet) GetVerifiedAt() int64 {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return 0
+}
+
+func (x *Wallet) GetVerificationExpiresAt() int64 {
+	if x != nil {
+		return x.VerificationExpiresAt
+	}
+	return 0
+}
+
+func (x *Wallet) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// At most 1000 user IDs are allowed per call.
+type GetWalletsByUserIDsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	UserIds []uint64               `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	// verified_only reports users without a valid verification as not found.
+	VerifiedOnly  bool `protobuf:"varint,2,opt,name=verified_only,json=verifiedOnly,proto3" json:"verified_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletsByUserIDsRequest) Reset() {
+	*x = GetWalletsByUserIDsRequest{}
+	mi := &file_wallets_private_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletsByUserIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletsByUserIDsRequest) ProtoMessage() {}
+
+func (x *GetWalletsByUserIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletsByUserIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletsByUserIDsRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetWalletsByUserIDsRequest) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *GetWalletsByUserIDsRequest) GetVerifiedOnly() bool {
+	if x != nil {
+		return x.VerifiedOnly
+	}
+	return false
+}
+
+type UserWalletResult struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// found is false if the user has no wallet matching the request; wallet is unset then.
+	Found         bool    `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	Wallet        *Wallet `protobuf:"bytes,3,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserWalletResult) Reset() {
+	*x = UserWalletResult{}
+	mi := &file_wallets_private_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserWalletResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserWalletResult) ProtoMessage() {}
+
+func (x *UserWalletResult) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserWalletResult.ProtoReflect.Descriptor instead.
+func (*UserWalletResult) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UserWalletResult) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserWalletResult) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *UserWalletResult) GetWallet() *Wallet {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+type GetWalletsByUserIDsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results hold one entry per distinct requested user ID, in request order.
+	Results       []*UserWalletResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletsByUserIDsResponse) Reset() {
+	*x = GetWalletsByUserIDsResponse{}
+	mi := &file_wallets_private_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletsByUserIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletsByUserIDsResponse) ProtoMessage() {}
+
+func (x *GetWalletsByUserIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletsByUserIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetWalletsByUserIDsResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetWalletsByUserIDsResponse) GetResults() []*UserWalletResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// pubkey is normalized per chain before the lookup, e.g. Move addresses may be given in the short form.
+type GetWalletByPubkeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pubkey        string                 `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletByPubkeyRequest) Reset() {
+	*x = GetWalletByPubkeyRequest{}
+	mi := &file_wallets_private_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletByPubkeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletByPubkeyRequest) ProtoMessage() {}
+
+func (x *GetWalletByPubkeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletByPubkeyRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletByPubkeyRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetWalletByPubkeyRequest) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+type GetWalletByPubkeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wallet        *Wallet                `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletByPubkeyResponse) Reset() {
+	*x = GetWalletByPubkeyResponse{}
+	mi := &file_wallets_private_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletByPubkeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletByPubkeyResponse) ProtoMessage() {}
+
+func (x *GetWalletByPubkeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletByPubkeyResponse.ProtoReflect.Descriptor instead.
+func (*GetWalletByPubkeyResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetWalletByPubkeyResponse) GetWallet() *Wallet {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+// At most 1000 pubkeys are allowed per call; each is normalized per chain before the lookup.
+type GetUsersByPubkeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pubkeys       []string               `protobuf:"bytes,1,rep,name=pubkeys,proto3" json:"pubkeys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersByPubkeysRequest) Reset() {
+	*x = GetUsersByPubkeysRequest{}
+	mi := &file_wallets_private_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersByPubkeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersByPubkeysRequest) ProtoMessage() {}
+
+func (x *GetUsersByPubkeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersByPubkeysRequest.ProtoReflect.Descriptor instead.
+func (*GetUsersByPubkeysRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetUsersByPubkeysRequest) GetPubkeys() []string {
+	if x != nil {
+		return x.Pubkeys
+	}
+	return nil
+}
+
+type PubkeyWalletResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pubkey is the address as requested.
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// found is false if the address is not linked to any user or is not a supported address; wallet is unset then.
+	Found         bool    `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`
+	Wallet        *Wallet `protobuf:"bytes,3,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PubkeyWalletResult) Reset() {
+	*x = PubkeyWalletResult{}
+	mi := &file_wallets_private_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PubkeyWalletResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PubkeyWalletResult) ProtoMessage() {}
+
+func (x *PubkeyWalletResult) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PubkeyWalletResult.ProtoReflect.Descriptor instead.
+func (*PubkeyWalletResult) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PubkeyWalletResult) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *PubkeyWalletResult) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *PubkeyWalletResult) GetWallet() *Wallet {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+type GetUsersByPubkeysResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results hold one entry per distinct requested pubkey, in request order.
+	Results       []*PubkeyWalletResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsersByPubkeysResponse) Reset() {
+	*x = GetUsersByPubkeysResponse{}
+	mi := &file_wallets_private_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsersByPubkeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsersByPubkeysResponse) ProtoMessage() {}
+
+func (x *GetUsersByPubkeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsersByPubkeysResponse.ProtoReflect.Descriptor instead.
+func (*GetUsersByPubkeysResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetUsersByPubkeysResponse) GetResults() []*PubkeyWalletResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type WatchWalletChangesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// provider narrows the stream to one provider; undefined streams every provider.
+	Provider Provider `protobuf:"varint,1,opt,name=provider,proto3,enum=wallets.private.Provider" json:"provider,omitempty"`
+	// verified_only leaves out unverified wallets and added changes. Verifications may expire without a change;
+	// use wallet.verification_expires_at.
+	VerifiedOnly bool `protobuf:"varint,2,opt,name=verified_only,json=verifiedOnly,proto3" json:"verified_only,omitempty"`
+	// from_sequence resumes the stream after the given sequence; 0 starts with a snapshot.
+	FromSequence  uint64 `protobuf:"varint,3,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchWalletChangesRequest) Reset() {
+	*x = WatchWalletChangesRequest{}
+	mi := &file_wallets_private_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchWalletChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchWalletChangesRequest) ProtoMessage() {}
+
+func (x *WatchWalletChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchWalletChangesRequest.ProtoReflect.Descriptor instead.
+func (*WatchWalletChangesRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WatchWalletChangesRequest) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_PROVIDER_UNDEFINED
+}
+
+func (x *WatchWalletChangesRequest) GetVerifiedOnly() bool {
+	if x != nil {
+		return x.VerifiedOnly
+	}
+	return false
+}
+
+func (x *WatchWalletChangesRequest) GetFromSequence() uint64 {
+	if x != nil {
+		return x.FromSequence
+	}
+	return 0
+}
+
+// Changes are upserts (snapshot, added, verified) or deletes (unlinked) by pubkey. Changes overlapping
+// the snapshot may be sent again.
+type WalletChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sequence increases monotonically across live changes; snapshot messages carry the sequence
+	// the snapshot was taken at. Store the last received one to resume after a reconnect.
+	Sequence uint64           `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type     WalletChangeType `protobuf:"varint,2,opt,name=type,proto3,enum=wallets.private.WalletChangeType" json:"type,omitempty"`
+	// wallet is unset for the snapshot end marker.
+	Wallet        *Wallet `protobuf:"bytes,3,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletChange) Reset() {
+	*x = WalletChange{}
+	mi := &file_wallets_private_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletChange) ProtoMessage() {}
+
+func (x *WalletChange) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletChange.ProtoReflect.Descriptor instead.
+func (*WalletChange) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WalletChange) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *WalletChange) GetType() WalletChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return WalletChangeType_WALLET_CHANGE_TYPE_UNDEFINED
+}
+
+func (x *WalletChange) GetWallet() *Wallet {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+type WalletSnapshot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Label string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// taken_at is a unix timestamp (seconds) of the point in time verifications were checked at.
+	TakenAt     int64  `protobuf:"varint,3,opt,name=taken_at,json=takenAt,proto3" json:"taken_at,omitempty"`
+	WalletCount uint32 `protobuf:"varint,4,opt,name=wallet_count,json=walletCount,proto3" json:"wallet_count,omitempty"`
+	// content_hash is the hex encoded SHA-256 of the CSV export.
+	ContentHash   string `protobuf:"bytes,5,opt,name=content_hash,json=contentHash,proto3" json:"content_hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletSnapshot) Reset() {
+	*x = WalletSnapshot{}
+	mi := &file_wallets_private_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletSnapshot) ProtoMessage() {}
+
+func (x *WalletSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletSnapshot.ProtoReflect.Descriptor instead.
+func (*WalletSnapshot) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WalletSnapshot) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WalletSnapshot) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *WalletSnapshot) GetTakenAt() int64 {
+	if x != nil {
+		return x.TakenAt
+	}
+	return 0
+}
+
+func (x *WalletSnapshot) GetWalletCount() uint32 {
+	if x != nil {
+		return x.WalletCount
+	}
+	return 0
+}
+
+func (x *WalletSnapshot) GetContentHash() string {
+	if x != nil {
+		return x.ContentHash
+	}
+	return ""
+}
+
+type CreateWalletSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWalletSnapshotRequest) Reset() {
+	*x = CreateWalletSnapshotRequest{}
+	mi := &file_wallets_private_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWalletSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWalletSnapshotRequest) ProtoMessage() {}
+
+func (x *CreateWalletSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWalletSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CreateWalletSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateWalletSnapshotRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type CreateWalletSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *WalletSnapshot        `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWalletSnapshotResponse) Reset() {
+	*x = CreateWalletSnapshotResponse{}
+	mi := &file_wallets_private_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWalletSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWalletSnapshotResponse) ProtoMessage() {}
+
+func (x *CreateWalletSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWalletSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*CreateWalletSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateWalletSnapshotResponse) GetSnapshot() *WalletSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type GetWalletSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotId    uint64                 `protobuf:"varint,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletSnapshotRequest) Reset() {
+	*x = GetWalletSnapshotRequest{}
+	mi := &file_wallets_private_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletSnapshotRequest) ProtoMessage() {}
+
+func (x *GetWalletSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetWalletSnapshotRequest) GetSnapshotId() uint64 {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return 0
+}
+
+type GetWalletSnapshotResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snapshot      *WalletSnapshot        `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletSnapshotResponse) Reset() {
+	*x = GetWalletSnapshotResponse{}
+	mi := &file_wallets_private_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletSnapshotResponse) ProtoMessage() {}
+
+func (x *GetWalletSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*GetWalletSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetWalletSnapshotResponse) GetSnapshot() *WalletSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type ListWalletSnapshotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWalletSnapshotsRequest) Reset() {
+	*x = ListWalletSnapshotsRequest{}
+	mi := &file_wallets_private_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWalletSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListWalletSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListWalletSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{18}
+}
+
+type ListWalletSnapshotsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// snapshots are ordered newest first.
+	Snapshots     []*WalletSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWalletSnapshotsResponse) Reset() {
+	*x = ListWalletSnapshotsResponse{}
+	mi := &file_wallets_private_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWalletSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWalletSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListWalletSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWalletSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListWalletSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListWalletSnapshotsResponse) GetSnapshots() []*WalletSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+type ExportWalletSnapshotRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotId    uint64                 `protobuf:"varint,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	Format        SnapshotFormat         `protobuf:"varint,2,opt,name=format,proto3,enum=wallets.private.SnapshotFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportWalletSnapshotRequest) Reset() {
+	*x = ExportWalletSnapshotRequest{}
+	mi := &file_wallets_private_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportWalletSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWalletSnapshotRequest) ProtoMessage() {}
+
+func (x *ExportWalletSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWalletSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*ExportWalletSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExportWalletSnapshotRequest) GetSnapshotId() uint64 {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return 0
+}
+
+func (x *ExportWalletSnapshotRequest) GetFormat() SnapshotFormat {
+	if x != nil {
+		return x.Format
+	}
+	return SnapshotFormat_SNAPSHOT_FORMAT_UNDEFINED
+}
+
+// The export is the concatenation of the data of every chunk.
+type ExportWalletSnapshotChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportWalletSnapshotChunk) Reset() {
+	*x = ExportWalletSnapshotChunk{}
+	mi := &file_wallets_private_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportWalletSnapshotChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWalletSnapshotChunk) ProtoMessage() {}
+
+func (x *ExportWalletSnapshotChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWalletSnapshotChunk.ProtoReflect.Descriptor instead.
+func (*ExportWalletSnapshotChunk) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExportWalletSnapshotChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type Allowlist struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SnapshotId   uint64                 `protobuf:"varint,2,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	Label        string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Hash         MerkleHash             `protobuf:"varint,4,opt,name=hash,proto3,enum=wallets.private.MerkleHash" json:"hash,omitempty"`
+	LeafEncoding LeafEncoding           `protobuf:"varint,5,opt,name=leaf_encoding,json=leafEncoding,proto3,enum=wallets.private.LeafEncoding" json:"leaf_encoding,omitempty"`
+	// root is the 0x-prefixed hex encoded Merkle root.
+	Root      string `protobuf:"bytes,6,opt,name=root,proto3" json:"root,omitempty"`
+	LeafCount uint32 `protobuf:"varint,7,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"`
+	// created_at is a unix timestamp (seconds).
+	CreatedAt     int64 `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Allowlist) Reset() {
+	*x = Allowlist{}
+	mi := &file_wallets_private_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Allowlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Allowlist) ProtoMessage() {}
+
+func (x *Allowlist) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Allowlist.ProtoReflect.Descriptor instead.
+func (*Allowlist) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Allowlist) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Allowlist) GetSnapshotId() uint64 {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return 0
+}
+
+func (x *Allowlist) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Allowlist) GetHash() MerkleHash {
+	if x != nil {
+		return x.Hash
+	}
+	return MerkleHash_MERKLE_HASH_UNDEFINED
+}
+
+func (x *Allowlist) GetLeafEncoding() LeafEncoding {
+	if x != nil {
+		return x.LeafEncoding
+	}
+	return LeafEncoding_LEAF_ENCODING_UNDEFINED
+}
+
+func (x *Allowlist) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *Allowlist) GetLeafCount() uint32 {
+	if x != nil {
+		return x.LeafCount
+	}
+	return 0
+}
+
+func (x *Allowlist) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateAllowlistRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SnapshotId   uint64                 `protobuf:"varint,1,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	Label        string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Hash         MerkleHash             `protobuf:"varint,3,opt,name=hash,proto3,enum=wallets.private.MerkleHash" json:"hash,omitempty"`
+	LeafEncoding LeafEncoding           `protobuf:"varint,4,opt,name=leaf_encoding,json=leafEncoding,proto3,enum=wallets.private.LeafEncoding" json:"leaf_encoding,omitempty"`
+	// providers restricts the allowlist to wallets of these providers; empty means every provider.
+	Providers []Provider `protobuf:"varint,5,rep,packed,name=providers,proto3,enum=wallets.private.Provider" json:"providers,omitempty"`
+	// default_amount is the decimal allocation of wallets missing from allocations; wallets with
+	// a zero allocation are left out. Only valid for encodings with an amount.
+	DefaultAmount string `protobuf:"bytes,6,opt,name=default_amount,json=defaultAmount,proto3" json:"default_amount,omitempty"`
+	// allocations maps pubkeys to decimal allocations. Only valid for encodings with an amount.
+	Allocations   map[string]string `protobuf:"bytes,7,rep,name=allocations,proto3" json:"allocations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAllowlistRequest) Reset() {
+	*x = CreateAllowlistRequest{}
+	mi := &file_wallets_private_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAllowlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAllowlistRequest) ProtoMessage() {}
+
+func (x *CreateAllowlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAllowlistRequest.ProtoReflect.Descriptor instead.
+func (*CreateAllowlistRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateAllowlistRequest) GetSnapshotId() uint64 {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return 0
+}
+
+func (x *CreateAllowlistRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CreateAllowlistRequest) GetHash() MerkleHash {
+	if x != nil {
+		return x.Hash
+	}
+	return MerkleHash_MERKLE_HASH_UNDEFINED
+}
+
+func (x *CreateAllowlistRequest) GetLeafEncoding() LeafEncoding {
+	if x != nil {
+		return x.LeafEncoding
+	}
+	return LeafEncoding_LEAF_ENCODING_UNDEFINED
+}
+
+func (x *CreateAllowlistRequest) GetProviders() []Provider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+func (x *CreateAllowlistRequest) GetDefaultAmount() string {
+	if x != nil {
+		return x.DefaultAmount
+	}
+	return ""
+}
+
+func (x *CreateAllowlistRequest) GetAllocations() map[string]string {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+type CreateAllowlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowlist     *Allowlist             `protobuf:"bytes,1,opt,name=allowlist,proto3" json:"allowlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAllowlistResponse) Reset() {
+	*x = CreateAllowlistResponse{}
+	mi := &file_wallets_private_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAllowlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAllowlistResponse) ProtoMessage() {}
+
+func (x *CreateAllowlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAllowlistResponse.ProtoReflect.Descriptor instead.
+func (*CreateAllowlistResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateAllowlistResponse) GetAllowlist() *Allowlist {
+	if x != nil {
+		return x.Allowlist
+	}
+	return nil
+}
+
+type GetAllowlistRootRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllowlistId   uint64                 `protobuf:"varint,1,opt,name=allowlist_id,json=allowlistId,proto3" json:"allowlist_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllowlistRootRequest) Reset() {
+	*x = GetAllowlistRootRequest{}
+	mi := &file_wallets_private_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllowlistRootRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllowlistRootRequest) ProtoMessage() {}
+
+func (x *GetAllowlistRootRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllowlistRootRequest.ProtoReflect.Descriptor instead.
+func (*GetAllowlistRootRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetAllowlistRootRequest) GetAllowlistId() uint64 {
+	if x != nil {
+		return x.AllowlistId
+	}
+	return 0
+}
+
+type GetAllowlistRootResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowlist     *Allowlist             `protobuf:"bytes,1,opt,name=allowlist,proto3" json:"allowlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllowlistRootResponse) Reset() {
+	*x = GetAllowlistRootResponse{}
+	mi := &file_wallets_private_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllowlistRootResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllowlistRootResponse) ProtoMessage() {}
+
+func (x *GetAllowlistRootResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllowlistRootResponse.ProtoReflect.Descriptor instead.
+func (*GetAllowlistRootResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetAllowlistRootResponse) GetAllowlist() *Allowlist {
+	if x != nil {
+		return x.Allowlist
+	}
+	return nil
+}
+
+type CreateOwnershipChallengeRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// statement is the text the user agrees to by signing, at most 2000 bytes.
+	// It must be a single line for wallets that sign Sign In With Solana messages.
+	Statement string `protobuf:"bytes,2,opt,name=statement,proto3" json:"statement,omitempty"`
+	// purpose identifies the caller operation, e.g. "terms:v3"; the challenge can only be verified with
+	// the same purpose. Letters, digits and . _ - : characters, at most 100.
+	Purpose       string `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOwnershipChallengeRequest) Reset() {
+	*x = CreateOwnershipChallengeRequest{}
+	mi := &file_wallets_private_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOwnershipChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOwnershipChallengeRequest) ProtoMessage() {}
+
+func (x *CreateOwnershipChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOwnershipChallengeRequest.ProtoReflect.Descriptor instead.
+func (*CreateOwnershipChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateOwnershipChallengeRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateOwnershipChallengeRequest) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *CreateOwnershipChallengeRequest) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+type CreateOwnershipChallengeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	MessageToSign string                 `protobuf:"bytes,2,opt,name=message_to_sign,json=messageToSign,proto3" json:"message_to_sign,omitempty"`
+	MessageFormat MessageFormat          `protobuf:"varint,3,opt,name=message_format,json=messageFormat,proto3,enum=wallets.private.MessageFormat" json:"message_format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOwnershipChallengeResponse) Reset() {
+	*x = CreateOwnershipChallengeResponse{}
+	mi := &file_wallets_private_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOwnershipChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOwnershipChallengeResponse) ProtoMessage() {}
+
+func (x *CreateOwnershipChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOwnershipChallengeResponse.ProtoReflect.Descriptor instead.
+func (*CreateOwnershipChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CreateOwnershipChallengeResponse) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *CreateOwnershipChallengeResponse) GetMessageToSign() string {
+	if x != nil {
+		return x.MessageToSign
+	}
+	return ""
+}
+
+func (x *CreateOwnershipChallengeResponse) GetMessageFormat() MessageFormat {
+	if x != nil {
+		return x.MessageFormat
+	}
+	return MessageFormat_MESSAGE_FORMAT_NATIVE
+}
+
+type VerifyOwnershipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ChallengeId   string                 `protobuf:"bytes,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Purpose       string                 `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Signature     string                 `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyOwnershipRequest) Reset() {
+	*x = VerifyOwnershipRequest{}
+	mi := &file_wallets_private_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyOwnershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyOwnershipRequest) ProtoMessage() {}
+
+func (x *VerifyOwnershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyOwnershipRequest.ProtoReflect.Descriptor instead.
+func (*VerifyOwnershipRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *VerifyOwnershipRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VerifyOwnershipRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *VerifyOwnershipRequest) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *VerifyOwnershipRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type VerifyOwnershipResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	WalletId  uint64                 `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Pubkey    string                 `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Provider  Provider               `protobuf:"varint,3,opt,name=provider,proto3,enum=wallets.private.Provider" json:"provider,omitempty"`
+	Purpose   string                 `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	Statement string                 `protobuf:"bytes,5,opt,name=statement,proto3" json:"statement,omitempty"`
+	// message is the exact payload the user signed.
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	// encoding is the format the signature was submitted in, e.g. "base58".
+	Encoding string `protobuf:"bytes,7,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	// verified_at is a unix timestamp (seconds).
+	VerifiedAt    int64 `protobuf:"varint,8,opt,name=verified_at,json=verifiedAt,proto3" json:"verified_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyOwnershipResponse) Reset() {
+	*x = VerifyOwnershipResponse{}
+	mi := &file_wallets_private_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyOwnershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyOwnershipResponse) ProtoMessage() {}
+
+func (x *VerifyOwnershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyOwnershipResponse.ProtoReflect.Descriptor instead.
+func (*VerifyOwnershipResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *VerifyOwnershipResponse) GetWalletId() uint64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *VerifyOwnershipResponse) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *VerifyOwnershipResponse) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_PROVIDER_UNDEFINED
+}
+
+func (x *VerifyOwnershipResponse) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+func (x *VerifyOwnershipResponse) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *VerifyOwnershipResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *VerifyOwnershipResponse) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *VerifyOwnershipResponse) GetVerifiedAt() int64 {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return 0
+}
+
+type VerificationProof struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WalletId    uint64                 `protobuf:"varint,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	UserId      uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Pubkey      string                 `protobuf:"bytes,4,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Provider    Provider               `protobuf:"varint,5,opt,name=provider,proto3,enum=wallets.private.Provider" json:"provider,omitempty"`
+	ChallengeId string                 `protobuf:"bytes,6,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// message is the challenge message shown to the user.
+	Message   []byte `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Signature string `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	// encoding is the format the signature was submitted in, e.g. "base58" or "xdr".
+	Encoding        string `protobuf:"bytes,9,opt,name=encoding,proto3" json:"encoding,omitempty"`
+	VerifierVersion string `protobuf:"bytes,10,opt,name=verifier_version,json=verifierVersion,proto3" json:"verifier_version,omitempty"`
+	IpHash          string `protobuf:"bytes,11,opt,name=ip_hash,json=ipHash,proto3" json:"ip_hash,omitempty"`
+	UserAgentHash   string `protobuf:"bytes,12,opt,name=user_agent_hash,json=userAgentHash,proto3" json:"user_agent_hash,omitempty"`
+	// created_at is a unix timestamp (seconds) of the verification.
+	CreatedAt int64 `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// signed_payload is the exact byte string the signature covers, i.e. message wrapped the way
+	// the chain signs it, before any hashing of the signature scheme.
+	SignedPayload []byte `protobuf:"bytes,14,opt,name=signed_payload,json=signedPayload,proto3" json:"signed_payload,omitempty"`
+	// statement is the custom statement of the challenge; empty means the wallet verification statement.
+	Statement     string `protobuf:"bytes,15,opt,name=statement,proto3" json:"statement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerificationProof) Reset() {
+	*x = VerificationProof{}
+	mi := &file_wallets_private_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerificationProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerificationProof) ProtoMessage() {}
+
+func (x *VerificationProof) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerificationProof.ProtoReflect.Descriptor instead.
+func (*VerificationProof) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *VerificationProof) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VerificationProof) GetWalletId() uint64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *VerificationProof) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VerificationProof) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *VerificationProof) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_PROVIDER_UNDEFINED
+}
+
+func (x *VerificationProof) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *VerificationProof) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *VerificationProof) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *VerificationProof) GetEncoding() string {
+	if x != nil {
+		return x.Encoding
+	}
+	return ""
+}
+
+func (x *VerificationProof) GetVerifierVersion() string {
+	if x != nil {
+		return x.VerifierVersion
+	}
+	return ""
+}
+
+func (x *VerificationProof) GetIpHash() string {
+	if x != nil {
+		return x.IpHash
+	}
+	return ""
+}
+
+func (x *VerificationProof) GetUserAgentHash() string {
+	if x != nil {
+		return x.UserAgentHash
+	}
+	return ""
+}
+
+func (x *VerificationProof) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *VerificationProof) GetSignedPayload() []byte {
+	if x != nil {
+		return x.SignedPayload
+	}
+	return nil
+}
+
+func (x *VerificationProof) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+// At least one of the fields must be set.
+type GetVerificationProofsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      uint64                 `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Pubkey        string                 `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVerificationProofsRequest) Reset() {
+	*x = GetVerificationProofsRequest{}
+	mi := &file_wallets_private_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVerificationProofsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVerificationProofsRequest) ProtoMessage() {}
+
+func (x *GetVerificationProofsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVerificationProofsRequest.ProtoReflect.Descriptor instead.
+func (*GetVerificationProofsRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetVerificationProofsRequest) GetWalletId() uint64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *GetVerificationProofsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetVerificationProofsRequest) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+type GetVerificationProofsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proofs        []*VerificationProof   `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVerificationProofsResponse) Reset() {
+	*x = GetVerificationProofsResponse{}
+	mi := &file_wallets_private_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVerificationProofsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVerificationProofsResponse) ProtoMessage() {}
+
+func (x *GetVerificationProofsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVerificationProofsResponse.ProtoReflect.Descriptor instead.
+func (*GetVerificationProofsResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetVerificationProofsResponse) GetProofs() []*VerificationProof {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type WalletEvent struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type      WalletEventType        `protobuf:"varint,2,opt,name=type,proto3,enum=wallets.private.WalletEventType" json:"type,omitempty"`
+	UserId    uint64                 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WalletId  uint64                 `protobuf:"varint,4,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Pubkey    string                 `protobuf:"bytes,5,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Provider  Provider               `protobuf:"varint,6,opt,name=provider,proto3,enum=wallets.private.Provider" json:"provider,omitempty"`
+	Actor     string                 `protobuf:"bytes,7,opt,name=actor,proto3" json:"actor,omitempty"`
+	RequestId string                 `protobuf:"bytes,8,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Metadata  map[string]string      `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// created_at is a unix timestamp (seconds).
+	CreatedAt     int64 `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletEvent) Reset() {
+	*x = WalletEvent{}
+	mi := &file_wallets_private_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletEvent) ProtoMessage() {}
+
+func (x *WalletEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletEvent.ProtoReflect.Descriptor instead.
+func (*WalletEvent) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WalletEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WalletEvent) GetType() WalletEventType {
+	if x != nil {
+		return x.Type
+	}
+	return WalletEventType_WALLET_EVENT_TYPE_UNDEFINED
+}
+
+func (x *WalletEvent) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *WalletEvent) GetWalletId() uint64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *WalletEvent) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *WalletEvent) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_PROVIDER_UNDEFINED
+}
+
+func (x *WalletEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *WalletEvent) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *WalletEvent) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *WalletEvent) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// At least one of user_id, wallet_id and pubkey must be set.
+type GetWalletEventsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WalletId uint64                 `protobuf:"varint,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Pubkey   string                 `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// before_id returns events older than the given event, for paging.
+	BeforeId uint64 `protobuf:"varint,4,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+	// limit defaults to 50 and is capped at 200.
+	Limit         uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletEventsRequest) Reset() {
+	*x = GetWalletEventsRequest{}
+	mi := &file_wallets_private_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletEventsRequest) ProtoMessage() {}
+
+func (x *GetWalletEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletEventsRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetWalletEventsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetWalletEventsRequest) GetWalletId() uint64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *GetWalletEventsRequest) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *GetWalletEventsRequest) GetBeforeId() uint64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+func (x *GetWalletEventsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetWalletEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// events are ordered newest first.
+	Events        []*WalletEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletEventsResponse) Reset() {
+	*x = GetWalletEventsResponse{}
+	mi := &file_wallets_private_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletEventsResponse) ProtoMessage() {}
+
+func (x *GetWalletEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletEventsResponse.ProtoReflect.Descriptor instead.
+func (*GetWalletEventsResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetWalletEventsResponse) GetEvents() []*WalletEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type WebhookSubscription struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url   string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// event_types are domain event types, e.g. "wallet.verified".
+	EventTypes []string `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// created_at is a unix timestamp (seconds).
+	CreatedAt     int64 `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookSubscription) Reset() {
+	*x = WebhookSubscription{}
+	mi := &file_wallets_private_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookSubscription) ProtoMessage() {}
+
+func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookSubscription.ProtoReflect.Descriptor instead.
+func (*WebhookSubscription) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WebhookSubscription) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookSubscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookSubscription) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreateWebhookSubscriptionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Url   string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// event_types accepts "wallet.added", "wallet.verified", "wallet.unlinked", "wallet.reclaim_requested"
+	// and "wallet.transfer_initiated".
+	EventTypes []string `protobuf:"bytes,2,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	// secret keys the delivery signatures. If empty, a random secret is generated.
+	Secret        string `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookSubscriptionRequest) Reset() {
+	*x = CreateWebhookSubscriptionRequest{}
+	mi := &file_wallets_private_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *CreateWebhookSubscriptionRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type CreateWebhookSubscriptionResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Subscription *WebhookSubscription   `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	// secret is only returned on creation.
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookSubscriptionResponse) Reset() {
+	*x = CreateWebhookSubscriptionResponse{}
+	mi := &file_wallets_private_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreateWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateWebhookSubscriptionResponse) GetSubscription() *WebhookSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *CreateWebhookSubscriptionResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ListWebhookSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionsRequest) Reset() {
+	*x = ListWebhookSubscriptionsRequest{}
+	mi := &file_wallets_private_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{40}
+}
+
+type ListWebhookSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*WebhookSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookSubscriptionsResponse) Reset() {
+	*x = ListWebhookSubscriptionsResponse{}
+	mi := &file_wallets_private_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListWebhookSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListWebhookSubscriptionsResponse) GetSubscriptions() []*WebhookSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type DeleteWebhookSubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId uint64                 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionRequest) Reset() {
+	*x = DeleteWebhookSubscriptionRequest{}
+	mi := &file_wallets_private_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteWebhookSubscriptionRequest) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+type DeleteWebhookSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookSubscriptionResponse) Reset() {
+	*x = DeleteWebhookSubscriptionResponse{}
+	mi := &file_wallets_private_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{43}
+}
+
+type WebhookDelivery struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscriptionId uint64                 `protobuf:"varint,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	EventId        string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	EventType      string                 `protobuf:"bytes,4,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Status         WebhookDeliveryStatus  `protobuf:"varint,5,opt,name=status,proto3,enum=wallets.private.WebhookDeliveryStatus" json:"status,omitempty"`
+	Attempts       uint32                 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// next_attempt_at is a unix timestamp (seconds).
+	NextAttemptAt  int64  `protobuf:"varint,7,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	LastError      string `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastStatusCode uint32 `protobuf:"varint,9,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	// delivered_at is a unix timestamp (seconds), zero if not delivered.
+	DeliveredAt int64 `protobuf:"varint,10,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	// created_at is a unix timestamp (seconds).
+	CreatedAt     int64 `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_wallets_private_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *WebhookDelivery) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNDEFINED
+}
+
+func (x *WebhookDelivery) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() int64 {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetLastStatusCode() uint32 {
+	if x != nil {
+		return x.LastStatusCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() int64 {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId uint64                 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// status narrows the deliveries; undefined returns every status.
+	Status WebhookDeliveryStatus `protobuf:"varint,2,opt,name=status,proto3,enum=wallets.private.WebhookDeliveryStatus" json:"status,omitempty"`
+	// limit defaults to 50 and is capped at 200.
+	Limit         uint32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesRequest) Reset() {
+	*x = ListWebhookDeliveriesRequest{}
+	mi := &file_wallets_private_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListWebhookDeliveriesRequest) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *ListWebhookDeliveriesRequest) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNDEFINED
+}
+
+func (x *ListWebhookDeliveriesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListWebhookDeliveriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// deliveries are ordered newest first.
+	Deliveries    []*WebhookDelivery `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhookDeliveriesResponse) Reset() {
+	*x = ListWebhookDeliveriesResponse{}
+	mi := &file_wallets_private_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListWebhookDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+type ReplayWebhookSubscriptionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionId uint64                 `protobuf:"varint,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// since is a unix timestamp (seconds); events that occurred at or after it are delivered again.
+	Since         int64 `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayWebhookSubscriptionRequest) Reset() {
+	*x = ReplayWebhookSubscriptionRequest{}
+	mi := &file_wallets_private_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayWebhookSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayWebhookSubscriptionRequest) ProtoMessage() {}
+
+func (x *ReplayWebhookSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayWebhookSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReplayWebhookSubscriptionRequest) GetSubscriptionId() uint64 {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return 0
+}
+
+func (x *ReplayWebhookSubscriptionRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+type ReplayWebhookSubscriptionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// scheduled is the number of deliveries scheduled by the replay.
+	Scheduled     uint64 `protobuf:"varint,1,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayWebhookSubscriptionResponse) Reset() {
+	*x = ReplayWebhookSubscriptionResponse{}
+	mi := &file_wallets_private_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayWebhookSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayWebhookSubscriptionResponse) ProtoMessage() {}
+
+func (x *ReplayWebhookSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_private_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayWebhookSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*ReplayWebhookSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_private_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReplayWebhookSubscriptionResponse) GetScheduled() uint64 {
+	if x != nil {
+		return x.Scheduled
+	}
+	return 0
+}
+
+var File_wallets_private_proto protoreflect.FileDescriptor
+
+const file_wallets_private_proto_rawDesc = "" +
+	"\n" +
+	"\x15wallets.private.proto\x12\x0fwallets.private\"3\n" +
+	"\x18GetWalletByUserIDRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"\xa9\x02\n" +
+	"\x19GetWalletByUserIDResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06pubkey\x18\x02 \x01(\tR\x06pubkey\x125\n" +
+	"\bprovider\x18\x03 \x01(\x0e2\x19.wallets.private.ProviderR\bprovider\x12\x1f\n" +
+	"\vis_verified\x18\x04 \x01(\bR\n" +
+	"isVerified\x12T\n" +
+	"\x13verification_status\x18\x05 \x01(\x0e2#.wallets.private.VerificationStatusR\x12verificationStatus\x126\n" +
+	"\x17verification_expires_at\x18\x06 \x01(\x03R\x15verificationExpiresAt\"\xef\x02\n" +
+	"\x06Wallet\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x16\n" +
+	"\x06pubkey\x18\x03 \x01(\tR\x06pubkey\x125\n" +
+	"\bprovider\x18\x04 \x01(\x0e2\x19.wallets.private.ProviderR\bprovider\x12\x1f\n" +
+	"\vis_verified\x18\x05 \x01(\bR\n" +
+	"isVerified\x12T\n" +
+	"\x13verification_status\x18\x06 \x01(\x0e2#.wallets.private.VerificationStatusR\x12verificationStatus\x12\x1f\n" +
+	"\vverified_at\x18\a \x01(\x03R\n" +
+	"verifiedAt\x126\n" +
+	"\x17verification_expires_at\x18\b \x01(\x03R\x15verificationExpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\"\\\n" +
+	"\x1aGetWalletsByUserIDsRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x04R\auserIds\x12#\n" +
+	"\rverified_only\x18\x02 \x01(\bR\fverifiedOnly\"r\n" +
+	"\x10UserWalletResult\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
+	"\x05found\x18\x02 \x01(\bR\x05found\x12/\n" +
+	"\x06wallet\x18\x03 \x01(\v2\x17.wallets.private.WalletR\x06wallet\"Z\n" +
+	"\x1bGetWalletsByUserIDsResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.wallets.private.UserWalletResultR\aresults\"2\n" +
+	"\x18GetWalletByPubkeyRequest\x12\x16\n" +
+	"\x06pubkey\x18\x01 \x01(\tR\x06pubkey\"L\n" +
+	"\x19GetWalletByPubkeyResponse\x12/\n" +
+	"\x06wallet\x18\x01 \x01(\v2\x17.wallets.private.WalletR\x06wallet\"4\n" +
+	"\x18GetUsersByPubkeysRequest\x12\x18\n" +
+	"\apubkeys\x18\x01 \x03(\tR\apubkeys\"s\n" +
+	"\x12PubkeyWalletResult\x12\x16\n" +
+	"\x06pubkey\x18\x01 \x01(\tR\x06pubkey\x12\x14\n" +
+	"\x05found\x18\x02 \x01(\bR\x05found\x12/\n" +
+	"\x06wallet\x18\x03 \x01(\v2\x17.wallets.private.WalletR\x06wallet\"Z\n" +
+	"\x19GetUsersByPubkeysResponse\x12=\n" +
+	"\aresults\x18\x01 \x03(\v2#.wallets.private.PubkeyWalletResultR\aresults\"\x9c\x01\n" +
+	"\x19WatchWalletChangesRequest\x125\n" +
+	"\bprovider\x18\x01 \x01(\x0e2\x19.wallets.private.ProviderR\bprovider\x12#\n" +
+	"\rverified_only\x18\x02 \x01(\bR\fverifiedOnly\x12#\n" +
+	"\rfrom_sequence\x18\x03 \x01(\x04R\ffromSequence\"\x92\x01\n" +
+	"\fWalletChange\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x125\n" +
+	"\x04type\x18\x02 \x01(\x0e2!.wallets.private.WalletChangeTypeR\x04type\x12/\n" +
+	"\x06wallet\x18\x03 \x01(\v2\x17.wallets.private.WalletR\x06wallet\"\x97\x01\n" +
+	"\x0eWalletSnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x19\n" +
+	"\btaken_at\x18\x03 \x01(\x03R\atakenAt\x12!\n" +
+	"\fwallet_count\x18\x04 \x01(\rR\vwalletCount\x12!\n" +
+	"\fcontent_hash\x18\x05 \x01(\tR\vcontentHash\"3\n" +
+	"\x1bCreateWalletSnapshotRequest\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\"[\n" +
+	"\x1cCreateWalletSnapshotResponse\x12;\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x1f.wallets.private.WalletSnapshotR\bsnapshot\";\n" +
+	"\x18GetWalletSnapshotRequest\x12\x1f\n" +
+	"\vsnapshot_id\x18\x01 \x01(\x04R\n" +
+	"snapshotId\"X\n" +
+	"\x19GetWalletSnapshotResponse\x12;\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x1f.wallets.private.WalletSnapshotR\bsnapshot\"\x1c\n" +
+	"\x1aListWalletSnapshotsRequest\"\\\n" +
+	"\x1bListWalletSnapshotsResponse\x12=\n" +
+	"\tsnapshots\x18\x01 \x03(\v2\x1f.wallets.private.WalletSnapshotR\tsnapshots\"w\n" +
+	"\x1bExportWalletSnapshotRequest\x12\x1f\n" +
+	"\vsnapshot_id\x18\x01 \x01(\x04R\n" +
+	"snapshotId\x127\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x1f.wallets.private.SnapshotFormatR\x06format\"/\n" +
+	"\x19ExportWalletSnapshotChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\x99\x02\n" +
+	"\tAllowlist\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1f\n" +
+	"\vsnapshot_id\x18\x02 \x01(\x04R\n" +
+	"snapshotId\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12/\n" +
+	"\x04hash\x18\x04 \x01(\x0e2\x1b.wallets.private.MerkleHashR\x04hash\x12B\n" +
+	"\rleaf_encoding\x18\x05 \x01(\x0e2\x1d.wallets.private.LeafEncodingR\fleafEncoding\x12\x12\n" +
+	"\x04root\x18\x06 \x01(\tR\x04root\x12\x1d\n" +
+	"\n" +
+	"leaf_count\x18\a \x01(\rR\tleafCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\"\xc0\x03\n" +
+	"\x16CreateAllowlistRequest\x12\x1f\n" +
+	"\vsnapshot_id\x18\x01 \x01(\x04R\n" +
+	"snapshotId\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12/\n" +
+	"\x04hash\x18\x03 \x01(\x0e2\x1b.wallets.private.MerkleHashR\x04hash\x12B\n" +
+	"\rleaf_encoding\x18\x04 \x01(\x0e2\x1d.wallets.private.LeafEncodingR\fleafEncoding\x127\n" +
+	"\tproviders\x18\x05 \x03(\x0e2\x19.wallets.private.ProviderR\tproviders\x12%\n" +
+	"\x0edefault_amount\x18\x06 \x01(\tR\rdefaultAmount\x12Z\n" +
+	"\vallocations\x18\a \x03(\v28.wallets.private.CreateAllowlistRequest.AllocationsEntryR\vallocations\x1a>\n" +
+	"\x10AllocationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"S\n" +
+	"\x17CreateAllowlistResponse\x128\n" +
+	"\tallowlist\x18\x01 \x01(\v2\x1a.wallets.private.AllowlistR\tallowlist\"<\n" +
+	"\x17GetAllowlistRootRequest\x12!\n" +
+	"\fallowlist_id\x18\x01 \x01(\x04R\vallowlistId\"T\n" +
+	"\x18GetAllowlistRootResponse\x128\n" +
+	"\tallowlist\x18\x01 \x01(\v2\x1a.wallets.private.AllowlistR\tallowlist\"r\n" +
+	"\x1fCreateOwnershipChallengeRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1c\n" +
+	"\tstatement\x18\x02 \x01(\tR\tstatement\x12\x18\n" +
+	"\apurpose\x18\x03 \x01(\tR\apurpose\"\xb4\x01\n" +
+	" CreateOwnershipChallengeResponse\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12&\n" +
+	"\x0fmessage_to_sign\x18\x02 \x01(\tR\rmessageToSign\x12E\n" +
+	"\x0emessage_format\x18\x03 \x01(\x0e2\x1e.wallets.private.MessageFormatR\rmessageFormat\"\x8c\x01\n" +
+	"\x16VerifyOwnershipRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12!\n" +
+	"\fchallenge_id\x18\x02 \x01(\tR\vchallengeId\x12\x18\n" +
+	"\apurpose\x18\x03 \x01(\tR\apurpose\x12\x1c\n" +
+	"\tsignature\x18\x04 \x01(\tR\tsignature\"\x94\x02\n" +
+	"\x17VerifyOwnershipResponse\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x04R\bwalletId\x12\x16\n" +
+	"\x06pubkey\x18\x02 \x01(\tR\x06pubkey\x125\n" +
+	"\bprovider\x18\x03 \x01(\x0e2\x19.wallets.private.ProviderR\bprovider\x12\x18\n" +
+	"\apurpose\x18\x04 \x01(\tR\apurpose\x12\x1c\n" +
+	"\tstatement\x18\x05 \x01(\tR\tstatement\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12\x1a\n" +
+	"\bencoding\x18\a \x01(\tR\bencoding\x12\x1f\n" +
+	"\vverified_at\x18\b \x01(\x03R\n" +
+	"verifiedAt\"\xef\x03\n" +
+	"\x11VerificationProof\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
+	"\twallet_id\x18\x02 \x01(\x04R\bwalletId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x04R\x06userId\x12\x16\n" +
+	"\x06pubkey\x18\x04 \x01(\tR\x06pubkey\x125\n" +
+	"\bprovider\x18\x05 \x01(\x0e2\x19.wallets.private.ProviderR\bprovider\x12!\n" +
+	"\fchallenge_id\x18\x06 \x01(\tR\vchallengeId\x12\x18\n" +
+	"\amessage\x18\a \x01(\fR\amessage\x12\x1c\n" +
+	"\tsignature\x18\b \x01(\tR\tsignature\x12\x1a\n" +
+	"\bencoding\x18\t \x01(\tR\bencoding\x12)\n" +
+	"\x10verifier_version\x18\n" +
+	" \x01(\tR\x0fverifierVersion\x12\x17\n" +
+	"\aip_hash\x18\v \x01(\tR\x06ipHash\x12&\n" +
+	"\x0fuser_agent_hash\x18\f \x01(\tR\ruserAgentHash\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\r \x01(\x03R\tcreatedAt\x12%\n" +
+	"\x0esigned_payload\x18\x0e \x01(\fR\rsignedPayload\x12\x1c\n" +
+	"\tstatement\x18\x0f \x01(\tR\tstatement\"l\n" +
+	"\x1cGetVerificationProofsRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x04R\bwalletId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x16\n" +
+	"\x06pubkey\x18\x03 \x01(\tR\x06pubkey\"[\n" +
+	"\x1dGetVerificationProofsResponse\x12:\n" +
+	"\x06proofs\x18\x01 \x03(\v2\".wallets.private.VerificationProofR\x06proofs\"\xb1\x03\n" +
+	"\vWalletEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x124\n" +
+	"\x04type\x18\x02 \x01(\x0e2 .wallets.private.WalletEventTypeR\x04type\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\twallet_id\x18\x04 \x01(\x04R\bwalletId\x12\x16\n" +
+	"\x06pubkey\x18\x05 \x01(\tR\x06pubkey\x125\n" +
+	"\bprovider\x18\x06 \x01(\x0e2\x19.wallets.private.ProviderR\bprovider\x12\x14\n" +
+	"\x05actor\x18\a \x01(\tR\x05actor\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\b \x01(\tR\trequestId\x12F\n" +
+	"\bmetadata\x18\t \x03(\v2*.wallets.private.WalletEvent.MetadataEntryR\bmetadata\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x99\x01\n" +
+	"\x16GetWalletEventsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\twallet_id\x18\x02 \x01(\x04R\bwalletId\x12\x16\n" +
+	"\x06pubkey\x18\x03 \x01(\tR\x06pubkey\x12\x1b\n" +
+	"\tbefore_id\x18\x04 \x01(\x04R\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\rR\x05limit\"O\n" +
+	"\x17GetWalletEventsResponse\x124\n" +
+	"\x06events\x18\x01 \x03(\v2\x1c.wallets.private.WalletEventR\x06events\"w\n" +
+	"\x13WebhookSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\"m\n" +
+	" CreateWebhookSubscriptionRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x02 \x03(\tR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06secret\x18\x03 \x01(\tR\x06secret\"\x85\x01\n" +
+	"!CreateWebhookSubscriptionResponse\x12H\n" +
+	"\fsubscription\x18\x01 \x01(\v2$.wallets.private.WebhookSubscriptionR\fsubscription\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"!\n" +
+	"\x1fListWebhookSubscriptionsRequest\"n\n" +
+	" ListWebhookSubscriptionsResponse\x12J\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2$.wallets.private.WebhookSubscriptionR\rsubscriptions\"K\n" +
+	" DeleteWebhookSubscriptionRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\x04R\x0esubscriptionId\"#\n" +
+	"!DeleteWebhookSubscriptionResponse\"\x93\x03\n" +
+	"\x0fWebhookDelivery\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\x04R\x0esubscriptionId\x12\x19\n" +
+	"\bevent_id\x18\x03 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"event_type\x18\x04 \x01(\tR\teventType\x12>\n" +
+	"\x06status\x18\x05 \x01(\x0e2&.wallets.private.WebhookDeliveryStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\rR\battempts\x12&\n" +
+	"\x0fnext_attempt_at\x18\a \x01(\x03R\rnextAttemptAt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\b \x01(\tR\tlastError\x12(\n" +
+	"\x10last_status_code\x18\t \x01(\rR\x0elastStatusCode\x12!\n" +
+	"\fdelivered_at\x18\n" +
+	" \x01(\x03R\vdeliveredAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x03R\tcreatedAt\"\x9d\x01\n" +
+	"\x1cListWebhookDeliveriesRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\x04R\x0esubscriptionId\x12>\n" +
+	"\x06status\x18\x02 \x01(\x0e2&.wallets.private.WebhookDeliveryStatusR\x06status\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\rR\x05limit\"a\n" +
+	"\x1dListWebhookDeliveriesResponse\x12@\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2 .wallets.private.WebhookDeliveryR\n" +
+	"deliveries\"a\n" +
+	" ReplayWebhookSubscriptionRequest\x12'\n" +
+	"\x0fsubscription_id\x18\x01 \x01(\x04R\x0esubscriptionId\x12\x14\n" +
+	"\x05since\x18\x02 \x01(\x03R\x05since\"A\n" +
+	"!ReplayWebhookSubscriptionResponse\x12\x1c\n" +
+	"\tscheduled\x18\x01 \x01(\x04R\tscheduled*\xfd\x01\n" +
+	"\bProvider\x12\x16\n" +
+	"\x12PROVIDER_UNDEFINED\x10\x00\x12\x14\n" +
+	"\x10PROVIDER_PHANTOM\x10\x01\x12\x14\n" +
+	"\x10PROVIDER_STELLAR\x10\x02\x12\x13\n" +
+	"\x0fPROVIDER_COSMOS\x10\x03\x12\x12\n" +
+	"\x0ePROVIDER_APTOS\x10\x04\x12\x10\n" +
+	"\fPROVIDER_SUI\x10\x05\x12\x15\n" +
+	"\x11PROVIDER_SOLFLARE\x10\x06\x12\x15\n" +
+	"\x11PROVIDER_BACKPACK\x10\a\x12\x11\n" +
+	"\rPROVIDER_GLOW\x10\b\x12\x13\n" +
+	"\x0fPROVIDER_LEDGER\x10\t\x12\x1c\n" +
+	"\x18PROVIDER_WALLET_STANDARD\x10\n" +
+	"*\xc5\x01\n" +
+	"\x12VerificationStatus\x12!\n" +
+	"\x1dVERIFICATION_STATUS_UNDEFINED\x10\x00\x12\"\n" +
+	"\x1eVERIFICATION_STATUS_UNVERIFIED\x10\x01\x12 \n" +
+	"\x1cVERIFICATION_STATUS_VERIFIED\x10\x02\x12%\n" +
+	"!VERIFICATION_STATUS_EXPIRING_SOON\x10\x03\x12\x1f\n" +
+	"\x1bVERIFICATION_STATUS_EXPIRED\x10\x04*\xda\x01\n" +
+	"\x10WalletChangeType\x12 \n" +
+	"\x1cWALLET_CHANGE_TYPE_UNDEFINED\x10\x00\x12\x1f\n" +
+	"\x1bWALLET_CHANGE_TYPE_SNAPSHOT\x10\x01\x12#\n" +
+	"\x1fWALLET_CHANGE_TYPE_SNAPSHOT_END\x10\x02\x12\x1c\n" +
+	"\x18WALLET_CHANGE_TYPE_ADDED\x10\x03\x12\x1f\n" +
+	"\x1bWALLET_CHANGE_TYPE_VERIFIED\x10\x04\x12\x1f\n" +
+	"\x1bWALLET_CHANGE_TYPE_UNLINKED\x10\x05*h\n" +
+	"\x0eSnapshotFormat\x12\x1d\n" +
+	"\x19SNAPSHOT_FORMAT_UNDEFINED\x10\x00\x12\x17\n" +
+	"\x13SNAPSHOT_FORMAT_CSV\x10\x01\x12\x1e\n" +
+	"\x1aSNAPSHOT_FORMAT_JSON_LINES\x10\x02*Z\n" +
+	"\n" +
+	"MerkleHash\x12\x19\n" +
+	"\x15MERKLE_HASH_UNDEFINED\x10\x00\x12\x19\n" +
+	"\x15MERKLE_HASH_KECCAK256\x10\x01\x12\x16\n" +
+	"\x12MERKLE_HASH_SHA256\x10\x02*\x97\x01\n" +
+	"\fLeafEncoding\x12\x1b\n" +
+	"\x17LEAF_ENCODING_UNDEFINED\x10\x00\x12\x19\n" +
+	"\x15LEAF_ENCODING_ADDRESS\x10\x01\x12&\n" +
+	"\"LEAF_ENCODING_ADDRESS_AMOUNT_U64LE\x10\x02\x12'\n" +
+	"#LEAF_ENCODING_ADDRESS_AMOUNT_U256BE\x10\x03*\x80\x01\n" +
+	"\rMessageFormat\x12\x19\n" +
+	"\x15MESSAGE_FORMAT_NATIVE\x10\x00\x12\x17\n" +
+	"\x13MESSAGE_FORMAT_TEXT\x10\x01\x12\x17\n" +
+	"\x13MESSAGE_FORMAT_SIWS\x10\x02\x12\"\n" +
+	"\x1eMESSAGE_FORMAT_SOLANA_OFFCHAIN\x10\x03*\x8f\x01\n" +
+	"\x0fWalletEventType\x12\x1f\n" +
+	"\x1bWALLET_EVENT_TYPE_UNDEFINED\x10\x00\x12\x1b\n" +
+	"\x17WALLET_EVENT_TYPE_ADDED\x10\x01\x12\x1e\n" +
+	"\x1aWALLET_EVENT_TYPE_VERIFIED\x10\x02\x12\x1e\n" +
+	"\x1aWALLET_EVENT_TYPE_UNLINKED\x10\x03*\xac\x01\n" +
+	"\x15WebhookDeliveryStatus\x12%\n" +
+	"!WEBHOOK_DELIVERY_STATUS_UNDEFINED\x10\x00\x12#\n" +
+	"\x1fWEBHOOK_DELIVERY_STATUS_PENDING\x10\x01\x12%\n" +
+	"!WEBHOOK_DELIVERY_STATUS_DELIVERED\x10\x02\x12 \n" +
+	"\x1cWEBHOOK_DELIVERY_STATUS_DEAD\x10\x032\x8c\x12\n" +
+	"\x0eWalletsPrivate\x12j\n" +
+	"\x11GetWalletByUserID\x12).wallets.private.GetWalletByUserIDRequest\x1a*.wallets.private.GetWalletByUserIDResponse\x12p\n" +
+	"\x13GetWalletsByUserIDs\x12+.wallets.private.GetWalletsByUserIDsRequest\x1a,.wallets.private.GetWalletsByUserIDsResponse\x12j\n" +
+	"\x11GetWalletByPubkey\x12).wallets.private.GetWalletByPubkeyRequest\x1a*.wallets.private.GetWalletByPubkeyResponse\x12j\n" +
+	"\x11GetUsersByPubkeys\x12).wallets.private.GetUsersByPubkeysRequest\x1a*.wallets.private.GetUsersByPubkeysResponse\x12a\n" +
+	"\x12WatchWalletChanges\x12*.wallets.private.WatchWalletChangesRequest\x1a\x1d.wallets.private.WalletChange0\x01\x12s\n" +
+	"\x14CreateWalletSnapshot\x12,.wallets.private.CreateWalletSnapshotRequest\x1a-.wallets.private.CreateWalletSnapshotResponse\x12j\n" +
+	"\x11GetWalletSnapshot\x12).wallets.private.GetWalletSnapshotRequest\x1a*.wallets.private.GetWalletSnapshotResponse\x12p\n" +
+	"\x13ListWalletSnapshots\x12+.wallets.private.ListWalletSnapshotsRequest\x1a,.wallets.private.ListWalletSnapshotsResponse\x12r\n" +
+	"\x14ExportWalletSnapshot\x12,.wallets.private.ExportWalletSnapshotRequest\x1a*.wallets.private.ExportWalletSnapshotChunk0\x01\x12d\n" +
+	"\x0fCreateAllowlist\x12'.wallets.private.CreateAllowlistRequest\x1a(.wallets.private.CreateAllowlistResponse\x12g\n" +
+	"\x10GetAllowlistRoot\x12(.wallets.private.GetAllowlistRootRequest\x1a).wallets.private.GetAllowlistRootResponse\x12\x7f\n" +
+	"\x18CreateOwnershipChallenge\x120.wallets.private.CreateOwnershipChallengeRequest\x1a1.wallets.private.CreateOwnershipChallengeResponse\x12d\n" +
+	"\x0fVerifyOwnership\x12'.wallets.private.VerifyOwnershipRequest\x1a(.wallets.private.VerifyOwnershipResponse\x12v\n" +
+	"\x15GetVerificationProofs\x12-.wallets.private.GetVerificationProofsRequest\x1a..wallets.private.GetVerificationProofsResponse\x12d\n" +
+	"\x0fGetWalletEvents\x12'.wallets.private.GetWalletEventsRequest\x1a(.wallets.private.GetWalletEventsResponse\x12\x82\x01\n" +
+	"\x19CreateWebhookSubscription\x121.wallets.private.CreateWebhookSubscriptionRequest\x1a2.wallets.private.CreateWebhookSubscriptionResponse\x12\x7f\n" +
+	"\x18ListWebhookSubscriptions\x120.wallets.private.ListWebhookSubscriptionsRequest\x1a1.wallets.private.ListWebhookSubscriptionsResponse\x12\x82\x01\n" +
+	"\x19DeleteWebhookSubscription\x121.wallets.private.DeleteWebhookSubscriptionRequest\x1a2.wallets.private.DeleteWebhookSubscriptionResponse\x12v\n" +
+	"\x15ListWebhookDeliveries\x12-.wallets.private.ListWebhookDeliveriesRequest\x1a..wallets.private.ListWebhookDeliveriesResponse\x12\x82\x01\n" +
+	"\x19ReplayWebhookSubscription\x121.wallets.private.ReplayWebhookSubscriptionRequest\x1a2.wallets.private.ReplayWebhookSubscriptionResponseB\x04Z\x02./b\x06proto3"
+
+var (
+	file_wallets_private_proto_rawDescOnce sync.Once
+	file_wallets_private_proto_rawDescData []byte
+)
+
+func file_wallets_private_proto_rawDescGZIP() []byte {
+	file_wallets_private_proto_rawDescOnce.Do(func() {
+		file_wallets_private_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_wallets_private_proto_rawDesc), len(file_wallets_private_proto_rawDesc)))
+	})
+	return file_wallets_private_proto_rawDescData
+}
+
+var file_wallets_private_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_wallets_private_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_wallets_private_proto_goTypes = []any{
+	(Provider)(0),                             // 0: wallets.private.Provider
+	(VerificationStatus)(0),                   // 1: wallets.private.VerificationStatus
+	(WalletChangeType)(0),                     // 2: wallets.private.WalletChangeType
+	(SnapshotFormat)(0),                       // 3: wallets.private.SnapshotFormat
+	(MerkleHash)(0),                           // 4: wallets.private.MerkleHash
+	(LeafEncoding)(0),                         // 5: wallets.private.LeafEncoding
+	(MessageFormat)(0),                        // 6: wallets.private.MessageFormat
+	(WalletEventType)(0),                      // 7: wallets.private.WalletEventType
+	(WebhookDeliveryStatus)(0),                // 8: wallets.private.WebhookDeliveryStatus
+	(*GetWalletByUserIDRequest)(nil),          // 9: wallets.private.GetWalletByUserIDRequest
+	(*GetWalletByUserIDResponse)(nil),         // 10: wallets.private.GetWalletByUserIDResponse
+	(*Wallet)(nil),                            // 11: wallets.private.Wallet
+	(*GetWalletsByUserIDsRequest)(nil),        // 12: wallets.private.GetWalletsByUserIDsRequest
+	(*UserWalletResult)(nil),                  // 13: wallets.private.UserWalletResult
+	(*GetWalletsByUserIDsResponse)(nil),       // 14: wallets.private.GetWalletsByUserIDsResponse
+	(*GetWalletByPubkeyRequest)(nil),          // 15: wallets.private.GetWalletByPubkeyRequest
+	(*GetWalletByPubkeyResponse)(nil),         // 16: wallets.private.GetWalletByPubkeyResponse
+	(*GetUsersByPubkeysRequest)(nil),          // 17: wallets.private.GetUsersByPubkeysRequest
+	(*PubkeyWalletResult)(nil),                // 18: wallets.private.PubkeyWalletResult
+	(*GetUsersByPubkeysResponse)(nil),         // 19: wallets.private.GetUsersByPubkeysResponse
+	(*WatchWalletChangesRequest)(nil),         // 20: wallets.private.WatchWalletChangesRequest
+	(*WalletChange)(nil),                      // 21: wallets.private.WalletChange
+	(*WalletSnapshot)(nil),                    // 22: wallets.private.WalletSnapshot
+	(*CreateWalletSnapshotRequest)(nil),       // 23: wallets.private.CreateWalletSnapshotRequest
+	(*CreateWalletSnapshotResponse)(nil),      // 24: wallets.private.CreateWalletSnapshotResponse
+	(*GetWalletSnapshotRequest)(nil),          // 25: wallets.private.GetWalletSnapshotRequest
+	(*GetWalletSnapshotResponse)(nil),         // 26: wallets.private.GetWalletSnapshotResponse
+	(*ListWalletSnapshotsRequest)(nil),        // 27: wallets.private.ListWalletSnapshotsRequest
+	(*ListWalletSnapshotsResponse)(nil),       // 28: wallets.private.ListWalletSnapshotsResponse
+	(*ExportWalletSnapshotRequest)(nil),       // 29: wallets.private.ExportWalletSnapshotRequest
+	(*ExportWalletSnapshotChunk)(nil),         // 30: wallets.private.ExportWalletSnapshotChunk
+	(*Allowlist)(nil),                         // 31: wallets.private.Allowlist
+	(*CreateAllowlistRequest)(nil),            // 32: wallets.private.CreateAllowlistRequest
+	(*CreateAllowlistResponse)(nil),           // 33: wallets.private.CreateAllowlistResponse
+	(*GetAllowlistRootRequest)(nil),           // 34: wallets.private.GetAllowlistRootRequest
+	(*GetAllowlistRootResponse)(nil),          // 35: wallets.private.GetAllowlistRootResponse
+	(*CreateOwnershipChallengeRequest)(nil),   // 36: wallets.private.CreateOwnershipChallengeRequest
+	(*CreateOwnershipChallengeResponse)(nil),  // 37: wallets.private.CreateOwnershipChallengeResponse
+	(*VerifyOwnershipRequest)(nil),            // 38: wallets.private.VerifyOwnershipRequest
+	(*VerifyOwnershipResponse)(nil),           // 39: wallets.private.VerifyOwnershipResponse
+	(*VerificationProof)(nil),                 // 40: wallets.private.VerificationProof
+	(*GetVerificationProofsRequest)(nil),      // 41: wallets.private.GetVerificationProofsRequest
+	(*GetVerificationProofsResponse)(nil),     // 42: wallets.private.GetVerificationProofsResponse
+	(*WalletEvent)(nil),                       // 43: wallets.private.WalletEvent
+	(*GetWalletEventsRequest)(nil),            // 44: wallets.private.GetWalletEventsRequest
+	(*GetWalletEventsResponse)(nil),           // 45: wallets.private.GetWalletEventsResponse
+	(*WebhookSubscription)(nil),               // 46: wallets.private.WebhookSubscription
+	(*CreateWebhookSubscriptionRequest)(nil),  // 47: wallets.private.CreateWebhookSubscriptionRequest
+	(*CreateWebhookSubscriptionResponse)(nil), // 48: wallets.private.CreateWebhookSubscriptionResponse
+	(*ListWebhookSubscriptionsRequest)(nil),   // 49: wallets.private.ListWebhookSubscriptionsRequest
+	(*ListWebhookSubscriptionsResponse)(nil),  // 50: wallets.private.ListWebhookSubscriptionsResponse
+	(*DeleteWebhookSubscriptionRequest)(nil),  // 51: wallets.private.DeleteWebhookSubscriptionRequest
+	(*DeleteWebhookSubscriptionResponse)(nil), // 52: wallets.private.DeleteWebhookSubscriptionResponse
+	(*WebhookDelivery)(nil),                   // 53: wallets.private.WebhookDelivery
+	(*ListWebhookDeliveriesRequest)(nil),      // 54: wallets.private.ListWebhookDeliveriesRequest
+	(*ListWebhookDeliveriesResponse)(nil),     // 55: wallets.private.ListWebhookDeliveriesResponse
+	(*ReplayWebhookSubscriptionRequest)(nil),  // 56: wallets.private.ReplayWebhookSubscriptionRequest
+	(*ReplayWebhookSubscriptionResponse)(nil), // 57: wallets.private.ReplayWebhookSubscriptionResponse
+	nil, // 58: wallets.private.CreateAllowlistRequest.AllocationsEntry
+	nil, // 59: wallets.private.WalletEvent.MetadataEntry
+}
+var file_wallets_private_proto_depIdxs = []int32{
+	0,  // 0: wallets.private.GetWalletByUserIDResponse.provider:type_name -> wallets.private.Provider
+	1,  // 1: wallets.private.GetWalletByUserIDResponse.verification_status:type_name -> wallets.private.VerificationStatus
+	0,  // 2: wallets.private.Wallet.provider:type_name -> wallets.private.Provider
+	1,  // 3: wallets.private.Wallet.verification_status:type_name -> wallets.private.VerificationStatus
+	11, // 4: wallets.private.UserWalletResult.wallet:type_name -> wallets.private.Wallet
+	13, // 5: wallets.private.GetWalletsByUserIDsResponse.results:type_name -> wallets.private.UserWalletResult
+	11, // 6: wallets.private.GetWalletByPubkeyResponse.wallet:type_name -> wallets.private.Wallet
+	11, // 7: wallets.private.PubkeyWalletResult.wallet:type_name -> wallets.private.Wallet
+	18, // 8: wallets.private.GetUsersByPubkeysResponse.results:type_name -> wallets.private.PubkeyWalletResult
+	0,  // 9: wallets.private.WatchWalletChangesRequest.provider:type_name -> wallets.private.Provider
+	2,  // 10: wallets.private.WalletChange.type:type_name -> wallets.private.WalletChangeType
+	11, // 11: wallets.private.WalletChange.wallet:type_name -> wallets.private.Wallet
+	22, // 12: wallets.private.CreateWalletSnapshotResponse.snapshot:type_name -> wallets.private.WalletSnapshot
+	22, // 13: wallets.private.GetWalletSnapshotResponse.snapshot:type_name -> wallets.private.WalletSnapshot
+	22, // 14: wallets.private.ListWalletSnapshotsResponse.snapshots:type_name -> wallets.private.WalletSnapshot
+	3,  // 15: wallets.private.ExportWalletSnapshotRequest.format:type_name -> wallets.private.SnapshotFormat
+	4,  // 16: wallets.private.Allowlist.hash:type_name -> wallets.private.MerkleHash
+	5,  // 17: wallets.private.Allowlist.leaf_encoding:type_name -> wallets.private.LeafEncoding
+	4,  // 18: wallets.private.CreateAllowlistRequest.hash:type_name -> wallets.private.MerkleHash
+	5,  // 19: wallets.private.CreateAllowlistRequest.leaf_encoding:type_name -> wallets.private.LeafEncoding
+	0,  // 20: wallets.private.CreateAllowlistRequest.providers:type_name -> wallets.private.Provider
+	58, // 21: wallets.private.CreateAllowlistRequest.allocations:type_name -> wallets.private.CreateAllowlistRequest.AllocationsEntry
+	31, // 22: wallets.private.CreateAllowlistResponse.allowlist:type_name -> wallets.private.Allowlist
+	31, // 23: wallets.private.GetAllowlistRootResponse.allowlist:type_name -> wallets.private.Allowlist
+	6,  // 24: wallets.private.CreateOwnershipChallengeResponse.message_format:type_name -> wallets.private.MessageFormat
+	0,  // 25: wallets.private.VerifyOwnershipResponse.provider:type_name -> wallets.private.Provider
+	0,  // 26: wallets.private.VerificationProof.provider:type_name -> wallets.private.Provider
+	40, // 27: wallets.private.GetVerificationProofsResponse.proofs:type_name -> wallets.private.VerificationProof
+	7,  // 28: wallets.private.WalletEvent.type:type_name -> wallets.private.WalletEventType
+	0,  // 29: wallets.private.WalletEvent.provider:type_name -> wallets.private.Provider
+	59, // 30: wallets.private.WalletEvent.metadata:type_name -> wallets.private.WalletEvent.MetadataEntry
+	43, // 31: wallets.private.GetWalletEventsResponse.events:type_name -> wallets.private.WalletEvent
+	46, // 32: wallets.private.CreateWebhookSubscriptionResponse.subscription:type_name -> wallets.private.WebhookSubscription
+	46, // 33: wallets.private.ListWebhookSubscriptionsResponse.subscriptions:type_name -> wallets.private.WebhookSubscription
+	8,  // 34: wallets.private.WebhookDelivery.status:type_name -> wallets.private.WebhookDeliveryStatus
+	8,  // 35: wallets.private.ListWebhookDeliveriesRequest.status:type_name -> wallets.private.WebhookDeliveryStatus
+	53, // 36: wallets.private.ListWebhookDeliveriesResponse.deliveries:type_name -> wallets.private.WebhookDelivery
+	9,  // 37: wallets.private.WalletsPrivate.GetWalletByUserID:input_type -> wallets.private.GetWalletByUserIDRequest
+	12, // 38: wallets.private.WalletsPrivate.GetWalletsByUserIDs:input_type -> wallets.private.GetWalletsByUserIDsRequest
+	15, // 39: wallets.private.WalletsPrivate.GetWalletByPubkey:input_type -> wallets.private.GetWalletByPubkeyRequest
+	17, // 40: wallets.private.WalletsPrivate.GetUsersByPubkeys:input_type -> wallets.private.GetUsersByPubkeysRequest
+	20, // 41: wallets.private.WalletsPrivate.WatchWalletChanges:input_type -> wallets.private.WatchWalletChangesRequest
+	23, // 42: wallets.private.WalletsPrivate.CreateWalletSnapshot:input_type -> wallets.private.CreateWalletSnapshotRequest
+	25, // 43: wallets.private.WalletsPrivate.GetWalletSnapshot:input_type -> wallets.private.GetWalletSnapshotRequest
+	27, // 44: wallets.private.WalletsPrivate.ListWalletSnapshots:input_type -> wallets.private.ListWalletSnapshotsRequest
+	29, // 45: wallets.private.WalletsPrivate.ExportWalletSnapshot:input_type -> wallets.private.ExportWalletSnapshotRequest
+	32, // 46: wallets.private.WalletsPrivate.CreateAllowlist:input_type -> wallets.private.CreateAllowlistRequest
+	34, // 47: wallets.private.WalletsPrivate.GetAllowlistRoot:input_type -> wallets.private.GetAllowlistRootRequest
+	36, // 48: wallets.private.WalletsPrivate.CreateOwnershipChallenge:input_type -> wallets.private.CreateOwnershipChallengeRequest
+	38, // 49: wallets.private.WalletsPrivate.VerifyOwnership:input_type -> wallets.private.VerifyOwnershipRequest
+	41, // 50: wallets.private.WalletsPrivate.GetVerificationProofs:input_type -> wallets.private.GetVerificationProofsRequest
+	44, // 51: wallets.private.WalletsPrivate.GetWalletEvents:input_type -> wallets.private.GetWalletEventsRequest
+	47, // 52: wallets.private.WalletsPrivate.CreateWebhookSubscription:input_type -> wallets.private.CreateWebhookSubscriptionRequest
+	49, // 53: wallets.private.WalletsPrivate.ListWebhookSubscriptions:input_type -> wallets.private.ListWebhookSubscriptionsRequest
+	51, // 54: wallets.private.WalletsPrivate.DeleteWebhookSubscription:input_type -> wallets.private.DeleteWebhookSubscriptionRequest
+	54, // 55: wallets.private.WalletsPrivate.ListWebhookDeliveries:input_type -> wallets.private.ListWebhookDeliveriesRequest
+	56, // 56: wallets.private.WalletsPrivate.ReplayWebhookSubscription:input_type -> wallets.private.ReplayWebhookSubscriptionRequest
+	10, // 57: wallets.private.WalletsPrivate.GetWalletByUserID:output_type -> wallets.private.GetWalletByUserIDResponse
+	14, // 58: wallets.private.WalletsPrivate.GetWalletsByUserIDs:output_type -> wallets.private.GetWalletsByUserIDsResponse
+	16, // 59: wallets.private.WalletsPrivate.GetWalletByPubkey:output_type -> wallets.private.GetWalletByPubkeyResponse
+	19, // 60: wallets.private.WalletsPrivate.GetUsersByPubkeys:output_type -> wallets.private.GetUsersByPubkeysResponse
+	21, // 61: wallets.private.WalletsPrivate.WatchWalletChanges:output_type -> wallets.private.WalletChange
+	24, // 62: wallets.private.WalletsPrivate.CreateWalletSnapshot:output_type -> wallets.private.CreateWalletSnapshotResponse
+	26, // 63: wallets.private.WalletsPrivate.GetWalletSnapshot:output_type -> wallets.private.GetWalletSnapshotResponse
+	28, // 64: wallets.private.WalletsPrivate.ListWalletSnapshots:output_type -> wallets.private.ListWalletSnapshotsResponse
+	30, // 65: wallets.private.WalletsPrivate.ExportWalletSnapshot:output_type -> wallets.private.ExportWalletSnapshotChunk
+	33, // 66: wallets.private.WalletsPrivate.CreateAllowlist:output_type -> wallets.private.CreateAllowlistResponse
+	35, // 67: wallets.private.WalletsPrivate.GetAllowlistRoot:output_type -> wallets.private.GetAllowlistRootResponse
+	37, // 68: wallets.private.WalletsPrivate.CreateOwnershipChallenge:output_type -> wallets.private.CreateOwnershipChallengeResponse
+	39, // 69: wallets.private.WalletsPrivate.VerifyOwnership:output_type -> wallets.private.VerifyOwnershipResponse
+	42, // 70: wallets.private.WalletsPrivate.GetVerificationProofs:output_type -> wallets.private.GetVerificationProofsResponse
+	45, // 71: wallets.private.WalletsPrivate.GetWalletEvents:output_type -> wallets.private.GetWalletEventsResponse
+	48, // 72: wallets.private.WalletsPrivate.CreateWebhookSubscription:output_type -> wallets.private.CreateWebhookSubscriptionResponse
+	50, // 73: wallets.private.WalletsPrivate.ListWebhookSubscriptions:output_type -> wallets.private.ListWebhookSubscriptionsResponse
+	52, // 74: wallets.private.WalletsPrivate.DeleteWebhookSubscription:output_type -> wallets.private.DeleteWebhookSubscriptionResponse
+	55, // 75: wallets.private.WalletsPrivate.ListWebhookDeliveries:output_type -> wallets.private.ListWebhookDeliveriesResponse
+	57, // 76: wallets.private.WalletsPrivate.ReplayWebhookSubscription:output_type -> wallets.private.ReplayWebhookSubscriptionResponse
+	57, // [57:77] is the sub-list for method output_type
+	37, // [37:57] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
+}
+
+func init() { file_wallets_private_proto_init() }
+func file_wallets_private_proto_init() {
+	if File_wallets_private_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wallets_private_proto_rawDesc), len(file_wallets_private_proto_rawDesc)),
+			NumEnums:      9,
+			NumMessages:   51,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_wallets_private_proto_goTypes,
+		DependencyIndexes: file_wallets_private_proto_depIdxs,
+		EnumInfos:         file_wallets_private_proto_enumTypes,
+		MessageInfos:      file_wallets_private_proto_msgTypes,
+	}.Build()
+	File_wallets_private_proto = out.File
+	file_wallets_private_proto_goTypes = nil
+	file_wallets_private_proto_depIdxs = nil
+}
@@ -0,0 +1,854 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: wallets.private.proto
+
+package __
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	WalletsPrivate_GetWalletByUserID_FullMethodName         = "/wallets.private.WalletsPrivate/GetWalletByUserID"
+	WalletsPrivate_GetWalletsByUserIDs_FullMethodName       = "/wallets.private.WalletsPrivate/GetWalletsByUserIDs"
+	WalletsPrivate_GetWalletByPubkey_FullMethodName         = "/wallets.private.WalletsPrivate/GetWalletByPubkey"
+	WalletsPrivate_GetUsersByPubkeys_FullMethodName         = "/wallets.private.WalletsPrivate/GetUsersByPubkeys"
+	WalletsPrivate_WatchWalletChanges_FullMethodName        = "/wallets.private.WalletsPrivate/WatchWalletChanges"
+	WalletsPrivate_CreateWalletSnapshot_FullMethodName      = "/wallets.private.WalletsPrivate/CreateWalletSnapshot"
+	WalletsPrivate_GetWalletSnapshot_FullMethodName         = "/wallets.private.WalletsPrivate/GetWalletSnapshot"
+	WalletsPrivate_ListWalletSnapshots_FullMethodName       = "/wallets.private.WalletsPrivate/ListWalletSnapshots"
+	WalletsPrivate_ExportWalletSnapshot_FullMethodName      = "/wallets.private.WalletsPrivate/ExportWalletSnapshot"
+	WalletsPrivate_CreateAllowlist_FullMethodName           = "/wallets.private.WalletsPrivate/CreateAllowlist"
+	WalletsPrivate_GetAllowlistRoot_FullMethodName          = "/wallets.private.WalletsPrivate/GetAllowlistRoot"
+	WalletsPrivate_CreateOwnershipChallenge_FullMethodName  = "/wallets.private.WalletsPrivate/CreateOwnershipChallenge"
+	WalletsPrivate_VerifyOwnership_FullMethodName           = "/wallets.private.WalletsPrivate/VerifyOwnership"
+	WalletsPrivate_GetVerificationProofs_FullMethodName     = "/wallets.private.WalletsPrivate/GetVerificationProofs"
+	WalletsPrivate_GetWalletEvents_FullMethodName           = "/wallets.private.WalletsPrivate/GetWalletEvents"
+	WalletsPrivate_CreateWebhookSubscription_FullMethodName = "/wallets.private.WalletsPrivate/CreateWebhookSubscription"
+	WalletsPrivate_ListWebhookSubscriptions_FullMethodName  = "/wallets.private.WalletsPrivate/ListWebhookSubscriptions"
+	WalletsPrivate_DeleteWebhookSubscription_FullMethodName = "/wallets.private.WalletsPrivate/DeleteWebhookSubscription"
+	WalletsPrivate_ListWebhookDeliveries_FullMethodName     = "/wallets.private.WalletsPrivate/ListWebhookDeliveries"
+	WalletsPrivate_ReplayWebhookSubscription_FullMethodName = "/wallets.private.WalletsPrivate/ReplayWebhookSubscription"
+)
+
+// WalletsPrivateClient is the client API for WalletsPrivate service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WalletsPrivateClient interface {
+	GetWalletByUserID(ctx context.Context, in *GetWalletByUserIDRequest, opts ...grpc.CallOption) (*GetWalletByUserIDResponse, error)
+	GetWalletsByUserIDs(ctx context.Context, in *GetWalletsByUserIDsRequest, opts ...grpc.CallOption) (*GetWalletsByUserIDsResponse, error)
+	GetWalletByPubkey(ctx context.Context, in *GetWalletByPubkeyRequest, opts ...grpc.CallOption) (*GetWalletByPubkeyResponse, error)
+	GetUsersByPubkeys(ctx context.Context, in *GetUsersByPubkeysRequest, opts ...grpc.CallOption) (*GetUsersByPubkeysResponse, error)
+	// WatchWalletChanges ends with UNAVAILABLE when the server shuts down; reconnect with the last received
+	// sequence as from_sequence to resume.
+	WatchWalletChanges(ctx context.Context, in *WatchWalletChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WalletChange], error)
+	CreateWalletSnapshot(ctx context.Context, in *CreateWalletSnapshotRequest, opts ...grpc.CallOption) (*CreateWalletSnapshotResponse, error)
+	GetWalletSnapshot(ctx context.Context, in *GetWalletSnapshotRequest, opts ...grpc.CallOption) (*GetWalletSnapshotResponse, error)
+	ListWalletSnapshots(ctx context.Context, in *ListWalletSnapshotsRequest, opts ...grpc.CallOption) (*ListWalletSnapshotsResponse, error)
+	ExportWalletSnapshot(ctx context.Context, in *ExportWalletSnapshotRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportWalletSnapshotChunk], error)
+	CreateAllowlist(ctx context.Context, in *CreateAllowlistRequest, opts ...grpc.CallOption) (*CreateAllowlistResponse, error)
+	GetAllowlistRoot(ctx context.Context, in *GetAllowlistRootRequest, opts ...grpc.CallOption) (*GetAllowlistRootResponse, error)
+	CreateOwnershipChallenge(ctx context.Context, in *CreateOwnershipChallengeRequest, opts ...grpc.CallOption) (*CreateOwnershipChallengeResponse, error)
+	VerifyOwnership(ctx context.Context, in *VerifyOwnershipRequest, opts ...grpc.CallOption) (*VerifyOwnershipResponse, error)
+	GetVerificationProofs(ctx context.Context, in *GetVerificationProofsRequest, opts ...grpc.CallOption) (*GetVerificationProofsResponse, error)
+	GetWalletEvents(ctx context.Context, in *GetWalletEventsRequest, opts ...grpc.CallOption) (*GetWalletEventsResponse, error)
+	CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error)
+	ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error)
+	DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	ReplayWebhookSubscription(ctx context.Context, in *ReplayWebhookSubscriptionRequest, opts ...grpc.CallOption) (*ReplayWebhookSubscriptionResponse, error)
+}
+
+type walletsPrivateClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWalletsPrivateClient(cc grpc.ClientConnInterface) WalletsPrivateClient {
+	return &walletsPrivateClient{cc}
+}
+
+func (c *walletsPrivateClient) GetWalletByUserID(ctx context.Context, in *GetWalletByUserIDRequest, opts ...grpc.CallOption) (*GetWalletByUserIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletByUserIDResponse)
+	err := c.cc.Invoke(ctx, WalletsPrivate_GetWalletByUserID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsPrivateClient) GetWalletsByUserIDs(ctx context.Context, in *GetWalletsByUserIDsRequest, opts ...grpc.CallOption) (*GetWalletsByUserIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletsByUserIDsResponse)
+	err := c.cc.Invoke(ctx, WalletsPrivate_GetWalletsByUserIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsPrivateClient) GetWalletByPubkey(ctx context.Context, in *GetWalletByPubkeyRequest, opts ...grpc.CallOption) (*GetWalletByPubkeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletByPubkeyResponse)
+	err := c.cc.Invoke(ctx, WalletsPrivate_GetWalletByPubkey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsPrivateClient) GetUsersByPubkeys(ctx context.Context, in *GetUsersByPubkeysRequest, opts ...grpc.CallOption) (*GetUsersByPubkeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsersByPubkeysResponse)
+	err := c.cc.Invoke(ctx, WalletsPrivate_GetUsersByPubkeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsPrivateClient) WatchWalletChanges(ctx context.Context, in *WatchWalletChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WalletChange], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WalletsPrivate_ServiceDesc.Streams[0], WalletsPrivate_WatchWalletChanges_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchWalletChangesRequest, WalletChange]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WalletsPrivate_WatchWalletChangesClient = grpc.ServerStreamingClient[WalletChange]
+
+func (c *walletsPrivateClient) CreateWalletSnapshot(ctx context.Context, in *CreateWalletSnapshotRequest, opts ...grpc.CallOption) (*CreateWalletSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWalletSnapshotResponse)
+	err := c.cc.Invoke(ctx, WalletsPrivate_CreateWalletSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsPrivateClient) GetWalletSnapshot(ctx context.Context, in *GetWalletSnapshotRequest, opts ...grpc.CallOption) (*GetWalletSnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletSnapshotResponse)
+	err := c.cc.Invoke(ctx, WalletsPrivate_GetWalletSnapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsPrivateClient) ListWalletSnapshots(ctx context.Context, in *ListWalletSnapshotsRequest, opts ...grpc.CallOption) (*ListWalletSnapshotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWalletSnapshotsResponse)
+	err := c.cc.Invoke(ctx, WalletsPrivate_ListWalletSnapshots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsPrivateClient) ExportWalletSnapshot(ctx context.Context, in *ExportWalletSnapshotRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportWalletSnapshotChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WalletsPrivate_ServiceDesc.Streams[1], WalletsPrivate_ExportWalletSnapshot_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportWalletSnapshotRequest, ExportWalletSnapshotChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WalletsPrivate_ExportWalletSnapshotClient = grpc.ServerStreamingClient[ExportWalletSnapshotChunk]
+
+func (c *walletsPrivateClient) CreateAllowlist(ctx context.Context, in *CreateAllowlistRequest, opts ...grpc.CallOption) (*CreateAllowlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAllowlistResponse)
+	err := c.cc.Invoke(ctx, WalletsPrivate_CreateAllowlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsPrivateClient) GetAllowlistRoot(ctx context.Context, in *GetAllowlistRootRequest, opts ...grpc.CallOption) (*GetAllowlistRootResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllowlistRootResponse)
+	err := c.cc.Invoke(ctx, WalletsPrivate_GetAllowlistRoot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsPrivateClient) CreateOwnershipChallenge(ctx context.Context, in *CreateOwnershipChallengeRequest, opts ...grpc.CallOption) (*CreateOwnershipChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOwnershipChallengeResponse)
+	err := c.cc.Invoke(ctx, WalletsPrivate_CreateOwnershipChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsPrivateClient) VerifyOwnership(ctx context.Context, in *VerifyOwnershipRequest, opts ...grpc.CallOption) (*VerifyOwnershipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyOwnershipResponse)
+	err := c.cc.Invoke(ctx, WalletsPrivate_VerifyOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsPrivateClient) GetVerificationProofs(ctx context.Context, in *GetVerificationProofsRequest, opts ...grpc.CallOption) (*GetVerificationProofsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVerificationProofsResponse)
+	err := c.cc.Invoke(ctx, WalletsPrivate_GetVerificationProofs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsPrivateClient) GetWalletEvents(ctx context.Context, in *GetWalletEventsRequest, opts ...grpc.CallOption) (*GetWalletEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletEventsResponse)
+	err := c.cc.Invoke(ctx, WalletsPrivate_GetWalletEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsPrivateClient) CreateWebhookSubscription(ctx context.Context, in *CreateWebhookSubscriptionRequest, opts ...grpc.CallOption) (*CreateWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, WalletsPrivate_CreateWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsPrivateClient) ListWebhookSubscriptions(ctx context.Context, in *ListWebhookSubscriptionsRequest, opts ...grpc.CallOption) (*ListWebhookSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, WalletsPrivate_ListWebhookSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsPrivateClient) DeleteWebhookSubscription(ctx context.Context, in *DeleteWebhookSubscriptionRequest, opts ...grpc.CallOption) (*DeleteWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, WalletsPrivate_DeleteWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsPrivateClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, WalletsPrivate_ListWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsPrivateClient) ReplayWebhookSubscription(ctx context.Context, in *ReplayWebhookSubscriptionRequest, opts ...grpc.CallOption) (*ReplayWebhookSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayWebhookSubscriptionResponse)
+	err := c.cc.Invoke(ctx, WalletsPrivate_ReplayWebhookSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletsPrivateServer is the server API for WalletsPrivate service.
+// All implementations must embed UnimplementedWalletsPrivateServer
+// for forward compatibility.
+type WalletsPrivateServer interface {
+	GetWalletByUserID(context.Context, *GetWalletByUserIDRequest) (*GetWalletByUserIDResponse, error)
+	GetWalletsByUserIDs(context.Context, *GetWalletsByUserIDsRequest) (*GetWalletsByUserIDsResponse, error)
+	GetWalletByPubkey(context.Context, *GetWalletByPubkeyRequest) (*GetWalletByPubkeyResponse, error)
+	GetUsersByPubkeys(context.Context, *GetUsersByPubkeysRequest) (*GetUsersByPubkeysResponse, error)
+	// WatchWalletChanges ends with UNAVAILABLE when the server shuts down; reconnect with the last received
+	// sequence as from_sequence to resume.
+	WatchWalletChanges(*WatchWalletChangesRequest, grpc.ServerStreamingServer[WalletChange]) error
+	CreateWalletSnapshot(context.Context, *CreateWalletSnapshotRequest) (*CreateWalletSnapshotResponse, error)
+	GetWalletSnapshot(context.Context, *GetWalletSnapshotRequest) (*GetWalletSnapshotResponse, error)
+	ListWalletSnapshots(context.Context, *ListWalletSnapshotsRequest) (*ListWalletSnapshotsResponse, error)
+	ExportWalletSnapshot(*ExportWalletSnapshotRequest, grpc.ServerStreamingServer[ExportWalletSnapshotChunk]) error
+	CreateAllowlist(context.Context, *CreateAllowlistRequest) (*CreateAllowlistResponse, error)
+	GetAllowlistRoot(context.Context, *GetAllowlistRootRequest) (*GetAllowlistRootResponse, error)
+	CreateOwnershipChallenge(context.Context, *CreateOwnershipChallengeRequest) (*CreateOwnershipChallengeResponse, error)
+	VerifyOwnership(context.Context, *VerifyOwnershipRequest) (*VerifyOwnershipResponse, error)
+	GetVerificationProofs(context.Context, *GetVerificationProofsRequest) (*GetVerificationProofsResponse, error)
+	GetWalletEvents(context.Context, *GetWalletEventsRequest) (*GetWalletEventsResponse, error)
+	CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error)
+	ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error)
+	DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	ReplayWebhookSubscription(context.Context, *ReplayWebhookSubscriptionRequest) (*ReplayWebhookSubscriptionResponse, error)
+	mustEmbedUnimplementedWalletsPrivateServer()
+}
+
+// UnimplementedWalletsPrivateServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWalletsPrivateServer struct{}
+
+func (UnimplementedWalletsPrivateServer) GetWalletByUserID(context.Context, *GetWalletByUserIDRequest) (*GetWalletByUserIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWalletByUserID not implemented")
+}
+func (UnimplementedWalletsPrivateServer) GetWalletsByUserIDs(context.Context, *GetWalletsByUserIDsRequest) (*GetWalletsByUserIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWalletsByUserIDs not implemented")
+}
+func (UnimplementedWalletsPrivateServer) GetWalletByPubkey(context.Context, *GetWalletByPubkeyRequest) (*GetWalletByPubkeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWalletByPubkey not implemented")
+}
+func (UnimplementedWalletsPrivateServer) GetUsersByPubkeys(context.Context, *GetUsersByPubkeysRequest) (*GetUsersByPubkeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersByPubkeys not implemented")
+}
+func (UnimplementedWalletsPrivateServer) WatchWalletChanges(*WatchWalletChangesRequest, grpc.ServerStreamingServer[WalletChange]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchWalletChanges not implemented")
+}
+func (UnimplementedWalletsPrivateServer) CreateWalletSnapshot(context.Context, *CreateWalletSnapshotRequest) (*CreateWalletSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWalletSnapshot not implemented")
+}
+func (UnimplementedWalletsPrivateServer) GetWalletSnapshot(context.Context, *GetWalletSnapshotRequest) (*GetWalletSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWalletSnapshot not implemented")
+}
+func (UnimplementedWalletsPrivateServer) ListWalletSnapshots(context.Context, *ListWalletSnapshotsRequest) (*ListWalletSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWalletSnapshots not implemented")
+}
+func (UnimplementedWalletsPrivateServer) ExportWalletSnapshot(*ExportWalletSnapshotRequest, grpc.ServerStreamingServer[ExportWalletSnapshotChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportWalletSnapshot not implemented")
+}
+func (UnimplementedWalletsPrivateServer) CreateAllowlist(context.Context, *CreateAllowlistRequest) (*CreateAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAllowlist not implemented")
+}
+func (UnimplementedWalletsPrivateServer) GetAllowlistRoot(context.Context, *GetAllowlistRootRequest) (*GetAllowlistRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllowlistRoot not implemented")
+}
+func (UnimplementedWalletsPrivateServer) CreateOwnershipChallenge(context.Context, *CreateOwnershipChallengeRequest) (*CreateOwnershipChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOwnershipChallenge not implemented")
+}
+func (UnimplementedWalletsPrivateServer) VerifyOwnership(context.Context, *VerifyOwnershipRequest) (*VerifyOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyOwnership not implemented")
+}
+func (UnimplementedWalletsPrivateServer) GetVerificationProofs(context.Context, *GetVerificationProofsRequest) (*GetVerificationProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVerificationProofs not implemented")
+}
+func (UnimplementedWalletsPrivateServer) GetWalletEvents(context.Context, *GetWalletEventsRequest) (*GetWalletEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWalletEvents not implemented")
+}
+func (UnimplementedWalletsPrivateServer) CreateWebhookSubscription(context.Context, *CreateWebhookSubscriptionRequest) (*CreateWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhookSubscription not implemented")
+}
+func (UnimplementedWalletsPrivateServer) ListWebhookSubscriptions(context.Context, *ListWebhookSubscriptionsRequest) (*ListWebhookSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookSubscriptions not implemented")
+}
+func (UnimplementedWalletsPrivateServer) DeleteWebhookSubscription(context.Context, *DeleteWebhookSubscriptionRequest) (*DeleteWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhookSubscription not implemented")
+}
+func (UnimplementedWalletsPrivateServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedWalletsPrivateServer) ReplayWebhookSubscription(context.Context, *ReplayWebhookSubscriptionRequest) (*ReplayWebhookSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayWebhookSubscription not implemented")
+}
+func (UnimplementedWalletsPrivateServer) mustEmbedUnimplementedWalletsPrivateServer() {}
+func (UnimplementedWalletsPrivateServer) testEmbeddedByValue()                        {}
+
+// UnsafeWalletsPrivateServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WalletsPrivateServer will
+// result in compilation errors.
+type UnsafeWalletsPrivateServer interface {
+	mustEmbedUnimplementedWalletsPrivateServer()
+}
+
+func RegisterWalletsPrivateServer(s grpc.ServiceRegistrar, srv WalletsPrivateServer) {
+	// If the following call pancis, it indicates UnimplementedWalletsPrivateServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WalletsPrivate_ServiceDesc, srv)
+}
+
+func _WalletsPrivate_GetWalletByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletByUserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsPrivateServer).GetWalletByUserID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletsPrivate_GetWalletByUserID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsPrivateServer).GetWalletByUserID(ctx, req.(*GetWalletByUserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletsPrivate_GetWalletsByUserIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletsByUserIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsPrivateServer).GetWalletsByUserIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletsPrivate_GetWalletsByUserIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsPrivateServer).GetWalletsByUserIDs(ctx, req.(*GetWalletsByUserIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletsPrivate_GetWalletByPubkey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletByPubkeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsPrivateServer).GetWalletByPubkey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletsPrivate_GetWalletByPubkey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsPrivateServer).GetWalletByPubkey(ctx, req.(*GetWalletByPubkeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletsPrivate_GetUsersByPubkeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersByPubkeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsPrivateServer).GetUsersByPubkeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletsPrivate_GetUsersByPubkeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsPrivateServer).GetUsersByPubkeys(ctx, req.(*GetUsersByPubkeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletsPrivate_WatchWalletChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchWalletChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletsPrivateServer).WatchWalletChanges(m, &grpc.GenericServerStream[WatchWalletChangesRequest, WalletChange]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WalletsPrivate_WatchWalletChangesServer = grpc.ServerStreamingServer[WalletChange]
+
+func _WalletsPrivate_CreateWalletSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWalletSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsPrivateServer).CreateWalletSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletsPrivate_CreateWalletSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsPrivateServer).CreateWalletSnapshot(ctx, req.(*CreateWalletSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletsPrivate_GetWalletSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsPrivateServer).GetWalletSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletsPrivate_GetWalletSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsPrivateServer).GetWalletSnapshot(ctx, req.(*GetWalletSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletsPrivate_ListWalletSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWalletSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsPrivateServer).ListWalletSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletsPrivate_ListWalletSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsPrivateServer).ListWalletSnapshots(ctx, req.(*ListWalletSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletsPrivate_ExportWalletSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportWalletSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletsPrivateServer).ExportWalletSnapshot(m, &grpc.GenericServerStream[ExportWalletSnapshotRequest, ExportWalletSnapshotChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WalletsPrivate_ExportWalletSnapshotServer = grpc.ServerStreamingServer[ExportWalletSnapshotChunk]
+
+func _WalletsPrivate_CreateAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsPrivateServer).CreateAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletsPrivate_CreateAllowlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsPrivateServer).CreateAllowlist(ctx, req.(*CreateAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletsPrivate_GetAllowlistRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllowlistRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsPrivateServer).GetAllowlistRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletsPrivate_GetAllowlistRoot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsPrivateServer).GetAllowlistRoot(ctx, req.(*GetAllowlistRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletsPrivate_CreateOwnershipChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOwnershipChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsPrivateServer).CreateOwnershipChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletsPrivate_CreateOwnershipChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsPrivateServer).CreateOwnershipChallenge(ctx, req.(*CreateOwnershipChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletsPrivate_VerifyOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsPrivateServer).VerifyOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletsPrivate_VerifyOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsPrivateServer).VerifyOwnership(ctx, req.(*VerifyOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletsPrivate_GetVerificationProofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVerificationProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsPrivateServer).GetVerificationProofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletsPrivate_GetVerificationProofs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsPrivateServer).GetVerificationProofs(ctx, req.(*GetVerificationProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletsPrivate_GetWalletEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsPrivateServer).GetWalletEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletsPrivate_GetWalletEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsPrivateServer).GetWalletEvents(ctx, req.(*GetWalletEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletsPrivate_CreateWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsPrivateServer).CreateWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletsPrivate_CreateWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsPrivateServer).CreateWebhookSubscription(ctx, req.(*CreateWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletsPrivate_ListWebhookSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsPrivateServer).ListWebhookSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletsPrivate_ListWebhookSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsPrivateServer).ListWebhookSubscriptions(ctx, req.(*ListWebhookSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletsPrivate_DeleteWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsPrivateServer).DeleteWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletsPrivate_DeleteWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsPrivateServer).DeleteWebhookSubscription(ctx, req.(*DeleteWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletsPrivate_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsPrivateServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletsPrivate_ListWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsPrivateServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletsPrivate_ReplayWebhookSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayWebhookSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsPrivateServer).ReplayWebhookSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WalletsPrivate_ReplayWebhookSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsPrivateServer).ReplayWebhookSubscription(ctx, req.(*ReplayWebhookSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WalletsPrivate_ServiceDesc is the grpc.ServiceDesc for WalletsPrivate service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WalletsPrivate_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wallets.private.WalletsPrivate",
+	HandlerType: (*WalletsPrivateServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetWalletByUserID",
+			Handler:    _WalletsPrivate_GetWalletByUserID_Handler,
+		},
+		{
+			MethodName: "GetWalletsByUserIDs",
+			Handler:    _WalletsPrivate_GetWalletsByUserIDs_Handler,
+		},
+		{
+			MethodName: "GetWalletByPubkey",
+			Handler:    _WalletsPrivate_GetWalletByPubkey_Handler,
+		},
+		{
+			MethodName: "GetUsersByPubkeys",
+			Handler:    _WalletsPrivate_GetUsersByPubkeys_Handler,
+		},
+		{
+			MethodName: "CreateWalletSnapshot",
+			Handler:    _WalletsPrivate_CreateWalletSnapshot_Handler,
+		},
+		{
+			MethodName: "GetWalletSnapshot",
+			Handler:    _WalletsPrivate_GetWalletSnapshot_Handler,
+		},
+		{
+			MethodName: "ListWalletSnapshots",
+			Handler:    _WalletsPrivate_ListWalletSnapshots_Handler,
+		},
+		{
+			MethodName: "CreateAllowlist",
+			Handler:    _WalletsPrivate_CreateAllowlist_Handler,
+		},
+		{
+			MethodName: "GetAllowlistRoot",
+			Handler:    _WalletsPrivate_GetAllowlistRoot_Handler,
+		},
+		{
+			MethodName: "CreateOwnershipChallenge",
+			Handler:    _WalletsPrivate_CreateOwnershipChallenge_Handler,
+		},
+		{
+			MethodName: "VerifyOwnership",
+			Handler:    _WalletsPrivate_VerifyOwnership_Handler,
+		},
+		{
+			MethodName: "GetVerificationProofs",
+			Handler:    _WalletsPrivate_GetVerificationProofs_Handler,
+		},
+		{
+			MethodName: "GetWalletEvents",
+			Handler:    _WalletsPrivate_GetWalletEvents_Handler,
+		},
+		{
+			MethodName: "CreateWebhookSubscription",
+			Handler:    _WalletsPrivate_CreateWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookSubscriptions",
+			Handler:    _WalletsPrivate_ListWebhookSubscriptions_Handler,
+		},
+		{
+			MethodName: "DeleteWebhookSubscription",
+			Handler:    _WalletsPrivate_DeleteWebhookSubscription_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _WalletsPrivate_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "ReplayWebhookSubscription",
+			Handler:    _WalletsPrivate_ReplayWebhookSubscription_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchWalletChanges",
+			Handler:       _WalletsPrivate_WatchWalletChanges_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ExportWalletSnapshot",
+			Handler:       _WalletsPrivate_ExportWalletSnapshot_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "wallets.private.proto",
+}
@@ -0,0 +1,2966 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.3
+// source: wallets.public.proto
+
+package __
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Provider int32
+
+const (
+	Provider_PROVIDER_UNDEFINED       Provider = 0
+	Provider_PROVIDER_PHANTOM         Provider = 1
+	Provider_PROVIDER_STELLAR         Provider = 2
+	Provider_PROVIDER_COSMOS          Provider = 3
+	Provider_PROVIDER_APTOS           Provider = 4
+	Provider_PROVIDER_SUI             Provider = 5
+	Provider_PROVIDER_SOLFLARE        Provider = 6
+	Provider_PROVIDER_BACKPACK        Provider = 7
+	Provider_PROVIDER_GLOW            Provider = 8
+	Provider_PROVIDER_LEDGER          Provider = 9
+	Provider_PROVIDER_WALLET_STANDARD Provider = 10
+)
+
+// Enum value maps for Provider.
+var (
+	Provider_name = map[int32]string{
+		0:  "PROVIDER_UNDEFINED",
+		1:  "PROVIDER_PHANTOM",
+		2:  "PROVIDER_STELLAR",
+		3:  "PROVIDER_COSMOS",
+		4:  "PROVIDER_APTOS",
+		5:  "PROVIDER_SUI",
+		6:  "PROVIDER_SOLFLARE",
+		7:  "PROVIDER_BACKPACK",
+		8:  "PROVIDER_GLOW",
+		9:  "PROVIDER_LEDGER",
+		10: "PROVIDER_WALLET_STANDARD",
+	}
+	Provider_value = map[string]int32{
+		"PROVIDER_UNDEFINED":       0,
+		"PROVIDER_PHANTOM":         1,
+		"PROVIDER_STELLAR":         2,
+		"PROVIDER_COSMOS":          3,
+		"PROVIDER_APTOS":           4,
+		"PROVIDER_SUI":             5,
+		"PROVIDER_SOLFLARE":        6,
+		"PROVIDER_BACKPACK":        7,
+		"PROVIDER_GLOW":            8,
+		"PROVIDER_LEDGER":          9,
+		"PROVIDER_WALLET_STANDARD": 10,
+	}
+)
+
+func (x Provider) Enum() *Provider {
+	p := new(Provider)
+	*p = x
+	return p
+}
+
+func (x Provider) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Provider) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallets_public_proto_enumTypes[0].Descriptor()
+}
+
+func (Provider) Type() protoreflect.EnumType {
+	return &file_wallets_public_proto_enumTypes[0]
+}
+
+func (x Provider) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Provider.Descriptor instead.
+func (Provider) EnumDescriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{0}
+}
+
+type MessageFormat int32
+
+const (
+	MessageFormat_MESSAGE_FORMAT_NATIVE          MessageFormat = 0
+	MessageFormat_MESSAGE_FORMAT_TEXT            MessageFormat = 1
+	MessageFormat_MESSAGE_FORMAT_SIWS            MessageFormat = 2
+	MessageFormat_MESSAGE_FORMAT_SOLANA_OFFCHAIN MessageFormat = 3
+)
+
+// Enum value maps for MessageFormat.
+var (
+	MessageFormat_name = map[int32]string{
+		0: "MESSAGE_FORMAT_NATIVE",
+		1: "MESSAGE_FORMAT_TEXT",
+		2: "MESSAGE_FORMAT_SIWS",
+		3: "MESSAGE_FORMAT_SOLANA_OFFCHAIN",
+	}
+	MessageFormat_value = map[string]int32{
+		"MESSAGE_FORMAT_NATIVE":          0,
+		"MESSAGE_FORMAT_TEXT":            1,
+		"MESSAGE_FORMAT_SIWS":            2,
+		"MESSAGE_FORMAT_SOLANA_OFFCHAIN": 3,
+	}
+)
+
+func (x MessageFormat) Enum() *MessageFormat {
+	p := new(MessageFormat)
+	*p = x
+	return p
+}
+
+func (x MessageFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallets_public_proto_enumTypes[1].Descriptor()
+}
+
+func (MessageFormat) Type() protoreflect.EnumType {
+	return &file_wallets_public_proto_enumTypes[1]
+}
+
+func (x MessageFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageFormat.Descriptor instead.
+func (MessageFormat) EnumDescriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{1}
+}
+
+type VerificationStatus int32
+
+const (
+	VerificationStatus_VERIFICATION_STATUS_UNDEFINED     VerificationStatus = 0
+	VerificationStatus_VERIFICATION_STATUS_UNVERIFIED    VerificationStatus = 1
+	VerificationStatus_VERIFICATION_STATUS_VERIFIED      VerificationStatus = 2
+	VerificationStatus_VERIFICATION_STATUS_EXPIRING_SOON VerificationStatus = 3
+	VerificationStatus_VERIFICATION_STATUS_EXPIRED       VerificationStatus = 4
+)
+
+// Enum value maps for VerificationStatus.
+var (
+	VerificationStatus_name = map[int32]string{
+		0: "VERIFICATION_STATUS_UNDEFINED",
+		1: "VERIFICATION_STATUS_UNVERIFIED",
+		2: "VERIFICATION_STATUS_VERIFIED",
+		3: "VERIFICATION_STATUS_EXPIRING_SOON",
+		4: "VERIFICATION_STATUS_EXPIRED",
+	}
+	VerificationStatus_value = map[string]int32{
+		"VERIFICATION_STATUS_UNDEFINED":     0,
+		"VERIFICATION_STATUS_UNVERIFIED":    1,
+		"VERIFICATION_STATUS_VERIFIED":      2,
+		"VERIFICATION_STATUS_EXPIRING_SOON": 3,
+		"VERIFICATION_STATUS_EXPIRED":       4,
+	}
+)
+
+func (x VerificationStatus) Enum() *VerificationStatus {
+	p := new(VerificationStatus)
+	*p = x
+	return p
+}
+
+func (x VerificationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VerificationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallets_public_proto_enumTypes[2].Descriptor()
+}
+
+func (VerificationStatus) Type() protoreflect.EnumType {
+	return &file_wallets_public_proto_enumTypes[2]
+}
+
+func (x VerificationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VerificationStatus.Descriptor instead.
+func (VerificationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{2}
+}
+
+type ReclaimStatus int32
+
+const (
+	ReclaimStatus_RECLAIM_STATUS_UNDEFINED ReclaimStatus = 0
+	ReclaimStatus_RECLAIM_STATUS_PENDING   ReclaimStatus = 1
+	ReclaimStatus_RECLAIM_STATUS_CONTESTED ReclaimStatus = 2
+	ReclaimStatus_RECLAIM_STATUS_COMPLETED ReclaimStatus = 3
+	ReclaimStatus_RECLAIM_STATUS_CANCELLED ReclaimStatus = 4
+)
+
+// Enum value maps for ReclaimStatus.
+var (
+	ReclaimStatus_name = map[int32]string{
+		0: "RECLAIM_STATUS_UNDEFINED",
+		1: "RECLAIM_STATUS_PENDING",
+		2: "RECLAIM_STATUS_CONTESTED",
+		3: "RECLAIM_STATUS_COMPLETED",
+		4: "RECLAIM_STATUS_CANCELLED",
+	}
+	ReclaimStatus_value = map[string]int32{
+		"RECLAIM_STATUS_UNDEFINED": 0,
+		"RECLAIM_STATUS_PENDING":   1,
+		"RECLAIM_STATUS_CONTESTED": 2,
+		"RECLAIM_STATUS_COMPLETED": 3,
+		"RECLAIM_STATUS_CANCELLED": 4,
+	}
+)
+
+func (x ReclaimStatus) Enum() *ReclaimStatus {
+	p := new(ReclaimStatus)
+	*p = x
+	return p
+}
+
+func (x ReclaimStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReclaimStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallets_public_proto_enumTypes[3].Descriptor()
+}
+
+func (ReclaimStatus) Type() protoreflect.EnumType {
+	return &file_wallets_public_proto_enumTypes[3]
+}
+
+func (x ReclaimStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReclaimStatus.Descriptor instead.
+func (ReclaimStatus) EnumDescriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{3}
+}
+
+type TransferStatus int32
+
+const (
+	TransferStatus_TRANSFER_STATUS_UNDEFINED TransferStatus = 0
+	TransferStatus_TRANSFER_STATUS_PENDING   TransferStatus = 1
+	TransferStatus_TRANSFER_STATUS_ACCEPTED  TransferStatus = 2
+	TransferStatus_TRANSFER_STATUS_COMPLETED TransferStatus = 3
+	TransferStatus_TRANSFER_STATUS_CANCELLED TransferStatus = 4
+	TransferStatus_TRANSFER_STATUS_EXPIRED   TransferStatus = 5
+)
+
+// Enum value maps for TransferStatus.
+var (
+	TransferStatus_name = map[int32]string{
+		0: "TRANSFER_STATUS_UNDEFINED",
+		1: "TRANSFER_STATUS_PENDING",
+		2: "TRANSFER_STATUS_ACCEPTED",
+		3: "TRANSFER_STATUS_COMPLETED",
+		4: "TRANSFER_STATUS_CANCELLED",
+		5: "TRANSFER_STATUS_EXPIRED",
+	}
+	TransferStatus_value = map[string]int32{
+		"TRANSFER_STATUS_UNDEFINED": 0,
+		"TRANSFER_STATUS_PENDING":   1,
+		"TRANSFER_STATUS_ACCEPTED":  2,
+		"TRANSFER_STATUS_COMPLETED": 3,
+		"TRANSFER_STATUS_CANCELLED": 4,
+		"TRANSFER_STATUS_EXPIRED":   5,
+	}
+)
+
+func (x TransferStatus) Enum() *TransferStatus {
+	p := new(TransferStatus)
+	*p = x
+	return p
+}
+
+func (x TransferStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallets_public_proto_enumTypes[4].Descriptor()
+}
+
+func (TransferStatus) Type() protoreflect.EnumType {
+	return &file_wallets_public_proto_enumTypes[4]
+}
+
+func (x TransferStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferStatus.Descriptor instead.
+func (TransferStatus) EnumDescriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{4}
+}
+
+type WalletEventType int32
+
+const (
+	WalletEventType_WALLET_EVENT_TYPE_UNDEFINED WalletEventType = 0
+	WalletEventType_WALLET_EVENT_TYPE_ADDED     WalletEventType = 1
+	WalletEventType_WALLET_EVENT_TYPE_VERIFIED  WalletEventType = 2
+	WalletEventType_WALLET_EVENT_TYPE_UNLINKED  WalletEventType = 3
+)
+
+// Enum value maps for WalletEventType.
+var (
+	WalletEventType_name = map[int32]string{
+		0: "WALLET_EVENT_TYPE_UNDEFINED",
+		1: "WALLET_EVENT_TYPE_ADDED",
+		2: "WALLET_EVENT_TYPE_VERIFIED",
+		3: "WALLET_EVENT_TYPE_UNLINKED",
+	}
+	WalletEventType_value = map[string]int32{
+		"WALLET_EVENT_TYPE_UNDEFINED": 0,
+		"WALLET_EVENT_TYPE_ADDED":     1,
+		"WALLET_EVENT_TYPE_VERIFIED":  2,
+		"WALLET_EVENT_TYPE_UNLINKED":  3,
+	}
+)
+
+func (x WalletEventType) Enum() *WalletEventType {
+	p := new(WalletEventType)
+	*p = x
+	return p
+}
+
+func (x WalletEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WalletEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallets_public_proto_enumTypes[5].Descriptor()
+}
+
+func (WalletEventType) Type() protoreflect.EnumType {
+	return &file_wallets_public_proto_enumTypes[5]
+}
+
+func (x WalletEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WalletEventType.Descriptor instead.
+func (WalletEventType) EnumDescriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{5}
+}
+
+type MerkleHash int32
+
+const (
+	MerkleHash_MERKLE_HASH_UNDEFINED MerkleHash = 0
+	// keccak256 is the legacy Keccak-256 of the EVM.
+	MerkleHash_MERKLE_HASH_KECCAK256 MerkleHash = 1
+	MerkleHash_MERKLE_HASH_SHA256    MerkleHash = 2
+)
+
+// Enum value maps for MerkleHash.
+var (
+	MerkleHash_name = map[int32]string{
+		0: "MERKLE_HASH_UNDEFINED",
+		1: "MERKLE_HASH_KECCAK256",
+		2: "MERKLE_HASH_SHA256",
+	}
+	MerkleHash_value = map[string]int32{
+		"MERKLE_HASH_UNDEFINED": 0,
+		"MERKLE_HASH_KECCAK256": 1,
+		"MERKLE_HASH_SHA256":    2,
+	}
+)
+
+func (x MerkleHash) Enum() *MerkleHash {
+	p := new(MerkleHash)
+	*p = x
+	return p
+}
+
+func (x MerkleHash) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MerkleHash) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallets_public_proto_enumTypes[6].Descriptor()
+}
+
+func (MerkleHash) Type() protoreflect.EnumType {
+	return &file_wallets_public_proto_enumTypes[6]
+}
+
+func (x MerkleHash) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MerkleHash.Descriptor instead.
+func (MerkleHash) EnumDescriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{6}
+}
+
+type LeafEncoding int32
+
+const (
+	LeafEncoding_LEAF_ENCODING_UNDEFINED LeafEncoding = 0
+	// The leaf is the hash of the raw address bytes.
+	LeafEncoding_LEAF_ENCODING_ADDRESS LeafEncoding = 1
+	// The leaf is the hash of the address followed by the amount as a little-endian uint64.
+	LeafEncoding_LEAF_ENCODING_ADDRESS_AMOUNT_U64LE LeafEncoding = 2
+	// The leaf is the hash of the address followed by the amount as a big-endian uint256.
+	LeafEncoding_LEAF_ENCODING_ADDRESS_AMOUNT_U256BE LeafEncoding = 3
+)
+
+// Enum value maps for LeafEncoding.
+var (
+	LeafEncoding_name = map[int32]string{
+		0: "LEAF_ENCODING_UNDEFINED",
+		1: "LEAF_ENCODING_ADDRESS",
+		2: "LEAF_ENCODING_ADDRESS_AMOUNT_U64LE",
+		3: "LEAF_ENCODING_ADDRESS_AMOUNT_U256BE",
+	}
+	LeafEncoding_value = map[string]int32{
+		"LEAF_ENCODING_UNDEFINED":             0,
+		"LEAF_ENCODING_ADDRESS":               1,
+		"LEAF_ENCODING_ADDRESS_AMOUNT_U64LE":  2,
+		"LEAF_ENCODING_ADDRESS_AMOUNT_U256BE": 3,
+	}
+)
+
+func (x LeafEncoding) Enum() *LeafEncoding {
+	p := new(LeafEncoding)
+	*p = x
+	return p
+}
+
+func (x LeafEncoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeafEncoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_wallets_public_proto_enumTypes[7].Descriptor()
+}
+
+func (LeafEncoding) Type() protoreflect.EnumType {
+	return &file_wallets_public_proto_enumTypes[7]
+}
+
+func (x LeafEncoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeafEncoding.Descriptor instead.
+func (LeafEncoding) EnumDescriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{7}
+}
+
+type AddWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pubkey        string                 `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Provider      Provider               `protobuf:"varint,2,opt,name=provider,proto3,enum=wallets.public.Provider" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWalletRequest) Reset() {
+	*x = AddWalletRequest{}
+	mi := &file_wallets_public_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWalletRequest) ProtoMessage() {}
+
+func (x *AddWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWalletRequest.ProtoReflect.Descriptor instead.
+func (*AddWalletRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AddWalletRequest) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *AddWalletRequest) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_PROVIDER_UNDEFINED
+}
+
+type AddWalletResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	MessageToSign string                 `protobuf:"bytes,2,opt,name=message_to_sign,json=messageToSign,proto3" json:"message_to_sign,omitempty"`
+	MessageFormat MessageFormat          `protobuf:"varint,3,opt,name=message_format,json=messageFormat,proto3,enum=wallets.public.MessageFormat" json:"message_format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddWalletResponse) Reset() {
+	*x = AddWalletResponse{}
+	mi := &file_wallets_public_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddWalletResponse) ProtoMessage() {}
+
+func (x *AddWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddWalletResponse.ProtoReflect.Descriptor instead.
+func (*AddWalletResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddWalletResponse) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *AddWalletResponse) GetMessageToSign() string {
+	if x != nil {
+		return x.MessageToSign
+	}
+	return ""
+}
+
+func (x *AddWalletResponse) GetMessageFormat() MessageFormat {
+	if x != nil {
+		return x.MessageFormat
+	}
+	return MessageFormat_MESSAGE_FORMAT_NATIVE
+}
+
+type VerifyWalletRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Signature   string                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Pubkey      string                 `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// issue_attestation asks for a signed wallet-ownership attestation once the wallet is verified.
+	// The request is rejected before the challenge is redeemed if attestations are not configured.
+	IssueAttestation bool `protobuf:"varint,4,opt,name=issue_attestation,json=issueAttestation,proto3" json:"issue_attestation,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *VerifyWalletRequest) Reset() {
+	*x = VerifyWalletRequest{}
+	mi := &file_wallets_public_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyWalletRequest) ProtoMessage() {}
+
+func (x *VerifyWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyWalletRequest.ProtoReflect.Descriptor instead.
+func (*VerifyWalletRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VerifyWalletRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *VerifyWalletRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *VerifyWalletRequest) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *VerifyWalletRequest) GetIssueAttestation() bool {
+	if x != nil {
+		return x.IssueAttestation
+	}
+	return false
+}
+
+type VerifyWalletResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// attestation is set if it was requested and could be minted; otherwise fetch it with GetWalletAttestation.
+	// See GetWalletAttestationResponse.
+	Attestation   *WalletAttestation `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyWalletResponse) Reset() {
+	*x = VerifyWalletResponse{}
+	mi := &file_wallets_public_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyWalletResponse) ProtoMessage() {}
+
+func (x *VerifyWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyWalletResponse.ProtoReflect.Descriptor instead.
+func (*VerifyWalletResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VerifyWalletResponse) GetAttestation() *WalletAttestation {
+	if x != nil {
+		return x.Attestation
+	}
+	return nil
+}
+
+// WalletAttestation is a compact JWS signed with EdDSA (Ed25519), verifiable offline against the keys
+// served at /.well-known/jwks.json. Its claims are iss, sub, user_id, pubkey, provider, verified_at, iat and exp.
+type WalletAttestation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// expires_at is a unix timestamp (seconds).
+	ExpiresAt     int64 `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletAttestation) Reset() {
+	*x = WalletAttestation{}
+	mi := &file_wallets_public_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletAttestation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletAttestation) ProtoMessage() {}
+
+func (x *WalletAttestation) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletAttestation.ProtoReflect.Descriptor instead.
+func (*WalletAttestation) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *WalletAttestation) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *WalletAttestation) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type GetWalletAttestationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletAttestationRequest) Reset() {
+	*x = GetWalletAttestationRequest{}
+	mi := &file_wallets_public_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletAttestationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletAttestationRequest) ProtoMessage() {}
+
+func (x *GetWalletAttestationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletAttestationRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletAttestationRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{5}
+}
+
+type GetWalletAttestationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attestation   *WalletAttestation     `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletAttestationResponse) Reset() {
+	*x = GetWalletAttestationResponse{}
+	mi := &file_wallets_public_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletAttestationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletAttestationResponse) ProtoMessage() {}
+
+func (x *GetWalletAttestationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletAttestationResponse.ProtoReflect.Descriptor instead.
+func (*GetWalletAttestationResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetWalletAttestationResponse) GetAttestation() *WalletAttestation {
+	if x != nil {
+		return x.Attestation
+	}
+	return nil
+}
+
+type UnlinkWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      uint64                 `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkWalletRequest) Reset() {
+	*x = UnlinkWalletRequest{}
+	mi := &file_wallets_public_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkWalletRequest) ProtoMessage() {}
+
+func (x *UnlinkWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkWalletRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkWalletRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UnlinkWalletRequest) GetWalletId() uint64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+type UnlinkWalletResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// confirmation_required is true when the wallet was not deleted yet and the challenge
+	// must be signed and passed to ConfirmUnlink.
+	ConfirmationRequired bool          `protobuf:"varint,1,opt,name=confirmation_required,json=confirmationRequired,proto3" json:"confirmation_required,omitempty"`
+	ChallengeId          string        `protobuf:"bytes,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	MessageToSign        string        `protobuf:"bytes,3,opt,name=message_to_sign,json=messageToSign,proto3" json:"message_to_sign,omitempty"`
+	MessageFormat        MessageFormat `protobuf:"varint,4,opt,name=message_format,json=messageFormat,proto3,enum=wallets.public.MessageFormat" json:"message_format,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UnlinkWalletResponse) Reset() {
+	*x = UnlinkWalletResponse{}
+	mi := &file_wallets_public_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkWalletResponse) ProtoMessage() {}
+
+func (x *UnlinkWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkWalletResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkWalletResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnlinkWalletResponse) GetConfirmationRequired() bool {
+	if x != nil {
+		return x.ConfirmationRequired
+	}
+	return false
+}
+
+func (x *UnlinkWalletResponse) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *UnlinkWalletResponse) GetMessageToSign() string {
+	if x != nil {
+		return x.MessageToSign
+	}
+	return ""
+}
+
+func (x *UnlinkWalletResponse) GetMessageFormat() MessageFormat {
+	if x != nil {
+		return x.MessageFormat
+	}
+	return MessageFormat_MESSAGE_FORMAT_NATIVE
+}
+
+type ConfirmUnlinkRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	// signature of message_to_sign by the wallet; ignored if second_factor_code is set.
+	Signature        string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	SecondFactorCode string `protobuf:"bytes,3,opt,name=second_factor_code,json=secondFactorCode,proto3" json:"second_factor_code,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ConfirmUnlinkRequest) Reset() {
+	*x = ConfirmUnlinkRequest{}
+	mi := &file_wallets_public_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmUnlinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmUnlinkRequest) ProtoMessage() {}
+
+func (x *ConfirmUnlinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmUnlinkRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmUnlinkRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ConfirmUnlinkRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *ConfirmUnlinkRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *ConfirmUnlinkRequest) GetSecondFactorCode() string {
+	if x != nil {
+		return x.SecondFactorCode
+	}
+	return ""
+}
+
+type ConfirmUnlinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmUnlinkResponse) Reset() {
+	*x = ConfirmUnlinkResponse{}
+	mi := &file_wallets_public_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmUnlinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmUnlinkResponse) ProtoMessage() {}
+
+func (x *ConfirmUnlinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmUnlinkResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmUnlinkResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{10}
+}
+
+type GetWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletRequest) Reset() {
+	*x = GetWalletRequest{}
+	mi := &file_wallets_public_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletRequest) ProtoMessage() {}
+
+func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{11}
+}
+
+type GetWalletResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider Provider               `protobuf:"varint,2,opt,name=provider,proto3,enum=wallets.public.Provider" json:"provider,omitempty"`
+	// is_verified is true while the verification is valid, including when it is expiring soon.
+	IsVerified         bool               `protobuf:"varint,3,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	VerificationStatus VerificationStatus `protobuf:"varint,4,opt,name=verification_status,json=verificationStatus,proto3,enum=wallets.public.VerificationStatus" json:"verification_status,omitempty"`
+	// verification_expires_at is a unix timestamp (seconds); 0 if the wallet is unverified or verifications never expire.
+	VerificationExpiresAt int64 `protobuf:"varint,5,opt,name=verification_expires_at,json=verificationExpiresAt,proto3" json:"verification_expires_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetWalletResponse) Reset() {
+	*x = GetWalletResponse{}
+	mi := &file_wallets_public_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletResponse) ProtoMessage() {}
+
+func (x *GetWalletResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletResponse.ProtoReflect.Descriptor instead.
+func (*GetWalletResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetWalletResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetWalletResponse) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_PROVIDER_UNDEFINED
+}
+
+func (x *GetWalletResponse) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *GetWalletResponse) GetVerificationStatus() VerificationStatus {
+	if x != nil {
+		return x.VerificationStatus
+	}
+	return VerificationStatus_VERIFICATION_STATUS_UNDEFINED
+}
+
+func (x *GetWalletResponse) GetVerificationExpiresAt() int64 {
+	if x != nil {
+		return x.VerificationExpiresAt
+	}
+	return 0
+}
+
+type Reclaim struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pubkey   string                 `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Provider Provider               `protobuf:"varint,3,opt,name=provider,proto3,enum=wallets.public.Provider" json:"provider,omitempty"`
+	Status   ReclaimStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=wallets.public.ReclaimStatus" json:"status,omitempty"`
+	// available_at is a unix timestamp (seconds) after which the claimant can complete the reclaim.
+	AvailableAt int64 `protobuf:"varint,5,opt,name=available_at,json=availableAt,proto3" json:"available_at,omitempty"`
+	// is_incoming is true when the caller is the current owner the wallet is being reclaimed from.
+	IsIncoming    bool `protobuf:"varint,6,opt,name=is_incoming,json=isIncoming,proto3" json:"is_incoming,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reclaim) Reset() {
+	*x = Reclaim{}
+	mi := &file_wallets_public_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reclaim) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reclaim) ProtoMessage() {}
+
+func (x *Reclaim) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reclaim.ProtoReflect.Descriptor instead.
+func (*Reclaim) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Reclaim) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Reclaim) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *Reclaim) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_PROVIDER_UNDEFINED
+}
+
+func (x *Reclaim) GetStatus() ReclaimStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReclaimStatus_RECLAIM_STATUS_UNDEFINED
+}
+
+func (x *Reclaim) GetAvailableAt() int64 {
+	if x != nil {
+		return x.AvailableAt
+	}
+	return 0
+}
+
+func (x *Reclaim) GetIsIncoming() bool {
+	if x != nil {
+		return x.IsIncoming
+	}
+	return false
+}
+
+type RequestReclaimRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pubkey        string                 `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Provider      Provider               `protobuf:"varint,2,opt,name=provider,proto3,enum=wallets.public.Provider" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReclaimRequest) Reset() {
+	*x = RequestReclaimRequest{}
+	mi := &file_wallets_public_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReclaimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReclaimRequest) ProtoMessage() {}
+
+func (x *RequestReclaimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReclaimRequest.ProtoReflect.Descriptor instead.
+func (*RequestReclaimRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RequestReclaimRequest) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *RequestReclaimRequest) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_PROVIDER_UNDEFINED
+}
+
+type RequestReclaimResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	MessageToSign string                 `protobuf:"bytes,2,opt,name=message_to_sign,json=messageToSign,proto3" json:"message_to_sign,omitempty"`
+	MessageFormat MessageFormat          `protobuf:"varint,3,opt,name=message_format,json=messageFormat,proto3,enum=wallets.public.MessageFormat" json:"message_format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestReclaimResponse) Reset() {
+	*x = RequestReclaimResponse{}
+	mi := &file_wallets_public_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestReclaimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestReclaimResponse) ProtoMessage() {}
+
+func (x *RequestReclaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestReclaimResponse.ProtoReflect.Descriptor instead.
+func (*RequestReclaimResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RequestReclaimResponse) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *RequestReclaimResponse) GetMessageToSign() string {
+	if x != nil {
+		return x.MessageToSign
+	}
+	return ""
+}
+
+func (x *RequestReclaimResponse) GetMessageFormat() MessageFormat {
+	if x != nil {
+		return x.MessageFormat
+	}
+	return MessageFormat_MESSAGE_FORMAT_NATIVE
+}
+
+type ConfirmReclaimRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Signature     string                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmReclaimRequest) Reset() {
+	*x = ConfirmReclaimRequest{}
+	mi := &file_wallets_public_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmReclaimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmReclaimRequest) ProtoMessage() {}
+
+func (x *ConfirmReclaimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmReclaimRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmReclaimRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ConfirmReclaimRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *ConfirmReclaimRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type ConfirmReclaimResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reclaim       *Reclaim               `protobuf:"bytes,1,opt,name=reclaim,proto3" json:"reclaim,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmReclaimResponse) Reset() {
+	*x = ConfirmReclaimResponse{}
+	mi := &file_wallets_public_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmReclaimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmReclaimResponse) ProtoMessage() {}
+
+func (x *ConfirmReclaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmReclaimResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmReclaimResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ConfirmReclaimResponse) GetReclaim() *Reclaim {
+	if x != nil {
+		return x.Reclaim
+	}
+	return nil
+}
+
+type ContestReclaimRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReclaimId     uint64                 `protobuf:"varint,1,opt,name=reclaim_id,json=reclaimId,proto3" json:"reclaim_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContestReclaimRequest) Reset() {
+	*x = ContestReclaimRequest{}
+	mi := &file_wallets_public_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContestReclaimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContestReclaimRequest) ProtoMessage() {}
+
+func (x *ContestReclaimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContestReclaimRequest.ProtoReflect.Descriptor instead.
+func (*ContestReclaimRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ContestReclaimRequest) GetReclaimId() uint64 {
+	if x != nil {
+		return x.ReclaimId
+	}
+	return 0
+}
+
+type ContestReclaimResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContestReclaimResponse) Reset() {
+	*x = ContestReclaimResponse{}
+	mi := &file_wallets_public_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContestReclaimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContestReclaimResponse) ProtoMessage() {}
+
+func (x *ContestReclaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContestReclaimResponse.ProtoReflect.Descriptor instead.
+func (*ContestReclaimResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{19}
+}
+
+type CompleteReclaimRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReclaimId     uint64                 `protobuf:"varint,1,opt,name=reclaim_id,json=reclaimId,proto3" json:"reclaim_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteReclaimRequest) Reset() {
+	*x = CompleteReclaimRequest{}
+	mi := &file_wallets_public_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteReclaimRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteReclaimRequest) ProtoMessage() {}
+
+func (x *CompleteReclaimRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteReclaimRequest.ProtoReflect.Descriptor instead.
+func (*CompleteReclaimRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CompleteReclaimRequest) GetReclaimId() uint64 {
+	if x != nil {
+		return x.ReclaimId
+	}
+	return 0
+}
+
+type CompleteReclaimResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteReclaimResponse) Reset() {
+	*x = CompleteReclaimResponse{}
+	mi := &file_wallets_public_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteReclaimResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteReclaimResponse) ProtoMessage() {}
+
+func (x *CompleteReclaimResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteReclaimResponse.ProtoReflect.Descriptor instead.
+func (*CompleteReclaimResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{21}
+}
+
+type GetReclaimsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReclaimsRequest) Reset() {
+	*x = GetReclaimsRequest{}
+	mi := &file_wallets_public_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReclaimsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReclaimsRequest) ProtoMessage() {}
+
+func (x *GetReclaimsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReclaimsRequest.ProtoReflect.Descriptor instead.
+func (*GetReclaimsRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{22}
+}
+
+type GetReclaimsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reclaims      []*Reclaim             `protobuf:"bytes,1,rep,name=reclaims,proto3" json:"reclaims,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReclaimsResponse) Reset() {
+	*x = GetReclaimsResponse{}
+	mi := &file_wallets_public_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReclaimsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReclaimsResponse) ProtoMessage() {}
+
+func (x *GetReclaimsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReclaimsResponse.ProtoReflect.Descriptor instead.
+func (*GetReclaimsResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetReclaimsResponse) GetReclaims() []*Reclaim {
+	if x != nil {
+		return x.Reclaims
+	}
+	return nil
+}
+
+type Transfer struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WalletId uint64                 `protobuf:"varint,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Pubkey   string                 `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Provider Provider               `protobuf:"varint,4,opt,name=provider,proto3,enum=wallets.public.Provider" json:"provider,omitempty"`
+	Status   TransferStatus         `protobuf:"varint,5,opt,name=status,proto3,enum=wallets.public.TransferStatus" json:"status,omitempty"`
+	// expires_at is a unix timestamp (seconds) after which the transfer can no longer be accepted or completed.
+	ExpiresAt int64 `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// is_incoming is true when the caller is the account the wallet is being transferred to.
+	IsIncoming    bool `protobuf:"varint,7,opt,name=is_incoming,json=isIncoming,proto3" json:"is_incoming,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	mi := &file_wallets_public_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transfer) ProtoMessage() {}
+
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Transfer) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Transfer) GetWalletId() uint64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *Transfer) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *Transfer) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_PROVIDER_UNDEFINED
+}
+
+func (x *Transfer) GetStatus() TransferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransferStatus_TRANSFER_STATUS_UNDEFINED
+}
+
+func (x *Transfer) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *Transfer) GetIsIncoming() bool {
+	if x != nil {
+		return x.IsIncoming
+	}
+	return false
+}
+
+type InitiateTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WalletId      uint64                 `protobuf:"varint,1,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	ToUserId      uint64                 `protobuf:"varint,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitiateTransferRequest) Reset() {
+	*x = InitiateTransferRequest{}
+	mi := &file_wallets_public_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiateTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateTransferRequest) ProtoMessage() {}
+
+func (x *InitiateTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateTransferRequest.ProtoReflect.Descriptor instead.
+func (*InitiateTransferRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *InitiateTransferRequest) GetWalletId() uint64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *InitiateTransferRequest) GetToUserId() uint64 {
+	if x != nil {
+		return x.ToUserId
+	}
+	return 0
+}
+
+type InitiateTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitiateTransferResponse) Reset() {
+	*x = InitiateTransferResponse{}
+	mi := &file_wallets_public_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitiateTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitiateTransferResponse) ProtoMessage() {}
+
+func (x *InitiateTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitiateTransferResponse.ProtoReflect.Descriptor instead.
+func (*InitiateTransferResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *InitiateTransferResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type AcceptTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    uint64                 `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTransferRequest) Reset() {
+	*x = AcceptTransferRequest{}
+	mi := &file_wallets_public_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTransferRequest) ProtoMessage() {}
+
+func (x *AcceptTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTransferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptTransferRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AcceptTransferRequest) GetTransferId() uint64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+type AcceptTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	MessageToSign string                 `protobuf:"bytes,2,opt,name=message_to_sign,json=messageToSign,proto3" json:"message_to_sign,omitempty"`
+	MessageFormat MessageFormat          `protobuf:"varint,3,opt,name=message_format,json=messageFormat,proto3,enum=wallets.public.MessageFormat" json:"message_format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTransferResponse) Reset() {
+	*x = AcceptTransferResponse{}
+	mi := &file_wallets_public_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTransferResponse) ProtoMessage() {}
+
+func (x *AcceptTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTransferResponse.ProtoReflect.Descriptor instead.
+func (*AcceptTransferResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AcceptTransferResponse) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *AcceptTransferResponse) GetMessageToSign() string {
+	if x != nil {
+		return x.MessageToSign
+	}
+	return ""
+}
+
+func (x *AcceptTransferResponse) GetMessageFormat() MessageFormat {
+	if x != nil {
+		return x.MessageFormat
+	}
+	return MessageFormat_MESSAGE_FORMAT_NATIVE
+}
+
+type CompleteTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Signature     string                 `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTransferRequest) Reset() {
+	*x = CompleteTransferRequest{}
+	mi := &file_wallets_public_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTransferRequest) ProtoMessage() {}
+
+func (x *CompleteTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTransferRequest.ProtoReflect.Descriptor instead.
+func (*CompleteTransferRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CompleteTransferRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *CompleteTransferRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type CompleteTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteTransferResponse) Reset() {
+	*x = CompleteTransferResponse{}
+	mi := &file_wallets_public_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteTransferResponse) ProtoMessage() {}
+
+func (x *CompleteTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteTransferResponse.ProtoReflect.Descriptor instead.
+func (*CompleteTransferResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CompleteTransferResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type CancelTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    uint64                 `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTransferRequest) Reset() {
+	*x = CancelTransferRequest{}
+	mi := &file_wallets_public_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTransferRequest) ProtoMessage() {}
+
+func (x *CancelTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTransferRequest.ProtoReflect.Descriptor instead.
+func (*CancelTransferRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CancelTransferRequest) GetTransferId() uint64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+type CancelTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelTransferResponse) Reset() {
+	*x = CancelTransferResponse{}
+	mi := &file_wallets_public_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelTransferResponse) ProtoMessage() {}
+
+func (x *CancelTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelTransferResponse.ProtoReflect.Descriptor instead.
+func (*CancelTransferResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{32}
+}
+
+type GetTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransfersRequest) Reset() {
+	*x = GetTransfersRequest{}
+	mi := &file_wallets_public_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransfersRequest) ProtoMessage() {}
+
+func (x *GetTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransfersRequest.ProtoReflect.Descriptor instead.
+func (*GetTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{33}
+}
+
+type GetTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfers     []*Transfer            `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransfersResponse) Reset() {
+	*x = GetTransfersResponse{}
+	mi := &file_wallets_public_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransfersResponse) ProtoMessage() {}
+
+func (x *GetTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransfersResponse.ProtoReflect.Descriptor instead.
+func (*GetTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetTransfersResponse) GetTransfers() []*Transfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+type WalletEvent struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type     WalletEventType        `protobuf:"varint,2,opt,name=type,proto3,enum=wallets.public.WalletEventType" json:"type,omitempty"`
+	WalletId uint64                 `protobuf:"varint,3,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Pubkey   string                 `protobuf:"bytes,4,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Provider Provider               `protobuf:"varint,5,opt,name=provider,proto3,enum=wallets.public.Provider" json:"provider,omitempty"`
+	Metadata map[string]string      `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// created_at is a unix timestamp (seconds).
+	CreatedAt     int64 `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WalletEvent) Reset() {
+	*x = WalletEvent{}
+	mi := &file_wallets_public_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WalletEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletEvent) ProtoMessage() {}
+
+func (x *WalletEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletEvent.ProtoReflect.Descriptor instead.
+func (*WalletEvent) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *WalletEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WalletEvent) GetType() WalletEventType {
+	if x != nil {
+		return x.Type
+	}
+	return WalletEventType_WALLET_EVENT_TYPE_UNDEFINED
+}
+
+func (x *WalletEvent) GetWalletId() uint64 {
+	if x != nil {
+		return x.WalletId
+	}
+	return 0
+}
+
+func (x *WalletEvent) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *WalletEvent) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_PROVIDER_UNDEFINED
+}
+
+func (x *WalletEvent) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *WalletEvent) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type GetWalletEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// before_id returns events older than the given event, for paging.
+	BeforeId uint64 `protobuf:"varint,1,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+	// limit defaults to 50 and is capped at 200.
+	Limit         uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletEventsRequest) Reset() {
+	*x = GetWalletEventsRequest{}
+	mi := &file_wallets_public_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletEventsRequest) ProtoMessage() {}
+
+func (x *GetWalletEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletEventsRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetWalletEventsRequest) GetBeforeId() uint64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+func (x *GetWalletEventsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetWalletEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// events are ordered newest first.
+	Events        []*WalletEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWalletEventsResponse) Reset() {
+	*x = GetWalletEventsResponse{}
+	mi := &file_wallets_public_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWalletEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWalletEventsResponse) ProtoMessage() {}
+
+func (x *GetWalletEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWalletEventsResponse.ProtoReflect.Descriptor instead.
+func (*GetWalletEventsResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetWalletEventsResponse) GetEvents() []*WalletEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type GetAllowlistProofRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AllowlistId   uint64                 `protobuf:"varint,1,opt,name=allowlist_id,json=allowlistId,proto3" json:"allowlist_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllowlistProofRequest) Reset() {
+	*x = GetAllowlistProofRequest{}
+	mi := &file_wallets_public_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllowlistProofRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllowlistProofRequest) ProtoMessage() {}
+
+func (x *GetAllowlistProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllowlistProofRequest.ProtoReflect.Descriptor instead.
+func (*GetAllowlistProofRequest) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetAllowlistProofRequest) GetAllowlistId() uint64 {
+	if x != nil {
+		return x.AllowlistId
+	}
+	return 0
+}
+
+type GetAllowlistProofResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	AllowlistId uint64                 `protobuf:"varint,1,opt,name=allowlist_id,json=allowlistId,proto3" json:"allowlist_id,omitempty"`
+	// root is the 0x-prefixed hex encoded Merkle root.
+	Root         string       `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Hash         MerkleHash   `protobuf:"varint,3,opt,name=hash,proto3,enum=wallets.public.MerkleHash" json:"hash,omitempty"`
+	LeafEncoding LeafEncoding `protobuf:"varint,4,opt,name=leaf_encoding,json=leafEncoding,proto3,enum=wallets.public.LeafEncoding" json:"leaf_encoding,omitempty"`
+	Pubkey       string       `protobuf:"bytes,5,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Provider     Provider     `protobuf:"varint,6,opt,name=provider,proto3,enum=wallets.public.Provider" json:"provider,omitempty"`
+	// amount is the decimal allocation of the wallet; empty for encodings without an amount.
+	Amount string `protobuf:"bytes,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	// leaf is the 0x-prefixed hex encoded leaf hash.
+	Leaf string `protobuf:"bytes,8,opt,name=leaf,proto3" json:"leaf,omitempty"`
+	// proof holds the 0x-prefixed hex encoded sibling hashes from the leaf up to the root.
+	// Pairs are hashed in ascending byte order, as OpenZeppelin MerkleProof expects.
+	Proof         []string `protobuf:"bytes,9,rep,name=proof,proto3" json:"proof,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllowlistProofResponse) Reset() {
+	*x = GetAllowlistProofResponse{}
+	mi := &file_wallets_public_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllowlistProofResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllowlistProofResponse) ProtoMessage() {}
+
+func (x *GetAllowlistProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wallets_public_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllowlistProofResponse.ProtoReflect.Descriptor instead.
+func (*GetAllowlistProofResponse) Descriptor() ([]byte, []int) {
+	return file_wallets_public_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetAllowlistProofResponse) GetAllowlistId() uint64 {
+	if x != nil {
+		return x.AllowlistId
+	}
+	return 0
+}
+
+func (x *GetAllowlistProofResponse) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *GetAllowlistProofResponse) GetHash() MerkleHash {
+	if x != nil {
+		return x.Hash
+	}
+	return MerkleHash_MERKLE_HASH_UNDEFINED
+}
+
+func (x *GetAllowlistProofResponse) GetLeafEncoding() LeafEncoding {
+	if x != nil {
+		return x.LeafEncoding
+	}
+	return LeafEncoding_LEAF_ENCODING_UNDEFINED
+}
+
+func (x *GetAllowlistProofResponse) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *GetAllowlistProofResponse) GetProvider() Provider {
+	if x != nil {
+		return x.Provider
+	}
+	return Provider_PROVIDER_UNDEFINED
+}
+
+func (x *GetAllowlistProofResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *GetAllowlistProofResponse) GetLeaf() string {
+	if x != nil {
+		return x.Leaf
+	}
+	return ""
+}
+
+func (x *GetAllowlistProofResponse) GetProof() []string {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+var File_wallets_public_proto protoreflect.FileDescriptor
+
+const file_wallets_public_proto_rawDesc = "" +
+	"\n" +
+	"\x14wallets.public.proto\x12\x0ewallets.public\"`\n" +
+	"\x10AddWalletRequest\x12\x16\n" +
+	"\x06pubkey\x18\x01 \x01(\tR\x06pubkey\x124\n" +
+	"\bprovider\x18\x02 \x01(\x0e2\x18.wallets.public.ProviderR\bprovider\"\xa4\x01\n" +
+	"\x11AddWalletResponse\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12&\n" +
+	"\x0fmessage_to_sign\x18\x02 \x01(\tR\rmessageToSign\x12D\n" +
+	"\x0emessage_format\x18\x03 \x01(\x0e2\x1d.wallets.public.MessageFormatR\rmessageFormat\"\x9b\x01\n" +
+	"\x13VerifyWalletRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\tR\tsignature\x12\x16\n" +
+	"\x06pubkey\x18\x03 \x01(\tR\x06pubkey\x12+\n" +
+	"\x11issue_attestation\x18\x04 \x01(\bR\x10issueAttestation\"[\n" +
+	"\x14VerifyWalletResponse\x12C\n" +
+	"\vattestation\x18\x01 \x01(\v2!.wallets.public.WalletAttestationR\vattestation\"H\n" +
+	"\x11WalletAttestation\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03R\texpiresAt\"\x1d\n" +
+	"\x1bGetWalletAttestationRequest\"c\n" +
+	"\x1cGetWalletAttestationResponse\x12C\n" +
+	"\vattestation\x18\x01 \x01(\v2!.wallets.public.WalletAttestationR\vattestation\"2\n" +
+	"\x13UnlinkWalletRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x04R\bwalletId\"\xdc\x01\n" +
+	"\x14UnlinkWalletResponse\x123\n" +
+	"\x15confirmation_required\x18\x01 \x01(\bR\x14confirmationRequired\x12!\n" +
+	"\fchallenge_id\x18\x02 \x01(\tR\vchallengeId\x12&\n" +
+	"\x0fmessage_to_sign\x18\x03 \x01(\tR\rmessageToSign\x12D\n" +
+	"\x0emessage_format\x18\x04 \x01(\x0e2\x1d.wallets.public.MessageFormatR\rmessageFormat\"\x85\x01\n" +
+	"\x14ConfirmUnlinkRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\tR\tsignature\x12,\n" +
+	"\x12second_factor_code\x18\x03 \x01(\tR\x10secondFactorCode\"\x17\n" +
+	"\x15ConfirmUnlinkResponse\"\x12\n" +
+	"\x10GetWalletRequest\"\x87\x02\n" +
+	"\x11GetWalletResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x124\n" +
+	"\bprovider\x18\x02 \x01(\x0e2\x18.wallets.public.ProviderR\bprovider\x12\x1f\n" +
+	"\vis_verified\x18\x03 \x01(\bR\n" +
+	"isVerified\x12S\n" +
+	"\x13verification_status\x18\x04 \x01(\x0e2\".wallets.public.VerificationStatusR\x12verificationStatus\x126\n" +
+	"\x17verification_expires_at\x18\x05 \x01(\x03R\x15verificationExpiresAt\"\xe2\x01\n" +
+	"\aReclaim\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06pubkey\x18\x02 \x01(\tR\x06pubkey\x124\n" +
+	"\bprovider\x18\x03 \x01(\x0e2\x18.wallets.public.ProviderR\bprovider\x125\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1d.wallets.public.ReclaimStatusR\x06status\x12!\n" +
+	"\favailable_at\x18\x05 \x01(\x03R\vavailableAt\x12\x1f\n" +
+	"\vis_incoming\x18\x06 \x01(\bR\n" +
+	"isIncoming\"e\n" +
+	"\x15RequestReclaimRequest\x12\x16\n" +
+	"\x06pubkey\x18\x01 \x01(\tR\x06pubkey\x124\n" +
+	"\bprovider\x18\x02 \x01(\x0e2\x18.wallets.public.ProviderR\bprovider\"\xa9\x01\n" +
+	"\x16RequestReclaimResponse\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12&\n" +
+	"\x0fmessage_to_sign\x18\x02 \x01(\tR\rmessageToSign\x12D\n" +
+	"\x0emessage_format\x18\x03 \x01(\x0e2\x1d.wallets.public.MessageFormatR\rmessageFormat\"X\n" +
+	"\x15ConfirmReclaimRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\tR\tsignature\"K\n" +
+	"\x16ConfirmReclaimResponse\x121\n" +
+	"\areclaim\x18\x01 \x01(\v2\x17.wallets.public.ReclaimR\areclaim\"6\n" +
+	"\x15ContestReclaimRequest\x12\x1d\n" +
+	"\n" +
+	"reclaim_id\x18\x01 \x01(\x04R\treclaimId\"\x18\n" +
+	"\x16ContestReclaimResponse\"7\n" +
+	"\x16CompleteReclaimRequest\x12\x1d\n" +
+	"\n" +
+	"reclaim_id\x18\x01 \x01(\x04R\treclaimId\"\x19\n" +
+	"\x17CompleteReclaimResponse\"\x14\n" +
+	"\x12GetReclaimsRequest\"J\n" +
+	"\x13GetReclaimsResponse\x123\n" +
+	"\breclaims\x18\x01 \x03(\v2\x17.wallets.public.ReclaimR\breclaims\"\xfd\x01\n" +
+	"\bTransfer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
+	"\twallet_id\x18\x02 \x01(\x04R\bwalletId\x12\x16\n" +
+	"\x06pubkey\x18\x03 \x01(\tR\x06pubkey\x124\n" +
+	"\bprovider\x18\x04 \x01(\x0e2\x18.wallets.public.ProviderR\bprovider\x126\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1e.wallets.public.TransferStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\x03R\texpiresAt\x12\x1f\n" +
+	"\vis_incoming\x18\a \x01(\bR\n" +
+	"isIncoming\"T\n" +
+	"\x17InitiateTransferRequest\x12\x1b\n" +
+	"\twallet_id\x18\x01 \x01(\x04R\bwalletId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x02 \x01(\x04R\btoUserId\"P\n" +
+	"\x18InitiateTransferResponse\x124\n" +
+	"\btransfer\x18\x01 \x01(\v2\x18.wallets.public.TransferR\btransfer\"8\n" +
+	"\x15AcceptTransferRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x04R\n" +
+	"transferId\"\xa9\x01\n" +
+	"\x16AcceptTransferResponse\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12&\n" +
+	"\x0fmessage_to_sign\x18\x02 \x01(\tR\rmessageToSign\x12D\n" +
+	"\x0emessage_format\x18\x03 \x01(\x0e2\x1d.wallets.public.MessageFormatR\rmessageFormat\"Z\n" +
+	"\x17CompleteTransferRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\tR\tsignature\"P\n" +
+	"\x18CompleteTransferResponse\x124\n" +
+	"\btransfer\x18\x01 \x01(\v2\x18.wallets.public.TransferR\btransfer\"8\n" +
+	"\x15CancelTransferRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x04R\n" +
+	"transferId\"\x18\n" +
+	"\x16CancelTransferResponse\"\x15\n" +
+	"\x13GetTransfersRequest\"N\n" +
+	"\x14GetTransfersResponse\x126\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x18.wallets.public.TransferR\ttransfers\"\xe0\x02\n" +
+	"\vWalletEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x123\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1f.wallets.public.WalletEventTypeR\x04type\x12\x1b\n" +
+	"\twallet_id\x18\x03 \x01(\x04R\bwalletId\x12\x16\n" +
+	"\x06pubkey\x18\x04 \x01(\tR\x06pubkey\x124\n" +
+	"\bprovider\x18\x05 \x01(\x0e2\x18.wallets.public.ProviderR\bprovider\x12E\n" +
+	"\bmetadata\x18\x06 \x03(\v2).wallets.public.WalletEvent.MetadataEntryR\bmetadata\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"K\n" +
+	"\x16GetWalletEventsRequest\x12\x1b\n" +
+	"\tbefore_id\x18\x01 \x01(\x04R\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"N\n" +
+	"\x17GetWalletEventsResponse\x123\n" +
+	"\x06events\x18\x01 \x03(\v2\x1b.wallets.public.WalletEventR\x06events\"=\n" +
+	"\x18GetAllowlistProofRequest\x12!\n" +
+	"\fallowlist_id\x18\x01 \x01(\x04R\vallowlistId\"\xd5\x02\n" +
+	"\x19GetAllowlistProofResponse\x12!\n" +
+	"\fallowlist_id\x18\x01 \x01(\x04R\vallowlistId\x12\x12\n" +
+	"\x04root\x18\x02 \x01(\tR\x04root\x12.\n" +
+	"\x04hash\x18\x03 \x01(\x0e2\x1a.wallets.public.MerkleHashR\x04hash\x12A\n" +
+	"\rleaf_encoding\x18\x04 \x01(\x0e2\x1c.wallets.public.LeafEncodingR\fleafEncoding\x12\x16\n" +
+	"\x06pubkey\x18\x05 \x01(\tR\x06pubkey\x124\n" +
+	"\bprovider\x18\x06 \x01(\x0e2\x18.wallets.public.ProviderR\bprovider\x12\x16\n" +
+	"\x06amount\x18\a \x01(\tR\x06amount\x12\x12\n" +
+	"\x04leaf\x18\b \x01(\tR\x04leaf\x12\x14\n" +
+	"\x05proof\x18\t \x03(\tR\x05proof*\xfd\x01\n" +
+	"\bProvider\x12\x16\n" +
+	"\x12PROVIDER_UNDEFINED\x10\x00\x12\x14\n" +
+	"\x10PROVIDER_PHANTOM\x10\x01\x12\x14\n" +
+	"\x10PROVIDER_STELLAR\x10\x02\x12\x13\n" +
+	"\x0fPROVIDER_COSMOS\x10\x03\x12\x12\n" +
+	"\x0ePROVIDER_APTOS\x10\x04\x12\x10\n" +
+	"\fPROVIDER_SUI\x10\x05\x12\x15\n" +
+	"\x11PROVIDER_SOLFLARE\x10\x06\x12\x15\n" +
+	"\x11PROVIDER_BACKPACK\x10\a\x12\x11\n" +
+	"\rPROVIDER_GLOW\x10\b\x12\x13\n" +
+	"\x0fPROVIDER_LEDGER\x10\t\x12\x1c\n" +
+	"\x18PROVIDER_WALLET_STANDARD\x10\n" +
+	"*\x80\x01\n" +
+	"\rMessageFormat\x12\x19\n" +
+	"\x15MESSAGE_FORMAT_NATIVE\x10\x00\x12\x17\n" +
+	"\x13MESSAGE_FORMAT_TEXT\x10\x01\x12\x17\n" +
+	"\x13MESSAGE_FORMAT_SIWS\x10\x02\x12\"\n" +
+	"\x1eMESSAGE_FORMAT_SOLANA_OFFCHAIN\x10\x03*\xc5\x01\n" +
+	"\x12VerificationStatus\x12!\n" +
+	"\x1dVERIFICATION_STATUS_UNDEFINED\x10\x00\x12\"\n" +
+	"\x1eVERIFICATION_STATUS_UNVERIFIED\x10\x01\x12 \n" +
+	"\x1cVERIFICATION_STATUS_VERIFIED\x10\x02\x12%\n" +
+	"!VERIFICATION_STATUS_EXPIRING_SOON\x10\x03\x12\x1f\n" +
+	"\x1bVERIFICATION_STATUS_EXPIRED\x10\x04*\xa3\x01\n" +
+	"\rReclaimStatus\x12\x1c\n" +
+	"\x18RECLAIM_STATUS_UNDEFINED\x10\x00\x12\x1a\n" +
+	"\x16RECLAIM_STATUS_PENDING\x10\x01\x12\x1c\n" +
+	"\x18RECLAIM_STATUS_CONTESTED\x10\x02\x12\x1c\n" +
+	"\x18RECLAIM_STATUS_COMPLETED\x10\x03\x12\x1c\n" +
+	"\x18RECLAIM_STATUS_CANCELLED\x10\x04*\xc5\x01\n" +
+	"\x0eTransferStatus\x12\x1d\n" +
+	"\x19TRANSFER_STATUS_UNDEFINED\x10\x00\x12\x1b\n" +
+	"\x17TRANSFER_STATUS_PENDING\x10\x01\x12\x1c\n" +
+	"\x18TRANSFER_STATUS_ACCEPTED\x10\x02\x12\x1d\n" +
+	"\x19TRANSFER_STATUS_COMPLETED\x10\x03\x12\x1d\n" +
+	"\x19TRANSFER_STATUS_CANCELLED\x10\x04\x12\x1b\n" +
+	"\x17TRANSFER_STATUS_EXPIRED\x10\x05*\x8f\x01\n" +
+	"\x0fWalletEventType\x12\x1f\n" +
+	"\x1bWALLET_EVENT_TYPE_UNDEFINED\x10\x00\x12\x1b\n" +
+	"\x17WALLET_EVENT_TYPE_ADDED\x10\x01\x12\x1e\n" +
+	"\x1aWALLET_EVENT_TYPE_VERIFIED\x10\x02\x12\x1e\n" +
+	"\x1aWALLET_EVENT_TYPE_UNLINKED\x10\x03*Z\n" +
+	"\n" +
+	"MerkleHash\x12\x19\n" +
+	"\x15MERKLE_HASH_UNDEFINED\x10\x00\x12\x19\n" +
+	"\x15MERKLE_HASH_KECCAK256\x10\x01\x12\x16\n" +
+	"\x12MERKLE_HASH_SHA256\x10\x02*\x97\x01\n" +
+	"\fLeafEncoding\x12\x1b\n" +
+	"\x17LEAF_ENCODING_UNDEFINED\x10\x00\x12\x19\n" +
+	"\x15LEAF_ENCODING_ADDRESS\x10\x01\x12&\n" +
+	"\"LEAF_ENCODING_ADDRESS_AMOUNT_U64LE\x10\x02\x12'\n" +
+	"#LEAF_ENCODING_ADDRESS_AMOUNT_U256BE\x10\x032\xcc\r\n" +
+	"\aWallets\x12P\n" +
+	"\tAddWallet\x12 .wallets.public.AddWalletRequest\x1a!.wallets.public.AddWalletResponse\x12Y\n" +
+	"\fVerifyWallet\x12#.wallets.public.VerifyWalletRequest\x1a$.wallets.public.VerifyWalletResponse\x12Y\n" +
+	"\fUnlinkWallet\x12#.wallets.public.UnlinkWalletRequest\x1a$.wallets.public.UnlinkWalletResponse\x12\\\n" +
+	"\rConfirmUnlink\x12$.wallets.public.ConfirmUnlinkRequest\x1a%.wallets.public.ConfirmUnlinkResponse\x12P\n" +
+	"\tGetWallet\x12 .wallets.public.GetWalletRequest\x1a!.wallets.public.GetWalletResponse\x12q\n" +
+	"\x14GetWalletAttestation\x12+.wallets.public.GetWalletAttestationRequest\x1a,.wallets.public.GetWalletAttestationResponse\x12_\n" +
+	"\x0eRequestReclaim\x12%.wallets.public.RequestReclaimRequest\x1a&.wallets.public.RequestReclaimResponse\x12_\n" +
+	"\x0eConfirmReclaim\x12%.wallets.public.ConfirmReclaimRequest\x1a&.wallets.public.ConfirmReclaimResponse\x12_\n" +
+	"\x0eContestReclaim\x12%.wallets.public.ContestReclaimRequest\x1a&.wallets.public.ContestReclaimResponse\x12b\n" +
+	"\x0fCompleteReclaim\x12&.wallets.public.CompleteReclaimRequest\x1a'.wallets.public.CompleteReclaimResponse\x12V\n" +
+	"\vGetReclaims\x12\".wallets.public.GetReclaimsRequest\x1a#.wallets.public.GetReclaimsResponse\x12e\n" +
+	"\x10InitiateTransfer\x12'.wallets.public.InitiateTransferRequest\x1a(.wallets.public.InitiateTransferResponse\x12_\n" +
+	"\x0eAcceptTransfer\x12%.wallets.public.AcceptTransferRequest\x1a&.wallets.public.AcceptTransferResponse\x12e\n" +
+	"\x10CompleteTransfer\x12'.wallets.public.CompleteTransferRequest\x1a(.wallets.public.CompleteTransferResponse\x12_\n" +
+	"\x0eCancelTransfer\x12%.wallets.public.CancelTransferRequest\x1a&.wallets.public.CancelTransferResponse\x12Y\n" +
+	"\fGetTransfers\x12#.wallets.public.GetTransfersRequest\x1a$.wallets.public.GetTransfersResponse\x12b\n" +
+	"\x0fGetWalletEvents\x12&.wallets.public.GetWalletEventsRequest\x1a'.wallets.public.GetWalletEventsResponse\x12h\n" +
+	"\x11GetAllowlistProof\x12(.wallets.public.GetAllowlistProofRequest\x1a).wallets.public.GetAllowlistProofResponseB\x04Z\x02./b\x06proto3"
+
+var (
+	file_wallets_public_proto_rawDescOnce sync.Once
+	file_wallets_public_proto_rawDescData []byte
+)
+
+func file_wallets_public_proto_rawDescGZIP() []byte {
+	file_wallets_public_proto_rawDescOnce.Do(func() {
+		file_wallets_public_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_wallets_public_proto_rawDesc), len(file_wallets_public_proto_rawDesc)))
+	})
+	return file_wallets_public_proto_rawDescData
+}
+
+var file_wallets_public_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_wallets_public_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
+var file_wallets_public_proto_goTypes = []any{
+	(Provider)(0),                        // 0: wallets.public.Provider
+	(MessageFormat)(0),                   // 1: wallets.public.MessageFormat
+	(VerificationStatus)(0),              // 2: wallets.public.VerificationStatus
+	(ReclaimStatus)(0),                   // 3: wallets.public.ReclaimStatus
+	(TransferStatus)(0),                  // 4: wallets.public.TransferStatus
+	(WalletEventType)(0),                 // 5: wallets.public.WalletEventType
+	(MerkleHash)(0),                      // 6: wallets.public.MerkleHash
+	(LeafEncoding)(0),                    // 7: wallets.public.LeafEncoding
+	(*AddWalletRequest)(nil),             // 8: wallets.public.AddWalletRequest
+	(*AddWalletResponse)(nil),            // 9: wallets.public.AddWalletResponse
+	(*VerifyWalletRequest)(nil),          // 10: wallets.public.VerifyWalletRequest
+	(*VerifyWalletResponse)(nil),         // 11: wallets.public.VerifyWalletResponse
+	(*WalletAttestation)(nil),            // 12: wallets.public.WalletAttestation
+	(*GetWalletAttestationRequest)(nil),  // 13: wallets.public.GetWalletAttestationRequest
+	(*GetWalletAttestationResponse)(nil), // 14: wallets.public.GetWalletAttestationResponse
+	(*UnlinkWalletRequest)(nil),          // 15: wallets.public.UnlinkWalletRequest
+	(*UnlinkWalletResponse)(nil),         // 16: wallets.public.UnlinkWalletResponse
+	(*ConfirmUnlinkRequest)(nil),         // 17: wallets.public.ConfirmUnlinkRequest
+	(*ConfirmUnlinkResponse)(nil),        // 18: wallets.public.ConfirmUnlinkResponse
+	(*GetWalletRequest)(nil),             // 19: wallets.public.GetWalletRequest
+	(*GetWalletResponse)(nil),            // 20: wallets.public.GetWalletResponse
+	(*Reclaim)(nil),                      // 21: wallets.public.Reclaim
+	(*RequestReclaimRequest)(nil),        // 22: wallets.public.RequestReclaimRequest
+	(*RequestReclaimResponse)(nil),       // 23: wallets.public.RequestReclaimResponse
+	(*ConfirmReclaimRequest)(nil),        // 24: wallets.public.ConfirmReclaimRequest
+	(*ConfirmReclaimResponse)(nil),       // 25: wallets.public.ConfirmReclaimResponse
+	(*ContestReclaimRequest)(nil),        // 26: wallets.public.ContestReclaimRequest
+	(*ContestReclaimResponse)(nil),       // 27: wallets.public.ContestReclaimResponse
+	(*CompleteReclaimRequest)(nil),       // 28: wallets.public.CompleteReclaimRequest
+	(*CompleteReclaimResponse)(nil),      // 29: wallets.public.CompleteReclaimResponse
+	(*GetReclaimsRequest)(nil),           // 30: wallets.public.GetReclaimsRequest
+	(*GetReclaimsResponse)(nil),          // 31: wallets.public.GetReclaimsResponse
+	(*Transfer)(nil),                     // 32: wallets.public.Transfer
+	(*InitiateTransferRequest)(nil),      // 33: wallets.public.InitiateTransferRequest
+	(*InitiateTransferResponse)(nil),     // 34: wallets.public.InitiateTransferResponse
+	(*AcceptTransferRequest)(nil),        // 35: wallets.public.AcceptTransferRequest
+	(*AcceptTransferResponse)(nil),       // 36: wallets.public.AcceptTransferResponse
+	(*CompleteTransferRequest)(nil),      // 37: wallets.public.CompleteTransferRequest
+	(*CompleteTransferResponse)(nil),     // 38: wallets.public.CompleteTransferResponse
+	(*CancelTransferRequest)(nil),        // 39: wallets.public.CancelTransferRequest
+	(*CancelTransferResponse)(nil),       // 40: wallets.public.CancelTransferResponse
+	(*GetTransfersRequest)(nil),          // 41: wallets.public.GetTransfersRequest
+	(*GetTransfersResponse)(nil),         // 42: wallets.public.GetTransfersResponse
+	(*WalletEvent)(nil),                  // 43: wallets.public.WalletEvent
+	(*GetWalletEventsRequest)(nil),       // 44: wallets.public.GetWalletEventsRequest
+	(*GetWalletEventsResponse)(nil),      // 45: wallets.public.GetWalletEventsResponse
+	(*GetAllowlistProofRequest)(nil),     // 46: wallets.public.GetAllowlistProofRequest
+	(*GetAllowlistProofResponse)(nil),    // 47: wallets.public.GetAllowlistProofResponse
+	nil,                                  // 48: wallets.public.WalletEvent.MetadataEntry
+}
+var file_wallets_public_proto_depIdxs = []int32{
+	0,  // 0: wallets.public.AddWalletRequest.provider:type_name -> wallets.public.Provider
+	1,  // 1: wallets.public.AddWalletResponse.message_format:type_name -> wallets.public.MessageFormat
+	12, // 2: wallets.public.VerifyWalletResponse.attestation:type_name -> wallets.public.WalletAttestation
+	12, // 3: wallets.public.GetWalletAttestationResponse.attestation:type_name -> wallets.public.WalletAttestation
+	1,  // 4: wallets.public.UnlinkWalletResponse.message_format:type_name -> wallets.public.MessageFormat
+	0,  // 5: wallets.public.GetWalletResponse.provider:type_name -> wallets.public.Provider
+	2,  // 6: wallets.public.GetWalletResponse.verification_status:type_name -> wallets.public.VerificationStatus
+	0,  // 7: wallets.public.Reclaim.provider:type_name -> wallets.public.Provider
+	3,  // 8: wallets.public.Reclaim.status:type_name -> wallets.public.ReclaimStatus
+	0,  // 9: wallets.public.RequestReclaimRequest.provider:type_name -> wallets.public.Provider
+	1,  // 10: wallets.public.RequestReclaimResponse.message_format:type_name -> wallets.public.MessageFormat
+	21, // 11: wallets.public.ConfirmReclaimResponse.reclaim:type_name -> wallets.public.Reclaim
+	21, // 12: wallets.public.GetReclaimsResponse.reclaims:type_name -> wallets.public.Reclaim
+	0,  // 13: wallets.public.Transfer.provider:type_name -> wallets.public.Provider
+	4,  // 14: wallets.public.Transfer.status:type_name -> wallets.public.TransferStatus
+	32, // 15: wallets.public.InitiateTransferResponse.transfer:type_name -> wallets.public.Transfer
+	1,  // 16: wallets.public.AcceptTransferResponse.message_format:type_name -> wallets.public.MessageFormat
+	32, // 17: wallets.public.CompleteTransferResponse.transfer:type_name -> wallets.public.Transfer
+	32, // 18: wallets.public.GetTransfersResponse.transfers:type_name -> wallets.public.Transfer
+	5,  // 19: wallets.public.WalletEvent.type:type_name -> wallets.public.WalletEventType
+	0,  // 20: wallets.public.WalletEvent.provider:type_name -> wallets.public.Provider
+	48, // 21: wallets.public.WalletEvent.metadata:type_name -> wallets.public.WalletEvent.MetadataEntry
+	43, // 22: wallets.public.GetWalletEventsResponse.events:type_name -> wallets.public.WalletEvent
+	6,  // 23: wallets.public.GetAllowlistProofResponse.hash:type_name -> wallets.public.MerkleHash
+	7,  // 24: wallets.public.GetAllowlistProofResponse.leaf_encoding:type_name -> wallets.public.LeafEncoding
+	0,  // 25: wallets.public.GetAllowlistProofResponse.provider:type_name -> wallets.public.Provider
+	8,  // 26: wallets.public.Wallets.AddWallet:input_type -> wallets.public.AddWalletRequest
+	10, // 27: wallets.public.Wallets.VerifyWallet:input_type -> wallets.public.VerifyWalletRequest
+	15, // 28: wallets.public.Wallets.UnlinkWallet:input_type -> wallets.public.UnlinkWalletRequest
+	17, // 29: wallets.public.Wallets.ConfirmUnlink:input_type -> wallets.public.ConfirmUnlinkRequest
+	19, // 30: wallets.public.Wallets.GetWallet:input_type -> wallets.public.GetWalletRequest
+	13, // 31: wallets.public.Wallets.GetWalletAttestation:input_type -> wallets.public.GetWalletAttestationRequest
+	22, // 32: wallets.public.Wallets.RequestReclaim:input_type -> wallets.public.RequestReclaimRequest
+	24, // 33: wallets.public.Wallets.ConfirmReclaim:input_type -> wallets.public.ConfirmReclaimRequest
+	26, // 34: wallets.public.Wallets.ContestReclaim:input_type -> wallets.public.ContestReclaimRequest
+	28, // 35: wallets.public.Wallets.CompleteReclaim:input_type -> wallets.public.CompleteReclaimRequest
+	30, // 36: wallets.public.Wallets.GetReclaims:input_type -> wallets.public.GetReclaimsRequest
+	33, // 37: wallets.public.Wallets.InitiateTransfer:input_type -> wallets.public.InitiateTransferRequest
+	35, // 38: wallets.public.Wallets.AcceptTransfer:input_type -> wallets.public.AcceptTransferRequest
+	37, // 39: wallets.public.Wallets.CompleteTransfer:input_type -> wallets.public.CompleteTransferRequest
+	39, // 40: wallets.public.Wallets.CancelTransfer:input_type -> wallets.public.CancelTransferRequest
+	41, // 41: wallets.public.Wallets.GetTransfers:input_type -> wallets.public.GetTransfersRequest
+	44, // 42: wallets.public.Wallets.GetWalletEvents:input_type -> wallets.public.GetWalletEventsRequest
+	46, // 43: wallets.public.Wallets.GetAllowlistProof:input_type -> wallets.public.GetAllowlistProofRequest
+	9,  // 44: wallets.public.Wallets.AddWallet:output_type -> wallets.public.AddWalletResponse
+	11, // 45: wallets.public.Wallets.VerifyWallet:output_type -> wallets.public.VerifyWalletResponse
+	16, // 46: wallets.public.Wallets.UnlinkWallet:output_type -> wallets.public.UnlinkWalletResponse
+	18, // 47: wallets.public.Wallets.ConfirmUnlink:output_type -> wallets.public.ConfirmUnlinkResponse
+	20, // 48: wallets.public.Wallets.GetWallet:output_type -> wallets.public.GetWalletResponse
+	14, // 49: wallets.public.Wallets.GetWalletAttestation:output_type -> wallets.public.GetWalletAttestationResponse
+	23, // 50: wallets.public.Wallets.RequestReclaim:output_type -> wallets.public.RequestReclaimResponse
+	25, // 51: wallets.public.Wallets.ConfirmReclaim:output_type -> wallets.public.ConfirmReclaimResponse
+	27, // 52: wallets.public.Wallets.ContestReclaim:output_type -> wallets.public.ContestReclaimResponse
+	29, // 53: wallets.public.Wallets.CompleteReclaim:output_type -> wallets.public.CompleteReclaimResponse
+	31, // 54: wallets.public.Wallets.GetReclaims:output_type -> wallets.public.GetReclaimsResponse
+	34, // 55: wallets.public.Wallets.InitiateTransfer:output_type -> wallets.public.InitiateTransferResponse
+	36, // 56: wallets.public.Wallets.AcceptTransfer:output_type -> wallets.public.AcceptTransferResponse
+	38, // 57: wallets.public.Wallets.CompleteTransfer:output_type -> wallets.public.CompleteTransferResponse
+	40, // 58: wallets.public.Wallets.CancelTransfer:output_type -> wallets.public.CancelTransferResponse
+	42, // 59: wallets.public.Wallets.GetTransfers:output_type -> wallets.public.GetTransfersResponse
+	45, // 60: wallets.public.Wallets.GetWalletEvents:output_type -> wallets.public.GetWalletEventsResponse
+	47, // 61: wallets.public.Wallets.GetAllowlistProof:output_type -> wallets.public.GetAllowlistProofResponse
+	44, // [44:62] is the sub-list for method output_type
+	26, // [26:44] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_wallets_public_proto_init() }
+func file_wallets_public_proto_init() {
+	if File_wallets_public_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_wallets_public_proto_rawDesc), len(file_wallets_public_proto_rawDesc)),
+			NumEnums:      8,
+			NumMessages:   41,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_wallets_public_proto_goTypes,
+		DependencyIndexes: file_wallets_public_proto_depIdxs,
+		EnumInfos:         file_wallets_public_proto_enumTypes,
+		MessageInfos:      file_wallets_public_proto_msgTypes,
+	}.Build()
+	File_wallets_public_proto = out.File
+	file_wallets_public_proto_goTypes = nil
+	file_wallets_public_proto_depIdxs = nil
+}
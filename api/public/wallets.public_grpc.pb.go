@@ -0,0 +1,767 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: wallets.public.proto
+
+package __
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Wallets_AddWallet_FullMethodName            = "/wallets.public.Wallets/AddWallet"
+	Wallets_VerifyWallet_FullMethodName         = "/wallets.public.Wallets/VerifyWallet"
+	Wallets_UnlinkWallet_FullMethodName         = "/wallets.public.Wallets/UnlinkWallet"
+	Wallets_ConfirmUnlink_FullMethodName        = "/wallets.public.Wallets/ConfirmUnlink"
+	Wallets_GetWallet_FullMethodName            = "/wallets.public.Wallets/GetWallet"
+	Wallets_GetWalletAttestation_FullMethodName = "/wallets.public.Wallets/GetWalletAttestation"
+	Wallets_RequestReclaim_FullMethodName       = "/wallets.public.Wallets/RequestReclaim"
+	Wallets_ConfirmReclaim_FullMethodName       = "/wallets.public.Wallets/ConfirmReclaim"
+	Wallets_ContestReclaim_FullMethodName       = "/wallets.public.Wallets/ContestReclaim"
+	Wallets_CompleteReclaim_FullMethodName      = "/wallets.public.Wallets/CompleteReclaim"
+	Wallets_GetReclaims_FullMethodName          = "/wallets.public.Wallets/GetReclaims"
+	Wallets_InitiateTransfer_FullMethodName     = "/wallets.public.Wallets/InitiateTransfer"
+	Wallets_AcceptTransfer_FullMethodName       = "/wallets.public.Wallets/AcceptTransfer"
+	Wallets_CompleteTransfer_FullMethodName     = "/wallets.public.Wallets/CompleteTransfer"
+	Wallets_CancelTransfer_FullMethodName       = "/wallets.public.Wallets/CancelTransfer"
+	Wallets_GetTransfers_FullMethodName         = "/wallets.public.Wallets/GetTransfers"
+	Wallets_GetWalletEvents_FullMethodName      = "/wallets.public.Wallets/GetWalletEvents"
+	Wallets_GetAllowlistProof_FullMethodName    = "/wallets.public.Wallets/GetAllowlistProof"
+)
+
+// WalletsClient is the client API for Wallets service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WalletsClient interface {
+	AddWallet(ctx context.Context, in *AddWalletRequest, opts ...grpc.CallOption) (*AddWalletResponse, error)
+	VerifyWallet(ctx context.Context, in *VerifyWalletRequest, opts ...grpc.CallOption) (*VerifyWalletResponse, error)
+	UnlinkWallet(ctx context.Context, in *UnlinkWalletRequest, opts ...grpc.CallOption) (*UnlinkWalletResponse, error)
+	ConfirmUnlink(ctx context.Context, in *ConfirmUnlinkRequest, opts ...grpc.CallOption) (*ConfirmUnlinkResponse, error)
+	GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error)
+	GetWalletAttestation(ctx context.Context, in *GetWalletAttestationRequest, opts ...grpc.CallOption) (*GetWalletAttestationResponse, error)
+	RequestReclaim(ctx context.Context, in *RequestReclaimRequest, opts ...grpc.CallOption) (*RequestReclaimResponse, error)
+	ConfirmReclaim(ctx context.Context, in *ConfirmReclaimRequest, opts ...grpc.CallOption) (*ConfirmReclaimResponse, error)
+	ContestReclaim(ctx context.Context, in *ContestReclaimRequest, opts ...grpc.CallOption) (*ContestReclaimResponse, error)
+	CompleteReclaim(ctx context.Context, in *CompleteReclaimRequest, opts ...grpc.CallOption) (*CompleteReclaimResponse, error)
+	GetReclaims(ctx context.Context, in *GetReclaimsRequest, opts ...grpc.CallOption) (*GetReclaimsResponse, error)
+	InitiateTransfer(ctx context.Context, in *InitiateTransferRequest, opts ...grpc.CallOption) (*InitiateTransferResponse, error)
+	AcceptTransfer(ctx context.Context, in *AcceptTransferRequest, opts ...grpc.CallOption) (*AcceptTransferResponse, error)
+	CompleteTransfer(ctx context.Context, in *CompleteTransferRequest, opts ...grpc.CallOption) (*CompleteTransferResponse, error)
+	CancelTransfer(ctx context.Context, in *CancelTransferRequest, opts ...grpc.CallOption) (*CancelTransferResponse, error)
+	GetTransfers(ctx context.Context, in *GetTransfersRequest, opts ...grpc.CallOption) (*GetTransfersResponse, error)
+	GetWalletEvents(ctx context.Context, in *GetWalletEventsRequest, opts ...grpc.CallOption) (*GetWalletEventsResponse, error)
+	GetAllowlistProof(ctx context.Context, in *GetAllowlistProofRequest, opts ...grpc.CallOption) (*GetAllowlistProofResponse, error)
+}
+
+type walletsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWalletsClient(cc grpc.ClientConnInterface) WalletsClient {
+	return &walletsClient{cc}
+}
+
+func (c *walletsClient) AddWallet(ctx context.Context, in *AddWalletRequest, opts ...grpc.CallOption) (*AddWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddWalletResponse)
+	err := c.cc.Invoke(ctx, Wallets_AddWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsClient) VerifyWallet(ctx context.Context, in *VerifyWalletRequest, opts ...grpc.CallOption) (*VerifyWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyWalletResponse)
+	err := c.cc.Invoke(ctx, Wallets_VerifyWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsClient) UnlinkWallet(ctx context.Context, in *UnlinkWalletRequest, opts ...grpc.CallOption) (*UnlinkWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkWalletResponse)
+	err := c.cc.Invoke(ctx, Wallets_UnlinkWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsClient) ConfirmUnlink(ctx context.Context, in *ConfirmUnlinkRequest, opts ...grpc.CallOption) (*ConfirmUnlinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmUnlinkResponse)
+	err := c.cc.Invoke(ctx, Wallets_ConfirmUnlink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsClient) GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletResponse)
+	err := c.cc.Invoke(ctx, Wallets_GetWallet_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsClient) GetWalletAttestation(ctx context.Context, in *GetWalletAttestationRequest, opts ...grpc.CallOption) (*GetWalletAttestationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletAttestationResponse)
+	err := c.cc.Invoke(ctx, Wallets_GetWalletAttestation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsClient) RequestReclaim(ctx context.Context, in *RequestReclaimRequest, opts ...grpc.CallOption) (*RequestReclaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestReclaimResponse)
+	err := c.cc.Invoke(ctx, Wallets_RequestReclaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsClient) ConfirmReclaim(ctx context.Context, in *ConfirmReclaimRequest, opts ...grpc.CallOption) (*ConfirmReclaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmReclaimResponse)
+	err := c.cc.Invoke(ctx, Wallets_ConfirmReclaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsClient) ContestReclaim(ctx context.Context, in *ContestReclaimRequest, opts ...grpc.CallOption) (*ContestReclaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContestReclaimResponse)
+	err := c.cc.Invoke(ctx, Wallets_ContestReclaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsClient) CompleteReclaim(ctx context.Context, in *CompleteReclaimRequest, opts ...grpc.CallOption) (*CompleteReclaimResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteReclaimResponse)
+	err := c.cc.Invoke(ctx, Wallets_CompleteReclaim_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsClient) GetReclaims(ctx context.Context, in *GetReclaimsRequest, opts ...grpc.CallOption) (*GetReclaimsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReclaimsResponse)
+	err := c.cc.Invoke(ctx, Wallets_GetReclaims_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsClient) InitiateTransfer(ctx context.Context, in *InitiateTransferRequest, opts ...grpc.CallOption) (*InitiateTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InitiateTransferResponse)
+	err := c.cc.Invoke(ctx, Wallets_InitiateTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsClient) AcceptTransfer(ctx context.Context, in *AcceptTransferRequest, opts ...grpc.CallOption) (*AcceptTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptTransferResponse)
+	err := c.cc.Invoke(ctx, Wallets_AcceptTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsClient) CompleteTransfer(ctx context.Context, in *CompleteTransferRequest, opts ...grpc.CallOption) (*CompleteTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteTransferResponse)
+	err := c.cc.Invoke(ctx, Wallets_CompleteTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsClient) CancelTransfer(ctx context.Context, in *CancelTransferRequest, opts ...grpc.CallOption) (*CancelTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelTransferResponse)
+	err := c.cc.Invoke(ctx, Wallets_CancelTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsClient) GetTransfers(ctx context.Context, in *GetTransfersRequest, opts ...grpc.CallOption) (*GetTransfersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTransfersResponse)
+	err := c.cc.Invoke(ctx, Wallets_GetTransfers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsClient) GetWalletEvents(ctx context.Context, in *GetWalletEventsRequest, opts ...grpc.CallOption) (*GetWalletEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWalletEventsResponse)
+	err := c.cc.Invoke(ctx, Wallets_GetWalletEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletsClient) GetAllowlistProof(ctx context.Context, in *GetAllowlistProofRequest, opts ...grpc.CallOption) (*GetAllowlistProofResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllowlistProofResponse)
+	err := c.cc.Invoke(ctx, Wallets_GetAllowlistProof_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletsServer is the server API for Wallets service.
+// All implementations must embed UnimplementedWalletsServer
+// for forward compatibility.
+type WalletsServer interface {
+	AddWallet(context.Context, *AddWalletRequest) (*AddWalletResponse, error)
+	VerifyWallet(context.Context, *VerifyWalletRequest) (*VerifyWalletResponse, error)
+	UnlinkWallet(context.Context, *UnlinkWalletRequest) (*UnlinkWalletResponse, error)
+	ConfirmUnlink(context.Context, *ConfirmUnlinkRequest) (*ConfirmUnlinkResponse, error)
+	GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error)
+	GetWalletAttestation(context.Context, *GetWalletAttestationRequest) (*GetWalletAttestationResponse, error)
+	RequestReclaim(context.Context, *RequestReclaimRequest) (*RequestReclaimResponse, error)
+	ConfirmReclaim(context.Context, *ConfirmReclaimRequest) (*ConfirmReclaimResponse, error)
+	ContestReclaim(context.Context, *ContestReclaimRequest) (*ContestReclaimResponse, error)
+	CompleteReclaim(context.Context, *CompleteReclaimRequest) (*CompleteReclaimResponse, error)
+	GetReclaims(context.Context, *GetReclaimsRequest) (*GetReclaimsResponse, error)
+	InitiateTransfer(context.Context, *InitiateTransferRequest) (*InitiateTransferResponse, error)
+	AcceptTransfer(context.Context, *AcceptTransferRequest) (*AcceptTransferResponse, error)
+	CompleteTransfer(context.Context, *CompleteTransferRequest) (*CompleteTransferResponse, error)
+	CancelTransfer(context.Context, *CancelTransferRequest) (*CancelTransferResponse, error)
+	GetTransfers(context.Context, *GetTransfersRequest) (*GetTransfersResponse, error)
+	GetWalletEvents(context.Context, *GetWalletEventsRequest) (*GetWalletEventsResponse, error)
+	GetAllowlistProof(context.Context, *GetAllowlistProofRequest) (*GetAllowlistProofResponse, error)
+	mustEmbedUnimplementedWalletsServer()
+}
+
+// UnimplementedWalletsServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWalletsServer struct{}
+
+func (UnimplementedWalletsServer) AddWallet(context.Context, *AddWalletRequest) (*AddWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWallet not implemented")
+}
+func (UnimplementedWalletsServer) VerifyWallet(context.Context, *VerifyWalletRequest) (*VerifyWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyWallet not implemented")
+}
+func (UnimplementedWalletsServer) UnlinkWallet(context.Context, *UnlinkWalletRequest) (*UnlinkWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkWallet not implemented")
+}
+func (UnimplementedWalletsServer) ConfirmUnlink(context.Context, *ConfirmUnlinkRequest) (*ConfirmUnlinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmUnlink not implemented")
+}
+func (UnimplementedWalletsServer) GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWallet not implemented")
+}
+func (UnimplementedWalletsServer) GetWalletAttestation(context.Context, *GetWalletAttestationRequest) (*GetWalletAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWalletAttestation not implemented")
+}
+func (UnimplementedWalletsServer) RequestReclaim(context.Context, *RequestReclaimRequest) (*RequestReclaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestReclaim not implemented")
+}
+func (UnimplementedWalletsServer) ConfirmReclaim(context.Context, *ConfirmReclaimRequest) (*ConfirmReclaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmReclaim not implemented")
+}
+func (UnimplementedWalletsServer) ContestReclaim(context.Context, *ContestReclaimRequest) (*ContestReclaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContestReclaim not implemented")
+}
+func (UnimplementedWalletsServer) CompleteReclaim(context.Context, *CompleteReclaimRequest) (*CompleteReclaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteReclaim not implemented")
+}
+func (UnimplementedWalletsServer) GetReclaims(context.Context, *GetReclaimsRequest) (*GetReclaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReclaims not implemented")
+}
+func (UnimplementedWalletsServer) InitiateTransfer(context.Context, *InitiateTransferRequest) (*InitiateTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateTransfer not implemented")
+}
+func (UnimplementedWalletsServer) AcceptTransfer(context.Context, *AcceptTransferRequest) (*AcceptTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTransfer not implemented")
+}
+func (UnimplementedWalletsServer) CompleteTransfer(context.Context, *CompleteTransferRequest) (*CompleteTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteTransfer not implemented")
+}
+func (UnimplementedWalletsServer) CancelTransfer(context.Context, *CancelTransferRequest) (*CancelTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTransfer not implemented")
+}
+func (UnimplementedWalletsServer) GetTransfers(context.Context, *GetTransfersRequest) (*GetTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransfers not implemented")
+}
+func (UnimplementedWalletsServer) GetWalletEvents(context.Context, *GetWalletEventsRequest) (*GetWalletEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWalletEvents not implemented")
+}
+func (UnimplementedWalletsServer) GetAllowlistProof(context.Context, *GetAllowlistProofRequest) (*GetAllowlistProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllowlistProof not implemented")
+}
+func (UnimplementedWalletsServer) mustEmbedUnimplementedWalletsServer() {}
+func (UnimplementedWalletsServer) testEmbeddedByValue()                 {}
+
+// UnsafeWalletsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WalletsServer will
+// result in compilation errors.
+type UnsafeWalletsServer interface {
+	mustEmbedUnimplementedWalletsServer()
+}
+
+func RegisterWalletsServer(s grpc.ServiceRegistrar, srv WalletsServer) {
+	// If the following call pancis, it indicates UnimplementedWalletsServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Wallets_ServiceDesc, srv)
+}
+
+func _Wallets_AddWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsServer).AddWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wallets_AddWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsServer).AddWallet(ctx, req.(*AddWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallets_VerifyWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsServer).VerifyWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wallets_VerifyWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsServer).VerifyWallet(ctx, req.(*VerifyWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallets_UnlinkWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsServer).UnlinkWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wallets_UnlinkWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsServer).UnlinkWallet(ctx, req.(*UnlinkWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallets_ConfirmUnlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmUnlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsServer).ConfirmUnlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wallets_ConfirmUnlink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsServer).ConfirmUnlink(ctx, req.(*ConfirmUnlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallets_GetWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsServer).GetWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wallets_GetWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsServer).GetWallet(ctx, req.(*GetWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallets_GetWalletAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsServer).GetWalletAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wallets_GetWalletAttestation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsServer).GetWalletAttestation(ctx, req.(*GetWalletAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallets_RequestReclaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestReclaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsServer).RequestReclaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wallets_RequestReclaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsServer).RequestReclaim(ctx, req.(*RequestReclaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallets_ConfirmReclaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmReclaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsServer).ConfirmReclaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wallets_ConfirmReclaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsServer).ConfirmReclaim(ctx, req.(*ConfirmReclaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallets_ContestReclaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContestReclaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsServer).ContestReclaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wallets_ContestReclaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsServer).ContestReclaim(ctx, req.(*ContestReclaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallets_CompleteReclaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteReclaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsServer).CompleteReclaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wallets_CompleteReclaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsServer).CompleteReclaim(ctx, req.(*CompleteReclaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallets_GetReclaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReclaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsServer).GetReclaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wallets_GetReclaims_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsServer).GetReclaims(ctx, req.(*GetReclaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallets_InitiateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsServer).InitiateTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wallets_InitiateTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsServer).InitiateTransfer(ctx, req.(*InitiateTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallets_AcceptTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsServer).AcceptTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wallets_AcceptTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsServer).AcceptTransfer(ctx, req.(*AcceptTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallets_CompleteTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsServer).CompleteTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wallets_CompleteTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsServer).CompleteTransfer(ctx, req.(*CompleteTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallets_CancelTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsServer).CancelTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wallets_CancelTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsServer).CancelTransfer(ctx, req.(*CancelTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallets_GetTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsServer).GetTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wallets_GetTransfers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsServer).GetTransfers(ctx, req.(*GetTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallets_GetWalletEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsServer).GetWalletEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wallets_GetWalletEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsServer).GetWalletEvents(ctx, req.(*GetWalletEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Wallets_GetAllowlistProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllowlistProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletsServer).GetAllowlistProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Wallets_GetAllowlistProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletsServer).GetAllowlistProof(ctx, req.(*GetAllowlistProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Wallets_ServiceDesc is the grpc.ServiceDesc for Wallets service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Wallets_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "wallets.public.Wallets",
+	HandlerType: (*WalletsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddWallet",
+			Handler:    _Wallets_AddWallet_Handler,
+		},
+		{
+			MethodName: "VerifyWallet",
+			Handler:    _Wallets_VerifyWallet_Handler,
+		},
+		{
+			MethodName: "UnlinkWallet",
+			Handler:    _Wallets_UnlinkWallet_Handler,
+		},
+		{
+			MethodName: "ConfirmUnlink",
+			Handler:    _Wallets_ConfirmUnlink_Handler,
+		},
+		{
+			MethodName: "GetWallet",
+			Handler:    _Wallets_GetWallet_Handler,
+		},
+		{
+			MethodName: "GetWalletAttestation",
+			Handler:    _Wallets_GetWalletAttestation_Handler,
+		},
+		{
+			MethodName: "RequestReclaim",
+			Handler:    _Wallets_RequestReclaim_Handler,
+		},
+		{
+			MethodName: "ConfirmReclaim",
+			Handler:    _Wallets_ConfirmReclaim_Handler,
+		},
+		{
+			MethodName: "ContestReclaim",
+			Handler:    _Wallets_ContestReclaim_Handler,
+		},
+		{
+			MethodName: "CompleteReclaim",
+			Handler:    _Wallets_CompleteReclaim_Handler,
+		},
+		{
+			MethodName: "GetReclaims",
+			Handler:    _Wallets_GetReclaims_Handler,
+		},
+		{
+			MethodName: "InitiateTransfer",
+			Handler:    _Wallets_InitiateTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptTransfer",
+			Handler:    _Wallets_AcceptTransfer_Handler,
+		},
+		{
+			MethodName: "CompleteTransfer",
+			Handler:    _Wallets_CompleteTransfer_Handler,
+		},
+		{
+			MethodName: "CancelTransfer",
+			Handler:    _Wallets_CancelTransfer_Handler,
+		},
+		{
+			MethodName: "GetTransfers",
+			Handler:    _Wallets_GetTransfers_Handler,
+		},
+		{
+			MethodName: "GetWalletEvents",
+			Handler:    _Wallets_GetWalletEvents_Handler,
+		},
+		{
+			MethodName: "GetAllowlistProof",
+			Handler:    _Wallets_GetAllowlistProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "wallets.public.proto",
+}
@@ -0,0 +1,43 @@
+// Command attestation-keys generates Ed25519 keys for wallet attestations.
+//
+// To rotate keys, set the printed signing key as ATTESTATION_SIGNING_KEY and append the public key of the
+// previous signing key to ATTESTATION_RETIRED_KEYS; drop it from there once ATTESTATION_TTL has passed.
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"os"
+
+	"wallets-service/config"
+	"wallets-service/internal/attestations"
+)
+
+func main() {
+	if err := run(); err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return fmt.Errorf("ed25519.GenerateKey: %w", err)
+	}
+
+	seed := base64.StdEncoding.EncodeToString(priv.Seed())
+	signer, err := attestations.NewSigner(config.AttestationConfig{SigningKey: seed})
+	if err != nil {
+		return fmt.Errorf("attestations.NewSigner: %w", err)
+	}
+
+	fmt.Printf("ATTESTATION_SIGNING_KEY=%s\n", seed)
+	fmt.Printf("public key: %s\n", base64.StdEncoding.EncodeToString(pub))
+	fmt.Printf("kid: %s\n", signer.KeySet().Keys[0].Kid)
+
+	return nil
+}
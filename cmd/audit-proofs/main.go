@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	knstchLog "github.com/knstch/knstch-libs/log"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"wallets-service/config"
+	"wallets-service/internal/proofaudit"
+	"wallets-service/internal/wallets/chains"
+	"wallets-service/internal/wallets/repo"
+)
+
+// Exit codes: 0 when every proof checks out, 1 when the audit has findings, 2 when it could not run.
+const (
+	exitFindings = 1
+	exitError    = 2
+)
+
+func main() {
+	report, format, err := run()
+	if err != nil {
+		log.Println(err)
+		os.Exit(exitError)
+	}
+
+	if err = printReport(os.Stdout, report, format); err != nil {
+		log.Println(err)
+		os.Exit(exitError)
+	}
+
+	if len(report.Findings) > 0 {
+		os.Exit(exitFindings)
+	}
+}
+
+func run() (proofaudit.Report, string, error) {
+	flags := flag.NewFlagSet("audit-proofs", flag.ExitOnError)
+	format := flags.String("format", "text", "output format: text or json")
+	pageSize := flags.Int("page-size", 500, "number of rows read from Postgres at a time")
+	if err := flags.Parse(os.Args[1:]); err != nil {
+		return proofaudit.Report{}, "", fmt.Errorf("flags.Parse: %w", err)
+	}
+	if *format != "text" && *format != "json" {
+		return proofaudit.Report{}, "", fmt.Errorf("unknown format %q", *format)
+	}
+
+	dir, err := filepath.Abs(filepath.Dir(os.Args[0]))
+	if err != nil {
+		return proofaudit.Report{}, "", fmt.Errorf("filepath.Abs: %w", err)
+	}
+
+	if err = config.InitENV(dir); err != nil {
+		return proofaudit.Report{}, "", fmt.Errorf("config.InitENV: %w", err)
+	}
+
+	cfg, err := config.GetConfig()
+	if err != nil {
+		return proofaudit.Report{}, "", fmt.Errorf("config.GetConfig: %w", err)
+	}
+
+	logger := knstchLog.NewLogger(cfg.ServiceName, knstchLog.InfoLevel)
+
+	db, err := gorm.Open(postgres.Open(cfg.GetDSN()), &gorm.Config{})
+	if err != nil {
+		return proofaudit.Report{}, "", fmt.Errorf("gorm.Open: %w", err)
+	}
+
+	dbRepo, err := repo.NewDBRepo(logger, db)
+	if err != nil {
+		return proofaudit.Report{}, "", fmt.Errorf("repo.NewDBRepo: %w", err)
+	}
+
+	auditor := proofaudit.NewAuditor(dbRepo, chains.NewRegistry(*cfg), *pageSize)
+
+	report, err := auditor.Run(context.Background())
+	if err != nil {
+		return proofaudit.Report{}, "", fmt.Errorf("auditor.Run: %w", err)
+	}
+
+	return report, *format, nil
+}
+
+func printReport(w io.Writer, report proofaudit.Report, format string) error {
+	if format == "json" {
+		if report.Findings == nil {
+			report.Findings = []proofaudit.Finding{}
+		}
+
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			return fmt.Errorf("json.Encode: %w", err)
+		}
+		return nil
+	}
+
+	for _, finding := range report.Findings {
+		line := fmt.Sprintf("%s: wallet %d (%s %s) of user %d", finding.Kind, finding.WalletID, finding.Provider, finding.Pubkey, finding.UserID)
+		if finding.ProofID != 0 {
+			line += fmt.Sprintf(", proof %d", finding.ProofID)
+		}
+		if finding.Detail != "" {
+			line += ": " + finding.Detail
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return fmt.Errorf("fmt.Fprintln: %w", err)
+		}
+	}
+
+	if _, err := fmt.Fprintf(w, "checked %d proofs and %d verified wallets, %d findings\n",
+		report.ProofsChecked, report.WalletsChecked, len(report.Findings)); err != nil {
+		return fmt.Errorf("fmt.Fprintf: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	knstchLog "github.com/knstch/knstch-libs/log"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"wallets-service/config"
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets"
+	"wallets-service/internal/wallets/repo"
+)
+
+const usage = `usage:
+  wallet-snapshots create [-label LABEL]
+  wallet-snapshots list
+  wallet-snapshots export -id ID [-format csv|jsonl] [-out FILE]`
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		log.Println(err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("missing command\n%s", usage)
+	}
+
+	switch args[0] {
+	case "create":
+		flags := flag.NewFlagSet("create", flag.ExitOnError)
+		label := flags.String("label", "", "label of the snapshot, e.g. the sale it is taken for")
+		if err := flags.Parse(args[1:]); err != nil {
+			return fmt.Errorf("flags.Parse: %w", err)
+		}
+
+		svc, err := newService()
+		if err != nil {
+			return err
+		}
+
+		snapshot, err := svc.CreateWalletSnapshot(context.Background(), *label)
+		if err != nil {
+			return fmt.Errorf("svc.CreateWalletSnapshot: %w", err)
+		}
+		printSnapshot(os.Stdout, snapshot)
+	case "list":
+		svc, err := newService()
+		if err != nil {
+			return err
+		}
+
+		snapshots, err := svc.ListWalletSnapshots(context.Background())
+		if err != nil {
+			return fmt.Errorf("svc.ListWalletSnapshots: %w", err)
+		}
+		for _, snapshot := range snapshots {
+			printSnapshot(os.Stdout, snapshot)
+		}
+	case "export":
+		flags := flag.NewFlagSet("export", flag.ExitOnError)
+		id := flags.Uint("id", 0, "ID of the snapshot")
+		rawFormat := flags.String("format", "csv", "output format: csv or jsonl")
+		out := flags.String("out", "", "output file; stdout if empty")
+		if err := flags.Parse(args[1:]); err != nil {
+			return fmt.Errorf("flags.Parse: %w", err)
+		}
+		if *id == 0 {
+			return fmt.Errorf("-id is required")
+		}
+		format, err := enum.GetSnapshotFormat(*rawFormat)
+		if err != nil {
+			return fmt.Errorf("enum.GetSnapshotFormat: %w", err)
+		}
+
+		svc, err := newService()
+		if err != nil {
+			return err
+		}
+
+		w := os.Stdout
+		if *out != "" {
+			if w, err = os.Create(*out); err != nil {
+				return fmt.Errorf("os.Create: %w", err)
+			}
+			defer w.Close()
+		}
+
+		buf := bufio.NewWriter(w)
+		if err = svc.ExportWalletSnapshot(context.Background(), *id, format, buf); err != nil {
+			return fmt.Errorf("svc.ExportWalletSnapshot: %w", err)
+		}
+		if err = buf.Flush(); err != nil {
+			return fmt.Errorf("buf.Flush: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown command %q\n%s", args[0], usage)
+	}
+
+	return nil
+}
+
+func newService() (*wallets.ServiceImpl, error) {
+	dir, err := filepath.Abs(filepath.Dir(os.Args[0]))
+	if err != nil {
+		return nil, fmt.Errorf("filepath.Abs: %w", err)
+	}
+
+	if err = config.InitENV(dir); err != nil {
+		return nil, fmt.Errorf("config.InitENV: %w", err)
+	}
+
+	cfg, err := config.GetConfig()
+	if err != nil {
+		return nil, fmt.Errorf("config.GetConfig: %w", err)
+	}
+
+	logger := knstchLog.NewLogger(cfg.ServiceName, knstchLog.InfoLevel)
+
+	db, err := gorm.Open(postgres.Open(cfg.GetDSN()), &gorm.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("gorm.Open: %w", err)
+	}
+
+	dbRepo, err := repo.NewDBRepo(logger, db)
+	if err != nil {
+		return nil, fmt.Errorf("repo.NewDBRepo: %w", err)
+	}
+
+	// Snapshots don't use challenges, so no Redis client is needed.
+	return wallets.NewService(logger, dbRepo, *cfg, nil), nil
+}
+
+func printSnapshot(w io.Writer, snapshot dto.WalletSnapshot) {
+	fmt.Fprintf(w, "%d\t%s\t%d wallets\tsha256:%s\t%s\n",
+		snapshot.ID, snapshot.TakenAt.UTC().Format(time.RFC3339), snapshot.WalletCount, snapshot.ContentHash, snapshot.Label)
+}
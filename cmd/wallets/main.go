@@ -18,19 +18,31 @@ import (
 	"github.com/redis/go-redis/v9"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 
 	privateApi "github.com/knstch/wallets-ido-api/private"
+	publicApi "github.com/knstch/wallets-ido-api/public"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 
 	"wallets-service/config"
+	"wallets-service/internal/attestations"
+	"wallets-service/internal/endpoints/interceptors"
 	"wallets-service/internal/endpoints/private"
 	"wallets-service/internal/endpoints/public"
+	"wallets-service/internal/health"
+	"wallets-service/internal/outbox"
 	"wallets-service/internal/wallets"
 	"wallets-service/internal/wallets/repo"
+	"wallets-service/internal/webhooks"
 )
 
+// grpcShutdownTimeout bounds how long a gRPC server waits for in-flight calls before closing them.
+const grpcShutdownTimeout = 10 * time.Second
+
 func main() {
 	if err := run(); err != nil {
 		defaultLog.Println(err)
@@ -60,6 +72,11 @@ func run() error {
 
 	logger := log.NewLogger(cfg.ServiceName, log.InfoLevel)
 
+	// Unkeyed hashes of client identifiers could be reversed by brute force, so proofs go without them.
+	if cfg.ProofsConfig.ClientHashKey == "" {
+		logger.Info("PROOFS_CLIENT_HASH_KEY is not set, verification proofs are stored without client IP and user agent hashes")
+	}
+
 	db, err := gorm.Open(postgres.Open(cfg.GetDSN()), &gorm.Config{})
 	if err != nil {
 		return fmt.Errorf("gorm.Open: %w", err)
@@ -78,14 +95,54 @@ func run() error {
 
 	svc := wallets.NewService(logger, dbRepo, *cfg, redisClient)
 
-	privateController := private.NewController(svc, logger, cfg)
+	attestationSigner, err := attestations.NewSigner(cfg.AttestationConfig)
+	if err != nil {
+		return fmt.Errorf("attestations.NewSigner: %w", err)
+	}
+	svc.SetAttestationSigner(attestationSigner)
+
+	// relayCtx lives as long as the server: background workers and open streams stop when it is cancelled.
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	defer stopRelay()
 
-	grpcServer := grpc.NewServer(
+	privateController := private.NewController(relayCtx, svc, logger, cfg)
+
+	privateOpts := []grpc.ServerOption{
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
-	)
+		grpc.ChainUnaryInterceptor(interceptors.UnaryErrorStatus(logger)),
+		grpc.ChainStreamInterceptor(interceptors.StreamErrorStatus(logger)),
+	}
+
+	privateCreds, err := private.ServerCredentials(cfg.PrivateGRPCConfig)
+	if err != nil {
+		return fmt.Errorf("private.ServerCredentials: %w", err)
+	}
+	if privateCreds != nil {
+		privateOpts = append(privateOpts, grpc.Creds(privateCreds))
+	}
+
+	serviceAuth, err := interceptors.NewServiceAuth(cfg.PrivateGRPCConfig, privateApi.WalletsPrivate_ServiceDesc)
+	if err != nil {
+		return fmt.Errorf("interceptors.NewServiceAuth: %w", err)
+	}
+	if serviceAuth != nil {
+		privateOpts = append(privateOpts,
+			grpc.ChainUnaryInterceptor(serviceAuth.Unary()),
+			grpc.ChainStreamInterceptor(serviceAuth.Stream()),
+		)
+	}
+
+	grpcServer := grpc.NewServer(privateOpts...)
 
 	privateApi.RegisterWalletsPrivateServer(grpcServer, privateController)
 
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+
+	if cfg.PrivateGRPCConfig.Reflection {
+		reflection.Register(grpcServer)
+	}
+
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.PrivateGRPCAddr))
 	if err != nil {
 		return fmt.Errorf("net.Listen: %w", err)
@@ -97,7 +154,57 @@ func run() error {
 		return grpcServer.Serve(lis)
 	})
 
+	healthChecker := health.NewChecker(logger, healthServer, map[string]health.Check{
+		"postgres": health.DBCheck(db),
+		"redis":    health.RedisCheck(redisClient),
+	}, []string{privateApi.WalletsPrivate_ServiceDesc.ServiceName}, cfg.HealthConfig)
+	g.Go(func() error {
+		return healthChecker.Run(relayCtx)
+	})
+
+	publisher, err := outbox.NewPublisher(cfg.OutboxConfig, redisClient)
+	if err != nil {
+		return fmt.Errorf("outbox.NewPublisher: %w", err)
+	}
+	if publisher != nil {
+		defer publisher.Close()
+
+		relay := outbox.NewRelay(logger, dbRepo, publisher, cfg.OutboxConfig)
+		g.Go(func() error {
+			return relay.Run(relayCtx)
+		})
+	}
+
+	dispatcher := webhooks.NewDispatcher(logger, dbRepo, nil, cfg.WebhooksConfig)
+	g.Go(func() error {
+		return dispatcher.Run(relayCtx)
+	})
+
 	publicController := public.NewController(svc, logger, cfg)
+
+	var publicGrpcServer *grpc.Server
+	if cfg.PublicGRPCAddr != "" {
+		publicGrpcServer = grpc.NewServer(
+			grpc.StatsHandler(otelgrpc.NewServerHandler()),
+			grpc.ChainUnaryInterceptor(
+				interceptors.UnaryErrorStatus(logger),
+				interceptors.UnaryBearerAuth(cfg.JwtSecret),
+				interceptors.UnaryRequestMeta(),
+			),
+		)
+
+		publicApi.RegisterWalletsServer(publicGrpcServer, publicController)
+
+		publicLis, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.PublicGRPCAddr))
+		if err != nil {
+			return fmt.Errorf("net.Listen: %w", err)
+		}
+
+		g.Go(func() error {
+			return publicGrpcServer.Serve(publicLis)
+		})
+	}
+
 	publicEndpoints := endpoints.InitHttpEndpoints(cfg.ServiceName, publicController.Endpoints())
 
 	srv := http.Server{
@@ -117,6 +224,12 @@ func run() error {
 		signal.Notify(sigint, os.Interrupt)
 		<-sigint
 
+		stopRelay()
+		healthServer.Shutdown()
+		gracefulStop(grpcServer, grpcShutdownTimeout)
+		if publicGrpcServer != nil {
+			gracefulStop(publicGrpcServer, grpcShutdownTimeout)
+		}
 		if err = srv.Shutdown(context.Background()); err != nil {
 			logger.Error("error shutting down", err)
 		}
@@ -135,3 +248,22 @@ func run() error {
 
 	return nil
 }
+
+// gracefulStop stops server gracefully, closing the remaining connections once timeout passes.
+func gracefulStop(server *grpc.Server, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-stopped:
+	case <-timer.C:
+		server.Stop()
+		<-stopped
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
@@ -24,10 +25,182 @@ type Config struct {
 
 	PrivateGRPCAddr string `envconfig:"PRIVATE_GRPC_ADDR"`
 
+	// PublicGRPCAddr is the public gRPC server port. The public gRPC API is not served when it is empty.
+	PublicGRPCAddr string `envconfig:"PUBLIC_GRPC_ADDR"`
+
+	PrivateGRPCConfig PrivateGRPCConfig
+	HealthConfig      HealthConfig
+
 	Environment string `envconfig:"ENVIRONMENT"`
 
-	DBConfig    DBConfig
-	RedisConfig RedisConfig
+	DBConfig           DBConfig
+	RedisConfig        RedisConfig
+	ReclaimConfig      ReclaimConfig
+	TransferConfig     TransferConfig
+	UnlinkConfig       UnlinkConfig
+	RelinkConfig       RelinkConfig
+	VerificationConfig VerificationConfig
+	ProofsConfig       ProofsConfig
+	OutboxConfig       OutboxConfig
+	WebhooksConfig     WebhooksConfig
+	WatchConfig        WatchConfig
+	AttestationConfig  AttestationConfig
+	SolanaConfig       SolanaConfig
+	StellarConfig      StellarConfig
+	CosmosConfig       CosmosConfig
+}
+
+// ReclaimConfig holds parameters of the flow that moves a wallet away from an account the user lost access to.
+type ReclaimConfig struct {
+	// WaitingPeriod is how long the current owner can contest a reclaim before it may be completed.
+	WaitingPeriod time.Duration `envconfig:"RECLAIM_WAITING_PERIOD" default:"72h"`
+}
+
+// TransferConfig holds parameters of wallet transfers between accounts.
+type TransferConfig struct {
+	// TTL is how long the target account has to accept and sign a transfer.
+	TTL time.Duration `envconfig:"TRANSFER_TTL" default:"24h"`
+}
+
+// UnlinkConfig holds parameters of wallet unlinking.
+type UnlinkConfig struct {
+	// StepUp requires verified wallets to confirm unlinking with a wallet signature or a second factor.
+	StepUp bool `envconfig:"UNLINK_STEP_UP" default:"false"`
+}
+
+// RelinkConfig limits how often wallets can change accounts through unlinking and adding them again.
+type RelinkConfig struct {
+	// Cooldown is how long after unlinking a pubkey can only be added again by its previous owner. Zero disables it.
+	Cooldown time.Duration `envconfig:"RELINK_COOLDOWN" default:"0"`
+	// ChurnWindow is the rolling window in which link and unlink events of a user are counted.
+	ChurnWindow time.Duration `envconfig:"RELINK_CHURN_WINDOW" default:"720h"`
+	// ChurnLimit is the number of link and unlink events a user may have within ChurnWindow. Zero disables it.
+	ChurnLimit int `envconfig:"RELINK_CHURN_LIMIT" default:"0"`
+}
+
+// VerificationConfig holds the validity of wallet verifications.
+type VerificationConfig struct {
+	// Validity is how long a verification stays valid. Zero means verifications never expire.
+	Validity time.Duration `envconfig:"VERIFICATION_VALIDITY" default:"8760h"`
+	// RenewalWindow is how long before expiry a wallet is reported as expiring soon and can be re-verified.
+	RenewalWindow time.Duration `envconfig:"VERIFICATION_RENEWAL_WINDOW" default:"720h"`
+}
+
+// ProofsConfig holds parameters of stored verification proofs.
+type ProofsConfig struct {
+	// ClientHashKey keys the hashes of client IP addresses and user agents. Without it proofs are stored without these hashes.
+	ClientHashKey string `envconfig:"PROOFS_CLIENT_HASH_KEY"`
+}
+
+// OutboxConfig holds parameters of the relay that publishes wallet domain events from the outbox.
+type OutboxConfig struct {
+	// Publisher selects the broker: "redis" for Redis Streams or "nats" for NATS JetStream.
+	// If empty, events are kept in the outbox and not published.
+	Publisher string `envconfig:"OUTBOX_PUBLISHER"`
+	// PollInterval is how often the relay looks for due events.
+	PollInterval time.Duration `envconfig:"OUTBOX_POLL_INTERVAL" default:"1s"`
+	// BatchSize is the maximum number of events published per poll.
+	BatchSize int `envconfig:"OUTBOX_BATCH_SIZE" default:"100"`
+	// RetryMinBackoff is the delay before retrying a failed event; it doubles with every failed attempt.
+	RetryMinBackoff time.Duration `envconfig:"OUTBOX_RETRY_MIN_BACKOFF" default:"1s"`
+	// RetryMaxBackoff caps the delay between retries.
+	RetryMaxBackoff time.Duration `envconfig:"OUTBOX_RETRY_MAX_BACKOFF" default:"5m"`
+	// RedisStream is the stream events are appended to by the Redis publisher.
+	RedisStream string `envconfig:"OUTBOX_REDIS_STREAM" default:"wallets:events"`
+	// RedisStreamMaxLen approximately caps the stream length. Zero keeps every event.
+	RedisStreamMaxLen int64 `envconfig:"OUTBOX_REDIS_STREAM_MAX_LEN" default:"100000"`
+	// NATSURL is the NATS server URL used by the NATS publisher.
+	NATSURL string `envconfig:"OUTBOX_NATS_URL" default:"nats://localhost:4222"`
+	// NATSSubjectPrefix prefixes event subjects, e.g. "wallets.wallet.verified".
+	NATSSubjectPrefix string `envconfig:"OUTBOX_NATS_SUBJECT_PREFIX" default:"wallets"`
+}
+
+// WebhooksConfig holds parameters of the dispatcher that delivers wallet domain events to webhook subscribers.
+type WebhooksConfig struct {
+	// PollInterval is how often the dispatcher looks for due deliveries.
+	PollInterval time.Duration `envconfig:"WEBHOOKS_POLL_INTERVAL" default:"1s"`
+	// BatchSize caps the number of deliveries sent per poll.
+	BatchSize int `envconfig:"WEBHOOKS_BATCH_SIZE" default:"50"`
+	// Timeout bounds a single delivery request.
+	Timeout time.Duration `envconfig:"WEBHOOKS_TIMEOUT" default:"10s"`
+	// MaxAttempts is the number of attempts after which a delivery is moved to the dead-letter state.
+	MaxAttempts int `envconfig:"WEBHOOKS_MAX_ATTEMPTS" default:"10"`
+	// RetryMinBackoff is the delay before the first retry; it doubles with every failed attempt.
+	RetryMinBackoff time.Duration `envconfig:"WEBHOOKS_RETRY_MIN_BACKOFF" default:"10s"`
+	// RetryMaxBackoff caps the delay between retries.
+	RetryMaxBackoff time.Duration `envconfig:"WEBHOOKS_RETRY_MAX_BACKOFF" default:"1h"`
+}
+
+// WatchConfig holds parameters of the wallet changes streams served to downstream caches.
+type WatchConfig struct {
+	// PollInterval is how often a stream looks for new wallet events.
+	PollInterval time.Duration `envconfig:"WATCH_POLL_INTERVAL" default:"1s"`
+	// BatchSize caps the number of wallets or events read per query.
+	BatchSize int `envconfig:"WATCH_BATCH_SIZE" default:"500"`
+}
+
+// AttestationConfig holds parameters of the signed wallet-ownership attestations minted on verification.
+type AttestationConfig struct {
+	// SigningKey is the base64 encoded 32-byte Ed25519 seed attestations are signed with. If empty,
+	// attestations are disabled.
+	SigningKey string `envconfig:"ATTESTATION_SIGNING_KEY"`
+	// RetiredKeys are base64 encoded Ed25519 public keys of previous signing keys, still published
+	// in the JWKS so attestations they signed verify until they expire.
+	RetiredKeys []string `envconfig:"ATTESTATION_RETIRED_KEYS"`
+	// Issuer is the "iss" claim of attestations.
+	Issuer string `envconfig:"ATTESTATION_ISSUER" default:"wallets-service"`
+	// TTL is the lifetime of an attestation; it never outlives the verification of the wallet.
+	TTL time.Duration `envconfig:"ATTESTATION_TTL" default:"24h"`
+}
+
+// PrivateGRPCConfig holds transport security and caller authorization of the private gRPC API.
+type PrivateGRPCConfig struct {
+	// TLSCertFile is the PEM server certificate. If empty, the server runs without TLS.
+	TLSCertFile string `envconfig:"PRIVATE_GRPC_TLS_CERT_FILE"`
+	// TLSKeyFile is the PEM private key of TLSCertFile.
+	TLSKeyFile string `envconfig:"PRIVATE_GRPC_TLS_KEY_FILE"`
+	// TLSClientCAFile is the PEM bundle of CAs client certificates must chain to. If set, every client
+	// must present a certificate (mTLS).
+	TLSClientCAFile string `envconfig:"PRIVATE_GRPC_TLS_CLIENT_CA_FILE"`
+	// ServiceTokens maps caller names to the bearer tokens they authenticate with
+	// (e.g. "notifications:token1,admin:token2"). If empty, callers are not authenticated.
+	ServiceTokens map[string]string `envconfig:"PRIVATE_GRPC_SERVICE_TOKENS"`
+	// CallerMethods maps caller names to the "|" separated RPC methods they may call
+	// (e.g. "notifications:GetWalletByUserID|GetWalletsByUserIDs,admin:*"). Callers without an entry may call nothing.
+	CallerMethods map[string]string `envconfig:"PRIVATE_GRPC_CALLER_METHODS"`
+	// Reflection registers the gRPC server reflection service for debugging. When service tokens are
+	// configured, only callers allowed every method ("*") may use it.
+	Reflection bool `envconfig:"PRIVATE_GRPC_REFLECTION" default:"false"`
+}
+
+// HealthConfig holds parameters of the dependency checks behind the gRPC health service.
+type HealthConfig struct {
+	// CheckInterval is how often Postgres and Redis are checked.
+	CheckInterval time.Duration `envconfig:"HEALTH_CHECK_INTERVAL" default:"5s"`
+	// CheckTimeout caps a single dependency check.
+	CheckTimeout time.Duration `envconfig:"HEALTH_CHECK_TIMEOUT" default:"2s"`
+}
+
+// SolanaConfig holds Sign In With Solana (SIWS) parameters for Solana wallets.
+type SolanaConfig struct {
+	// SIWSDomain is the domain requesting the sign-in. If empty, SIWS challenges are disabled.
+	SIWSDomain string `envconfig:"SOLANA_SIWS_DOMAIN"`
+	// SIWSURI is the optional URI referring to the resource that is the subject of the sign-in.
+	SIWSURI string `envconfig:"SOLANA_SIWS_URI"`
+	// ChainID is the Solana cluster the sign-in is bound to.
+	ChainID string `envconfig:"SOLANA_CHAIN_ID" default:"mainnet"`
+}
+
+// StellarConfig holds SEP-10 challenge parameters for Stellar wallets.
+type StellarConfig struct {
+	// SigningSeed is the S... secret seed of the server account that signs challenge transactions.
+	SigningSeed string `envconfig:"STELLAR_SIGNING_SEED"`
+	// NetworkPassphrase identifies the Stellar network challenges are built for.
+	NetworkPassphrase string `envconfig:"STELLAR_NETWORK_PASSPHRASE" default:"Public Global Stellar Network ; September 2015"`
+	// HomeDomain is used as the "<home domain> auth" manage_data key.
+	HomeDomain string `envconfig:"STELLAR_HOME_DOMAIN"`
+	// WebAuthDomain is the domain of the service issuing challenges.
+	WebAuthDomain string `envconfig:"STELLAR_WEB_AUTH_DOMAIN"`
 }
 
 // DBConfig holds Postgres connection parameters.
@@ -42,6 +215,12 @@ type DBConfig struct {
 	Password string `envconfig:"PG_PASSWORD"`
 }
 
+// CosmosConfig holds ADR-036 verification parameters for Cosmos wallets.
+type CosmosConfig struct {
+	// Bech32Prefixes lists the accepted account address prefixes (e.g. "cosmos,osmo,juno").
+	Bech32Prefixes []string `envconfig:"COSMOS_BECH32_PREFIXES" default:"cosmos"`
+}
+
 // GetDSN returns a postgres DSN for gorm/pgx.
 func (cfg *Config) GetDSN() string {
 	return fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable",
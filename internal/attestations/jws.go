@@ -0,0 +1,159 @@
+// Package attestations mints signed wallet-ownership attestations, so other services can check that a user
+// owns a wallet offline instead of calling the wallets service.
+//
+// Attestations are compact JWS tokens (RFC 7515) signed with Ed25519 ("EdDSA", RFC 8037). The public keys are
+// published as a JSON Web Key Set; every key is identified by its RFC 7638 thumbprint.
+package attestations
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	algEdDSA   = "EdDSA"
+	keyTypeOKP = "OKP"
+	curve      = "Ed25519"
+	tokenType  = "JWT"
+)
+
+// ErrInvalidToken is returned by KeySet.Verify for malformed, forged or expired tokens.
+var ErrInvalidToken = errors.New("invalid attestation")
+
+// WalletClaims are the claims of a wallet-ownership attestation.
+type WalletClaims struct {
+	Issuer string `json:"iss"`
+	// Subject is the user ID as a decimal string.
+	Subject  string `json:"sub"`
+	UserID   uint   `json:"user_id"`
+	Pubkey   string `json:"pubkey"`
+	Provider string `json:"provider"`
+	// VerifiedAt is the unix time (seconds) the wallet was last verified at.
+	VerifiedAt int64 `json:"verified_at"`
+	IssuedAt   int64 `json:"iat"`
+	// ExpiresAt is the unix time (seconds) after which the attestation must be rejected.
+	ExpiresAt int64 `json:"exp"`
+}
+
+type header struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+	Typ string `json:"typ,omitempty"`
+}
+
+// JWK is an Ed25519 public key in JSON Web Key format (RFC 8037).
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+}
+
+// KeySet is a JSON Web Key Set, as served at /.well-known/jwks.json.
+type KeySet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// newJWK returns the JWK of an Ed25519 public key.
+func newJWK(pub ed25519.PublicKey) JWK {
+	x := base64.RawURLEncoding.EncodeToString(pub)
+	return JWK{
+		Kty: keyTypeOKP,
+		Crv: curve,
+		X:   x,
+		Kid: thumbprint(x),
+		Alg: algEdDSA,
+		Use: "sig",
+	}
+}
+
+// thumbprint returns the RFC 7638 thumbprint of an Ed25519 JWK with the given x coordinate: the base64url
+// SHA-256 of its required members in lexicographic order.
+func thumbprint(x string) string {
+	sum := sha256.Sum256([]byte(`{"crv":"` + curve + `","kty":"` + keyTypeOKP + `","x":"` + x + `"}`))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// sign returns the compact JWS of claims signed by key.
+func sign(key ed25519.PrivateKey, kid string, claims any) (string, error) {
+	headerJSON, err := json.Marshal(header{Alg: algEdDSA, Kid: kid, Typ: tokenType})
+	if err != nil {
+		return "", fmt.Errorf("json.Marshal: %w", err)
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	signature := ed25519.Sign(key, []byte(signingInput))
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// Verify checks the signature of an attestation against the key set and that it has not expired at now,
+// and returns its claims.
+//
+// If the token is malformed, signed by an unknown key or expired, Verify returns an error wrapping ErrInvalidToken.
+func (k KeySet) Verify(token string, now time.Time) (WalletClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return WalletClaims{}, fmt.Errorf("token must have 3 parts: %w", ErrInvalidToken)
+	}
+
+	headerJSON, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return WalletClaims{}, fmt.Errorf("header: %w", errors.Join(err, ErrInvalidToken))
+	}
+	var h header
+	if err = json.Unmarshal(headerJSON, &h); err != nil {
+		return WalletClaims{}, fmt.Errorf("json.Unmarshal: %w", errors.Join(err, ErrInvalidToken))
+	}
+	if h.Alg != algEdDSA {
+		return WalletClaims{}, fmt.Errorf("unsupported alg %q: %w", h.Alg, ErrInvalidToken)
+	}
+
+	var pub ed25519.PublicKey
+	for _, key := range k.Keys {
+		if key.Kid != h.Kid || key.Kty != keyTypeOKP || key.Crv != curve {
+			continue
+		}
+		if pub, err = base64.RawURLEncoding.DecodeString(key.X); err != nil || len(pub) != ed25519.PublicKeySize {
+			return WalletClaims{}, fmt.Errorf("key %s is malformed: %w", key.Kid, ErrInvalidToken)
+		}
+		break
+	}
+	if pub == nil {
+		return WalletClaims{}, fmt.Errorf("unknown key %q: %w", h.Kid, ErrInvalidToken)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return WalletClaims{}, fmt.Errorf("signature: %w", errors.Join(err, ErrInvalidToken))
+	}
+	if !ed25519.Verify(pub, []byte(parts[0]+"."+parts[1]), signature) {
+		return WalletClaims{}, fmt.Errorf("signature mismatch: %w", ErrInvalidToken)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return WalletClaims{}, fmt.Errorf("payload: %w", errors.Join(err, ErrInvalidToken))
+	}
+	var claims WalletClaims
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return WalletClaims{}, fmt.Errorf("json.Unmarshal: %w", errors.Join(err, ErrInvalidToken))
+	}
+	if !now.Before(time.Unix(claims.ExpiresAt, 0)) {
+		return WalletClaims{}, fmt.Errorf("attestation expired: %w", ErrInvalidToken)
+	}
+
+	return claims, nil
+}
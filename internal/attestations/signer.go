@@ -0,0 +1,91 @@
+package attestations
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"wallets-service/config"
+)
+
+// Signer mints attestations with the active key and publishes it along with retired keys.
+type Signer struct {
+	key    ed25519.PrivateKey
+	kid    string
+	keySet KeySet
+	issuer string
+	ttl    time.Duration
+}
+
+// NewSigner returns the Signer configured by cfg, or nil if attestations are disabled.
+//
+// Keys are rotated by moving the public key of the active key to cfg.RetiredKeys and setting a new signing key;
+// the retired key can be dropped once the attestations it signed have expired.
+func NewSigner(cfg config.AttestationConfig) (*Signer, error) {
+	if cfg.SigningKey == "" {
+		return nil, nil
+	}
+
+	seed, err := base64.StdEncoding.DecodeString(cfg.SigningKey)
+	if err != nil {
+		return nil, fmt.Errorf("base64.StdEncoding.DecodeString: %w", err)
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("signing key must be a %d-byte Ed25519 seed, got %d bytes", ed25519.SeedSize, len(seed))
+	}
+
+	var retired []ed25519.PublicKey
+	for _, encoded := range cfg.RetiredKeys {
+		pub, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("base64.StdEncoding.DecodeString: %w", err)
+		}
+		if len(pub) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("retired key must be a %d-byte Ed25519 public key, got %d bytes", ed25519.PublicKeySize, len(pub))
+		}
+		retired = append(retired, pub)
+	}
+
+	return newSigner(ed25519.NewKeyFromSeed(seed), retired, cfg.Issuer, cfg.TTL), nil
+}
+
+func newSigner(key ed25519.PrivateKey, retired []ed25519.PublicKey, issuer string, ttl time.Duration) *Signer {
+	active := newJWK(key.Public().(ed25519.PublicKey))
+
+	keySet := KeySet{Keys: []JWK{active}}
+	for _, pub := range retired {
+		if jwk := newJWK(pub); jwk.Kid != active.Kid {
+			keySet.Keys = append(keySet.Keys, jwk)
+		}
+	}
+
+	return &Signer{
+		key:    key,
+		kid:    active.Kid,
+		keySet: keySet,
+		issuer: issuer,
+		ttl:    ttl,
+	}
+}
+
+// KeySet returns the public keys attestations are verified with, the active key first.
+func (s *Signer) KeySet() KeySet {
+	return s.keySet
+}
+
+// TTL returns the configured lifetime of attestations.
+func (s *Signer) TTL() time.Duration {
+	return s.ttl
+}
+
+// Sign fills in the issuer of the claims and returns them as a compact JWS.
+func (s *Signer) Sign(claims WalletClaims) (string, error) {
+	claims.Issuer = s.issuer
+
+	token, err := sign(s.key, s.kid, claims)
+	if err != nil {
+		return "", fmt.Errorf("sign: %w", err)
+	}
+	return token, nil
+}
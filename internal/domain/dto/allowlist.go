@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"time"
+
+	"wallets-service/internal/domain/enum"
+)
+
+// Allowlist is a Merkle tree over the wallets of a snapshot, published on-chain by its root.
+type Allowlist struct {
+	ID         uint
+	SnapshotID uint
+	Label      string
+	Hash       enum.MerkleHash
+	Encoding   enum.LeafEncoding
+	// Root is the 0x-prefixed hex encoded root of the tree.
+	Root      string
+	LeafCount int
+	CreatedAt time.Time
+}
+
+// AllowlistLeaf is a wallet included in an allowlist.
+type AllowlistLeaf struct {
+	// Position is the index of the leaf among the sorted leaf hashes.
+	Position int
+	UserID   uint
+	WalletID uint
+	Pubkey   string
+	Provider enum.Provider
+	// Amount is the decimal allocation of the wallet, empty for encodings without an amount.
+	Amount string
+	// Hash is the 0x-prefixed hex encoded leaf hash.
+	Hash string
+}
+
+// AllowlistProof is the inclusion proof of a wallet in an allowlist.
+type AllowlistProof struct {
+	Allowlist Allowlist
+	Leaf      AllowlistLeaf
+	// Proof holds the 0x-prefixed hex encoded sibling hashes from the leaf up to the root.
+	Proof []string
+}
+
+// AllowlistSpec describes how to build an allowlist from a wallet snapshot.
+type AllowlistSpec struct {
+	SnapshotID uint
+	Label      string
+	Hash       enum.MerkleHash
+	Encoding   enum.LeafEncoding
+	// Providers restricts the allowlist to wallets of these providers; empty means every provider.
+	Providers []enum.Provider
+	// DefaultAmount is the decimal allocation of wallets missing from Allocations, zero when empty.
+	DefaultAmount string
+	// Allocations maps pubkeys to decimal allocations.
+	Allocations map[string]string
+}
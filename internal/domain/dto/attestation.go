@@ -0,0 +1,11 @@
+package dto
+
+import "time"
+
+// WalletAttestation is a signed statement that a user owns a verified wallet, verifiable offline
+// against the published JWKS.
+type WalletAttestation struct {
+	// Token is the compact JWS of the attestation.
+	Token     string
+	ExpiresAt time.Time
+}
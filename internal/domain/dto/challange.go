@@ -1,6 +1,9 @@
 package dto
 
+import "wallets-service/internal/domain/enum"
+
 type ChallengeForUser struct {
 	ChallengeID   string
 	MessageToSign string
+	MessageFormat enum.MessageFormat
 }
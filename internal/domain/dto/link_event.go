@@ -0,0 +1,15 @@
+package dto
+
+import (
+	"time"
+
+	"wallets-service/internal/domain/enum"
+)
+
+type LinkEvent struct {
+	ID        uint
+	UserID    uint
+	Pubkey    string
+	Action    enum.LinkAction
+	CreatedAt time.Time
+}
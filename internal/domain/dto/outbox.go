@@ -0,0 +1,35 @@
+package dto
+
+import "time"
+
+// OutboxMessage is a domain event waiting in the transactional outbox to be published.
+type OutboxMessage struct {
+	ID uint
+	// EventID uniquely identifies the event, so consumers can drop redeliveries.
+	EventID string
+	// Type is the domain event type, e.g. "wallet.verified".
+	Type string
+	// UserID is the account the event belongs to. Events of a user are published in order.
+	UserID  uint
+	Payload []byte
+
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	PublishedAt   *time.Time
+	CreatedAt     time.Time
+}
+
+// WalletDomainEvent is the payload of wallet events published to other services.
+type WalletDomainEvent struct {
+	EventID    string    `json:"event_id"`
+	Type       string    `json:"type"`
+	UserID     uint      `json:"user_id"`
+	WalletID   uint      `json:"wallet_id"`
+	Pubkey     string    `json:"pubkey"`
+	Provider   string    `json:"provider"`
+	RequestID  string    `json:"request_id,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+	// Metadata holds event specific details, e.g. the reclaim of a reclaim request.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"time"
+
+	"wallets-service/internal/domain/enum"
+)
+
+// OwnershipProof is a verified signature of a custom statement by the linked wallet of a user.
+type OwnershipProof struct {
+	ChallengeID string
+	UserID      uint
+	WalletID    uint
+	Pubkey      string
+	Provider    enum.Provider
+	// Purpose is the purpose the challenge was requested for by the calling service.
+	Purpose   string
+	Statement string
+	// Message is the exact payload the user signed.
+	Message   string
+	Signature string
+	// Encoding is the format the signature was submitted in, e.g. "base58".
+	Encoding   string
+	VerifiedAt time.Time
+}
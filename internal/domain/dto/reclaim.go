@@ -0,0 +1,20 @@
+package dto
+
+import (
+	"time"
+
+	"wallets-service/internal/domain/enum"
+)
+
+type WalletReclaim struct {
+	ID          uint
+	WalletID    uint
+	Pubkey      string
+	Provider    enum.Provider
+	FromUserID  uint
+	ToUserID    uint
+	Status      enum.ReclaimStatus
+	AvailableAt time.Time
+	ResolvedAt  *time.Time
+	CreatedAt   time.Time
+}
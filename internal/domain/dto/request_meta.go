@@ -0,0 +1,8 @@
+package dto
+
+// RequestMeta describes the client request an operation was made in.
+type RequestMeta struct {
+	IP        string
+	UserAgent string
+	RequestID string
+}
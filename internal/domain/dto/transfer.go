@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"time"
+
+	"wallets-service/internal/domain/enum"
+)
+
+type WalletTransfer struct {
+	ID         uint
+	WalletID   uint
+	Pubkey     string
+	Provider   enum.Provider
+	FromUserID uint
+	ToUserID   uint
+	Status     enum.TransferStatus
+	ExpiresAt  time.Time
+	AcceptedAt *time.Time
+	ResolvedAt *time.Time
+	CreatedAt  time.Time
+}
@@ -0,0 +1,9 @@
+package dto
+
+// UnlinkResult is the outcome of an unlink request.
+type UnlinkResult struct {
+	// Unlinked reports whether the wallet was deleted right away.
+	Unlinked bool
+	// Challenge must be signed by the wallet and passed to ConfirmUnlink when Unlinked is false.
+	Challenge ChallengeForUser
+}
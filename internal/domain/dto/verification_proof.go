@@ -0,0 +1,44 @@
+package dto
+
+import (
+	"time"
+
+	"wallets-service/internal/domain/enum"
+)
+
+// VerificationProof is the evidence a wallet verification was accepted on.
+type VerificationProof struct {
+	ID       uint
+	WalletID uint
+	UserID   uint
+	Pubkey   string
+	Provider enum.Provider
+
+	ChallengeID string
+	Nonce       string
+	// ExpiresAt is the challenge expiry as a unix timestamp (seconds).
+	ExpiresAt int64
+	Format    enum.MessageFormat
+	// Statement is the custom statement of the challenge; empty means the wallet verification statement.
+	Statement string
+	// Message is the challenge message shown to the user.
+	Message []byte
+	// SignedPayload is the exact byte string the signature covers, i.e. Message wrapped the way the
+	// chain signs it, such as an off-chain message envelope, an ADR-036 sign doc or a Stellar
+	// transaction signature payload.
+	SignedPayload []byte
+	Signature     string
+	// Encoding is the format the signature was submitted in.
+	Encoding string
+	// VerifierVersion identifies the verification rules that accepted the signature.
+	VerifierVersion string
+
+	// IPHash and UserAgentHash are keyed hashes of the client address and user agent.
+	// They are empty when no hash key is configured.
+	IPHash        string
+	UserAgentHash string
+
+	// VerifiedAt is the time the signature was checked at. It is zero for proofs stored before it was recorded.
+	VerifiedAt time.Time
+	CreatedAt  time.Time
+}
@@ -12,4 +12,27 @@ type Wallet struct {
 	Pubkey     string
 	Provider   enum.Provider
 	VerifiedAt *time.Time
+	CreatedAt  time.Time
+
+	// VerificationStatus is derived from VerifiedAt and the configured validity period.
+	VerificationStatus enum.VerificationStatus
+	// VerificationExpiresAt is when the verification expires; nil if the wallet is unverified or verifications never expire.
+	VerificationExpiresAt *time.Time
+}
+
+// UserWalletLookup is the result of looking up the wallet of one user in a batch.
+type UserWalletLookup struct {
+	UserID uint
+	// Found is false if the user has no wallet, or no valid verification when only verified wallets are requested.
+	Found  bool
+	Wallet Wallet
+}
+
+// PubkeyWalletLookup is the result of looking up the wallet of one pubkey in a batch.
+type PubkeyWalletLookup struct {
+	// Pubkey is the address as requested, before normalization.
+	Pubkey string
+	// Found is false if the address is not linked to any user or is not an address of a supported chain.
+	Found  bool
+	Wallet Wallet
 }
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"wallets-service/internal/domain/enum"
+)
+
+// WalletEvent is an entry of the append-only wallet audit log.
+type WalletEvent struct {
+	ID       uint
+	Type     enum.WalletEventType
+	UserID   uint
+	WalletID uint
+	Pubkey   string
+	Provider enum.Provider
+	Actor    enum.WalletEventActor
+	// RequestID is the X-Request-ID of the API request that caused the event, if any.
+	RequestID string
+	// Metadata holds event specific details, e.g. the signature encoding of a verification.
+	Metadata  map[string]string
+	CreatedAt time.Time
+}
+
+// WalletChange is a message of a wallet changes stream.
+type WalletChange struct {
+	// Sequence is the ID of the wallet event the change comes from. Snapshot messages carry the sequence
+	// the snapshot was taken at, so resuming from any received sequence never skips a change.
+	Sequence uint
+	Type     enum.WalletChangeType
+	// Wallet is the state of the wallet after the change; it is empty for the snapshot end marker.
+	Wallet Wallet
+}
@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"time"
+
+	"wallets-service/internal/domain/enum"
+)
+
+// WalletSnapshot is a frozen list of the wallets that were verified at a point in time.
+type WalletSnapshot struct {
+	ID    uint
+	Label string
+	// TakenAt is the point in time the verifications were checked at.
+	TakenAt     time.Time
+	WalletCount int
+	// ContentHash is the hex encoded SHA-256 of the CSV export of the snapshot.
+	ContentHash string
+	CreatedAt   time.Time
+}
+
+// WalletSnapshotEntry is a wallet captured by a snapshot.
+type WalletSnapshotEntry struct {
+	UserID     uint
+	WalletID   uint
+	Pubkey     string
+	Provider   enum.Provider
+	VerifiedAt time.Time
+}
@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"time"
+
+	"wallets-service/internal/domain/enum"
+)
+
+// WebhookSubscription is a partner endpoint receiving wallet domain events over HTTP.
+type WebhookSubscription struct {
+	ID  uint
+	URL string
+	// EventTypes lists the domain event types delivered to the subscription, e.g. "wallet.verified".
+	EventTypes []string
+	// Secret keys the HMAC-SHA256 signature of every delivery.
+	Secret    string
+	CreatedAt time.Time
+}
+
+// WebhookDelivery is a domain event scheduled for delivery to a webhook subscription.
+type WebhookDelivery struct {
+	ID             uint
+	SubscriptionID uint
+	EventID        string
+	EventType      string
+	Payload        []byte
+	Status         enum.WebhookDeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastError      string
+	LastStatusCode int
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+}
@@ -0,0 +1,64 @@
+package enum
+
+import "fmt"
+
+// MerkleHash is the hash function of an allowlist Merkle tree.
+type MerkleHash string
+
+func (m MerkleHash) String() string {
+	return string(m)
+}
+
+const (
+	// MerkleHashKeccak256 is the legacy Keccak-256 of the EVM, used by Solidity claim contracts.
+	MerkleHashKeccak256 MerkleHash = "keccak256"
+	// MerkleHashSHA256 is SHA-256, used by Solana programs through the sha256 syscall.
+	MerkleHashSHA256 MerkleHash = "sha256"
+)
+
+func GetMerkleHash(hash string) (MerkleHash, error) {
+	switch hash {
+	case "keccak256":
+		return MerkleHashKeccak256, nil
+	case "sha256":
+		return MerkleHashSHA256, nil
+	default:
+		return "", fmt.Errorf("unknown merkle hash: %s", hash)
+	}
+}
+
+// LeafEncoding describes the bytes hashed into an allowlist leaf.
+type LeafEncoding string
+
+func (l LeafEncoding) String() string {
+	return string(l)
+}
+
+const (
+	// LeafEncodingAddress is the raw address bytes of the wallet.
+	LeafEncodingAddress LeafEncoding = "address"
+	// LeafEncodingAddressAmountU64LE is the address followed by the allocation as a little-endian uint64,
+	// as Borsh serializes it for Solana programs.
+	LeafEncodingAddressAmountU64LE LeafEncoding = "address_amount_u64le"
+	// LeafEncodingAddressAmountU256BE is the address followed by the allocation as a big-endian uint256,
+	// as abi.encodePacked(address, uint256) lays it out for Solidity contracts.
+	LeafEncodingAddressAmountU256BE LeafEncoding = "address_amount_u256be"
+)
+
+func GetLeafEncoding(encoding string) (LeafEncoding, error) {
+	switch encoding {
+	case "address":
+		return LeafEncodingAddress, nil
+	case "address_amount_u64le":
+		return LeafEncodingAddressAmountU64LE, nil
+	case "address_amount_u256be":
+		return LeafEncodingAddressAmountU256BE, nil
+	default:
+		return "", fmt.Errorf("unknown leaf encoding: %s", encoding)
+	}
+}
+
+// HasAmount reports whether the encoding includes an allocation amount.
+func (l LeafEncoding) HasAmount() bool {
+	return l != LeafEncodingAddress
+}
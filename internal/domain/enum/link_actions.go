@@ -0,0 +1,28 @@
+package enum
+
+import "fmt"
+
+// LinkAction is a change of the binding between a wallet and an account.
+type LinkAction string
+
+func (l LinkAction) String() string {
+	return string(l)
+}
+
+const (
+	// LinkActionLink means a wallet was added to an account.
+	LinkActionLink LinkAction = "link"
+	// LinkActionUnlink means a wallet was removed from an account.
+	LinkActionUnlink LinkAction = "unlink"
+)
+
+func GetLinkAction(action string) (LinkAction, error) {
+	switch action {
+	case "link":
+		return LinkActionLink, nil
+	case "unlink":
+		return LinkActionUnlink, nil
+	default:
+		return "", fmt.Errorf("unknown link action: %s", action)
+	}
+}
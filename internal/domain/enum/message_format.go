@@ -0,0 +1,19 @@
+package enum
+
+// MessageFormat identifies how a Solana challenge is presented to the wallet and what bytes it signs.
+type MessageFormat string
+
+func (f MessageFormat) String() string {
+	return string(f)
+}
+
+const (
+	// MessageFormatNative means the chain has a single proof format of its own (e.g. SEP-10 for Stellar).
+	MessageFormatNative MessageFormat = ""
+	// MessageFormatText is the plain challenge text signed with signMessage.
+	MessageFormatText MessageFormat = "text"
+	// MessageFormatSIWS is a Sign In With Solana message signed with signMessage or signIn.
+	MessageFormatSIWS MessageFormat = "siws"
+	// MessageFormatSolanaOffchain is the challenge text wrapped into a Solana off-chain message envelope.
+	MessageFormatSolanaOffchain MessageFormat = "solana_offchain"
+)
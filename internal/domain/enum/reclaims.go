@@ -0,0 +1,36 @@
+package enum
+
+import "fmt"
+
+// ReclaimStatus is the state of a wallet reclaim.
+type ReclaimStatus string
+
+func (r ReclaimStatus) String() string {
+	return string(r)
+}
+
+const (
+	// ReclaimStatusPending means possession was proven and the waiting period is running.
+	ReclaimStatusPending ReclaimStatus = "pending"
+	// ReclaimStatusContested means the current owner objected; the wallet stays where it is.
+	ReclaimStatusContested ReclaimStatus = "contested"
+	// ReclaimStatusCompleted means the wallet was moved to the claimant.
+	ReclaimStatusCompleted ReclaimStatus = "completed"
+	// ReclaimStatusCancelled means the wallet changed hands before the reclaim could complete.
+	ReclaimStatusCancelled ReclaimStatus = "cancelled"
+)
+
+func GetReclaimStatus(status string) (ReclaimStatus, error) {
+	switch status {
+	case "pending":
+		return ReclaimStatusPending, nil
+	case "contested":
+		return ReclaimStatusContested, nil
+	case "completed":
+		return ReclaimStatusCompleted, nil
+	case "cancelled":
+		return ReclaimStatusCancelled, nil
+	default:
+		return "", fmt.Errorf("unknown reclaim status: %s", status)
+	}
+}
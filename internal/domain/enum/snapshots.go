@@ -0,0 +1,28 @@
+package enum
+
+import "fmt"
+
+// SnapshotFormat is an export format of a wallet snapshot.
+type SnapshotFormat string
+
+func (s SnapshotFormat) String() string {
+	return string(s)
+}
+
+const (
+	// SnapshotFormatCSV is comma-separated values with a header row.
+	SnapshotFormatCSV SnapshotFormat = "csv"
+	// SnapshotFormatJSONLines is one JSON object per line.
+	SnapshotFormatJSONLines SnapshotFormat = "jsonl"
+)
+
+func GetSnapshotFormat(format string) (SnapshotFormat, error) {
+	switch format {
+	case "csv":
+		return SnapshotFormatCSV, nil
+	case "jsonl":
+		return SnapshotFormatJSONLines, nil
+	default:
+		return "", fmt.Errorf("unknown snapshot format: %s", format)
+	}
+}
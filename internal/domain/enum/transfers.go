@@ -0,0 +1,40 @@
+package enum
+
+import "fmt"
+
+// TransferStatus is the state of a wallet transfer between accounts.
+type TransferStatus string
+
+func (t TransferStatus) String() string {
+	return string(t)
+}
+
+const (
+	// TransferStatusPending means the owner initiated the transfer and the target account has not accepted yet.
+	TransferStatusPending TransferStatus = "pending"
+	// TransferStatusAccepted means the target account accepted and a wallet signature is awaited.
+	TransferStatusAccepted TransferStatus = "accepted"
+	// TransferStatusCompleted means the wallet was moved to the target account.
+	TransferStatusCompleted TransferStatus = "completed"
+	// TransferStatusCancelled means either party cancelled the transfer.
+	TransferStatusCancelled TransferStatus = "cancelled"
+	// TransferStatusExpired means the transfer was not completed in time.
+	TransferStatusExpired TransferStatus = "expired"
+)
+
+func GetTransferStatus(status string) (TransferStatus, error) {
+	switch status {
+	case "pending":
+		return TransferStatusPending, nil
+	case "accepted":
+		return TransferStatusAccepted, nil
+	case "completed":
+		return TransferStatusCompleted, nil
+	case "cancelled":
+		return TransferStatusCancelled, nil
+	case "expired":
+		return TransferStatusExpired, nil
+	default:
+		return "", fmt.Errorf("unknown transfer status: %s", status)
+	}
+}
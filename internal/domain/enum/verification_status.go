@@ -0,0 +1,19 @@
+package enum
+
+// VerificationStatus is the state of a wallet verification derived from its age.
+type VerificationStatus string
+
+func (v VerificationStatus) String() string {
+	return string(v)
+}
+
+const (
+	// VerificationStatusUnverified means the wallet ownership was never proven.
+	VerificationStatusUnverified VerificationStatus = "unverified"
+	// VerificationStatusVerified means the verification is valid.
+	VerificationStatusVerified VerificationStatus = "verified"
+	// VerificationStatusExpiringSoon means the verification is valid but should be renewed.
+	VerificationStatusExpiringSoon VerificationStatus = "expiring_soon"
+	// VerificationStatusExpired means the verification is no longer valid and must be renewed.
+	VerificationStatusExpired VerificationStatus = "expired"
+)
@@ -0,0 +1,21 @@
+package enum
+
+// WalletChangeType is a kind of message in a wallet changes stream.
+type WalletChangeType string
+
+func (w WalletChangeType) String() string {
+	return string(w)
+}
+
+const (
+	// WalletChangeSnapshot carries a wallet of the initial snapshot.
+	WalletChangeSnapshot WalletChangeType = "snapshot"
+	// WalletChangeSnapshotEnd marks the end of the initial snapshot; live changes follow.
+	WalletChangeSnapshotEnd WalletChangeType = "snapshot_end"
+	// WalletChangeAdded means a wallet was added to an account.
+	WalletChangeAdded WalletChangeType = "added"
+	// WalletChangeVerified means the ownership of a wallet was verified or re-verified.
+	WalletChangeVerified WalletChangeType = "verified"
+	// WalletChangeUnlinked means a wallet was removed from an account.
+	WalletChangeUnlinked WalletChangeType = "unlinked"
+)
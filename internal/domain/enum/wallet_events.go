@@ -0,0 +1,44 @@
+package enum
+
+import "fmt"
+
+// WalletEventType is a kind of change recorded in the wallet audit log.
+type WalletEventType string
+
+func (w WalletEventType) String() string {
+	return string(w)
+}
+
+const (
+	// WalletEventAdded means a wallet was added to an account.
+	WalletEventAdded WalletEventType = "added"
+	// WalletEventVerified means the ownership of a wallet was verified or re-verified.
+	WalletEventVerified WalletEventType = "verified"
+	// WalletEventUnlinked means a wallet was removed from an account.
+	WalletEventUnlinked WalletEventType = "unlinked"
+)
+
+func GetWalletEventType(eventType string) (WalletEventType, error) {
+	switch eventType {
+	case "added":
+		return WalletEventAdded, nil
+	case "verified":
+		return WalletEventVerified, nil
+	case "unlinked":
+		return WalletEventUnlinked, nil
+	default:
+		return "", fmt.Errorf("unknown wallet event type: %s", eventType)
+	}
+}
+
+// WalletEventActor is who caused a wallet event.
+type WalletEventActor string
+
+func (w WalletEventActor) String() string {
+	return string(w)
+}
+
+const (
+	// WalletEventActorUser means the wallet owner acted through the public API.
+	WalletEventActorUser WalletEventActor = "user"
+)
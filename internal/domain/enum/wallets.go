@@ -9,13 +9,40 @@ func (r Provider) String() string {
 }
 
 const (
-	ProviderPhantom Provider = "phantom"
+	ProviderPhantom        Provider = "phantom"
+	ProviderSolflare       Provider = "solflare"
+	ProviderBackpack       Provider = "backpack"
+	ProviderGlow           Provider = "glow"
+	ProviderLedger         Provider = "ledger"
+	ProviderWalletStandard Provider = "wallet_standard"
+	ProviderStellar        Provider = "stellar"
+	ProviderCosmos         Provider = "cosmos"
+	ProviderAptos          Provider = "aptos"
+	ProviderSui            Provider = "sui"
 )
 
 func GetProvider(provider string) (Provider, error) {
 	switch provider {
 	case "phantom":
 		return ProviderPhantom, nil
+	case "solflare":
+		return ProviderSolflare, nil
+	case "backpack":
+		return ProviderBackpack, nil
+	case "glow":
+		return ProviderGlow, nil
+	case "ledger":
+		return ProviderLedger, nil
+	case "wallet_standard":
+		return ProviderWalletStandard, nil
+	case "stellar":
+		return ProviderStellar, nil
+	case "cosmos":
+		return ProviderCosmos, nil
+	case "aptos":
+		return ProviderAptos, nil
+	case "sui":
+		return ProviderSui, nil
 	default:
 		return "", fmt.Errorf("unknown provider: %s", provider)
 	}
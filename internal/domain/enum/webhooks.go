@@ -0,0 +1,32 @@
+package enum
+
+import "fmt"
+
+// WebhookDeliveryStatus is the state of a webhook delivery.
+type WebhookDeliveryStatus string
+
+func (w WebhookDeliveryStatus) String() string {
+	return string(w)
+}
+
+const (
+	// WebhookDeliveryStatusPending means the delivery is waiting for its first or next attempt.
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryStatusDelivered means the subscriber acknowledged the event with a 2xx response.
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	// WebhookDeliveryStatusDead means every attempt failed; the delivery is only retried by a replay.
+	WebhookDeliveryStatusDead WebhookDeliveryStatus = "dead"
+)
+
+func GetWebhookDeliveryStatus(status string) (WebhookDeliveryStatus, error) {
+	switch status {
+	case "pending":
+		return WebhookDeliveryStatusPending, nil
+	case "delivered":
+		return WebhookDeliveryStatusDelivered, nil
+	case "dead":
+		return WebhookDeliveryStatusDead, nil
+	default:
+		return "", fmt.Errorf("unknown webhook delivery status: %s", status)
+	}
+}
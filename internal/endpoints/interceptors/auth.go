@@ -0,0 +1,64 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/knstch/knstch-libs/auth"
+	"github.com/knstch/knstch-libs/svcerrs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const bearerPrefix = "Bearer "
+
+// UnaryBearerAuth validates the bearer JWT in the authorization metadata the same way
+// middleware.WithCookieAuth validates the HTTP header, and stores its claims for auth.GetUserData.
+func UnaryBearerAuth(secret string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get("authorization")
+		if len(values) == 0 {
+			return nil, svcerrs.ErrForbidden
+		}
+
+		authHeader := values[0]
+		if !strings.HasPrefix(authHeader, bearerPrefix) {
+			return nil, svcerrs.ErrForbidden
+		}
+
+		claims, err := decodeToken(secret, strings.TrimSpace(authHeader[len(bearerPrefix):]))
+		if err != nil {
+			if errors.Is(err, svcerrs.ErrUnauthorized) {
+				return nil, err
+			}
+			return nil, svcerrs.ErrForbidden
+		}
+
+		// auth.GetUserData reads the claims by this plain string key.
+		ctx = context.WithValue(ctx, "claims", claims)
+
+		return handler(ctx, req)
+	}
+}
+
+func decodeToken(secret string, token string) (auth.Claims, error) {
+	claims := auth.Claims{}
+
+	_, err := jwt.ParseWithClaims(token, &claims, func(jwtToken *jwt.Token) (interface{}, error) {
+		if _, ok := jwtToken.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, svcerrs.ErrForbidden
+		}
+		return []byte(secret), nil
+	})
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return claims, svcerrs.ErrUnauthorized
+		}
+		return claims, err
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,122 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/knstch/knstch-libs/log"
+	"github.com/knstch/knstch-libs/svcerrs"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wallets-service/internal/wallets"
+)
+
+// errorDomain is the domain of the ErrorInfo details attached to errors with a machine-readable code.
+const errorDomain = "wallets-service"
+
+// callSitePattern matches the "pkg.Func" prefixes errors are wrapped with on their way up.
+var callSitePattern = regexp.MustCompile(`^[A-Za-z_]\w*(\.[A-Za-z_]\w*)+$`)
+
+// UnaryErrorStatus converts errors returned by unary handlers into gRPC statuses, the same way the
+// HTTP transport maps them to response codes. Internal errors are logged and not exposed to the caller.
+func UnaryErrorStatus(lg *log.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, statusError(lg, info.FullMethod, err)
+		}
+		return resp, nil
+	}
+}
+
+// StreamErrorStatus is the streaming counterpart of UnaryErrorStatus.
+func StreamErrorStatus(lg *log.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return statusError(lg, info.FullMethod, err)
+		}
+		return nil
+	}
+}
+
+func statusError(lg *log.Logger, method string, err error) error {
+	statusErr := StatusError(err)
+	if status.Code(statusErr) == codes.Internal {
+		lg.Error("grpc call failed", err,
+			log.AddMessage("method", method),
+		)
+	}
+	return statusErr
+}
+
+// StatusError returns err as a gRPC status error with a message safe to show to the caller.
+// Errors with a machine-readable code carry it as the reason of an ErrorInfo detail.
+// Errors that already carry a status are returned unchanged.
+func StatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	code := StatusCode(err)
+	switch code {
+	case codes.Internal:
+		return status.Error(code, "internal error")
+	case codes.Canceled, codes.DeadlineExceeded:
+		return status.Error(code, code.String())
+	}
+
+	st := status.New(code, sanitizeMessage(err.Error()))
+	if reason := wallets.ErrorCode(err); reason != "" {
+		if withDetails, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+			Reason: reason,
+			Domain: errorDomain,
+		}); detailsErr == nil {
+			st = withDetails
+		}
+	}
+	return st.Err()
+}
+
+// StatusCode maps svcerrs sentinels and context errors to gRPC status codes.
+func StatusCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, svcerrs.ErrInvalidData):
+		return codes.InvalidArgument
+	case errors.Is(err, svcerrs.ErrDataNotFound):
+		return codes.NotFound
+	case errors.Is(err, svcerrs.ErrUnauthorized):
+		return codes.Unauthenticated
+	case errors.Is(err, svcerrs.ErrForbidden):
+		return codes.PermissionDenied
+	case errors.Is(err, svcerrs.ErrConflict):
+		return codes.AlreadyExists
+	case errors.Is(err, svcerrs.ErrGone):
+		return codes.FailedPrecondition
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	default:
+		return codes.Internal
+	}
+}
+
+// sanitizeMessage drops the call-site prefixes of a wrapped error message, so
+// "svc.GetWallet: repo.GetWallet: wallet not found: data not found" becomes "wallet not found: data not found".
+func sanitizeMessage(msg string) string {
+	for {
+		prefix, rest, found := strings.Cut(msg, ": ")
+		if !found || !callSitePattern.MatchString(prefix) {
+			return msg
+		}
+		msg = rest
+	}
+}
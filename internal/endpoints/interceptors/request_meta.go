@@ -0,0 +1,52 @@
+package interceptors
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/wallets"
+)
+
+// UnaryRequestMeta passes the client address, user agent and request ID of the call to the service.
+func UnaryRequestMeta() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+
+		return handler(wallets.WithRequestMeta(ctx, dto.RequestMeta{
+			IP:        clientIP(ctx, md),
+			UserAgent: firstValue(md, "user-agent"),
+			RequestID: firstValue(md, "x-request-id"),
+		}), req)
+	}
+}
+
+// clientIP returns the first x-forwarded-for address, or the remote address of the connection.
+func clientIP(ctx context.Context, md metadata.MD) string {
+	if forwardedFor := firstValue(md, "x-forwarded-for"); forwardedFor != "" {
+		first, _, _ := strings.Cut(forwardedFor, ",")
+		return strings.TrimSpace(first)
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	remoteAddr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		return host
+	}
+	return remoteAddr
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
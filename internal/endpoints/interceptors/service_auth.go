@@ -0,0 +1,153 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"wallets-service/config"
+)
+
+const allMethods = "*"
+
+var healthServicePrefix = "/" + healthpb.Health_ServiceDesc.ServiceName + "/"
+
+// ServiceAuth authenticates the services calling a gRPC API by their bearer tokens and only lets
+// each caller reach the methods it is allowed to call.
+type ServiceAuth struct {
+	callers []serviceCaller
+}
+
+type serviceCaller struct {
+	name      string
+	tokenHash [sha256.Size]byte
+	methods   map[string]struct{}
+	all       bool
+}
+
+// NewServiceAuth builds the caller allowlist of service from cfg. It returns nil if no service tokens are configured.
+func NewServiceAuth(cfg config.PrivateGRPCConfig, service grpc.ServiceDesc) (*ServiceAuth, error) {
+	if len(cfg.ServiceTokens) == 0 {
+		return nil, nil
+	}
+
+	known := make(map[string]struct{}, len(service.Methods)+len(service.Streams))
+	for _, method := range service.Methods {
+		known[method.MethodName] = struct{}{}
+	}
+	for _, stream := range service.Streams {
+		known[stream.StreamName] = struct{}{}
+	}
+
+	for name := range cfg.CallerMethods {
+		if _, ok := cfg.ServiceTokens[name]; !ok {
+			return nil, fmt.Errorf("methods are allowed to caller %q that has no service token", name)
+		}
+	}
+
+	auth := &ServiceAuth{}
+	seenTokens := make(map[[sha256.Size]byte]string, len(cfg.ServiceTokens))
+	for name, token := range cfg.ServiceTokens {
+		if token == "" {
+			return nil, fmt.Errorf("empty service token of caller %q", name)
+		}
+
+		caller := serviceCaller{
+			name:      name,
+			tokenHash: sha256.Sum256([]byte(token)),
+			methods:   map[string]struct{}{},
+		}
+		if other, ok := seenTokens[caller.tokenHash]; ok {
+			return nil, fmt.Errorf("callers %q and %q share a service token", other, name)
+		}
+		seenTokens[caller.tokenHash] = name
+
+		for _, method := range strings.Split(cfg.CallerMethods[name], "|") {
+			method = strings.TrimSpace(method)
+			switch method {
+			case "":
+				continue
+			case allMethods:
+				caller.all = true
+				continue
+			}
+			if _, ok := known[method]; !ok {
+				return nil, fmt.Errorf("unknown method %q allowed to caller %q", method, name)
+			}
+			caller.methods[fmt.Sprintf("/%s/%s", service.ServiceName, method)] = struct{}{}
+		}
+
+		auth.callers = append(auth.callers, caller)
+	}
+
+	return auth, nil
+}
+
+// Unary returns a unary interceptor rejecting calls of unknown callers and of methods the caller is not allowed to call.
+func (a *ServiceAuth) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := a.authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns the streaming counterpart of Unary.
+func (a *ServiceAuth) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := a.authorize(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func (a *ServiceAuth) authorize(ctx context.Context, fullMethod string) error {
+	// Health checks come from load balancers and orchestrators, which hold no service token.
+	if strings.HasPrefix(fullMethod, healthServicePrefix) {
+		return nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	authHeader := firstValue(md, "authorization")
+	if !strings.HasPrefix(authHeader, bearerPrefix) {
+		return status.Error(codes.Unauthenticated, "missing service token")
+	}
+
+	caller, ok := a.caller(strings.TrimSpace(authHeader[len(bearerPrefix):]))
+	if !ok {
+		return status.Error(codes.Unauthenticated, "invalid service token")
+	}
+
+	if _, ok = caller.methods[fullMethod]; !ok && !caller.all {
+		return status.Errorf(codes.PermissionDenied, "caller %s is not allowed to call %s", caller.name, fullMethod)
+	}
+
+	return nil
+}
+
+// caller returns the caller owning token. Every caller is compared in constant time.
+func (a *ServiceAuth) caller(token string) (serviceCaller, bool) {
+	tokenHash := sha256.Sum256([]byte(token))
+
+	var (
+		found  serviceCaller
+		exists bool
+	)
+	for _, caller := range a.callers {
+		if subtle.ConstantTimeCompare(tokenHash[:], caller.tokenHash[:]) == 1 {
+			found, exists = caller, true
+		}
+	}
+
+	return found, exists
+}
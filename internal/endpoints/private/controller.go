@@ -1,6 +1,8 @@
 package private
 
 import (
+	"context"
+
 	private "github.com/knstch/wallets-ido-api/private"
 
 	"github.com/knstch/knstch-libs/log"
@@ -14,13 +16,19 @@ type Controller struct {
 	lg  *log.Logger
 	cfg *config.Config
 
+	// serverCtx is cancelled when the server shuts down, which ends open streams.
+	serverCtx context.Context
+
 	private.UnimplementedWalletsPrivateServer
 }
 
-func NewController(svc wallets.Service, lg *log.Logger, cfg *config.Config) *Controller {
+// NewController constructs a Controller. serverCtx must be cancelled when the server starts shutting down,
+// so long-lived streams end and let a graceful stop complete.
+func NewController(serverCtx context.Context, svc wallets.Service, lg *log.Logger, cfg *config.Config) *Controller {
 	return &Controller{
-		svc: svc,
-		cfg: cfg,
-		lg:  lg,
+		svc:       svc,
+		cfg:       cfg,
+		lg:        lg,
+		serverCtx: serverCtx,
 	}
 }
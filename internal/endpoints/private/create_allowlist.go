@@ -0,0 +1,114 @@
+package private
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+)
+
+func (c *Controller) CreateAllowlist(ctx context.Context, req *private.CreateAllowlistRequest) (*private.CreateAllowlistResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "private: CreateAllowlist")
+	defer span.End()
+
+	hash, err := convertTransportMerkleHashToSvc(req.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	encoding, err := convertTransportLeafEncodingToSvc(req.GetLeafEncoding())
+	if err != nil {
+		return nil, err
+	}
+
+	providers := make([]enum.Provider, 0, len(req.GetProviders()))
+	for _, transportProvider := range req.GetProviders() {
+		provider, err := convertTransportProviderToSvc(transportProvider)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, provider)
+	}
+
+	allowlist, err := c.svc.CreateAllowlist(ctx, dto.AllowlistSpec{
+		SnapshotID:    uint(req.GetSnapshotId()),
+		Label:         req.GetLabel(),
+		Hash:          hash,
+		Encoding:      encoding,
+		Providers:     providers,
+		DefaultAmount: req.GetDefaultAmount(),
+		Allocations:   req.GetAllocations(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("svc.CreateAllowlist: %w", err)
+	}
+
+	return &private.CreateAllowlistResponse{
+		Allowlist: convertSvcAllowlistToTransport(allowlist),
+	}, nil
+}
+
+func convertSvcAllowlistToTransport(allowlist dto.Allowlist) *private.Allowlist {
+	return &private.Allowlist{
+		Id:           uint64(allowlist.ID),
+		SnapshotId:   uint64(allowlist.SnapshotID),
+		Label:        allowlist.Label,
+		Hash:         convertSvcMerkleHashToTransport(allowlist.Hash),
+		LeafEncoding: convertSvcLeafEncodingToTransport(allowlist.Encoding),
+		Root:         allowlist.Root,
+		LeafCount:    uint32(allowlist.LeafCount),
+		CreatedAt:    allowlist.CreatedAt.Unix(),
+	}
+}
+
+func convertTransportMerkleHashToSvc(hash private.MerkleHash) (enum.MerkleHash, error) {
+	switch hash {
+	case private.MerkleHash_MERKLE_HASH_KECCAK256:
+		return enum.MerkleHashKeccak256, nil
+	case private.MerkleHash_MERKLE_HASH_SHA256:
+		return enum.MerkleHashSHA256, nil
+	default:
+		return "", fmt.Errorf("unknown merkle hash %s: %w", hash, svcerrs.ErrInvalidData)
+	}
+}
+
+func convertSvcMerkleHashToTransport(hash enum.MerkleHash) private.MerkleHash {
+	switch hash {
+	case enum.MerkleHashKeccak256:
+		return private.MerkleHash_MERKLE_HASH_KECCAK256
+	case enum.MerkleHashSHA256:
+		return private.MerkleHash_MERKLE_HASH_SHA256
+	default:
+		return private.MerkleHash_MERKLE_HASH_UNDEFINED
+	}
+}
+
+func convertTransportLeafEncodingToSvc(encoding private.LeafEncoding) (enum.LeafEncoding, error) {
+	switch encoding {
+	case private.LeafEncoding_LEAF_ENCODING_ADDRESS:
+		return enum.LeafEncodingAddress, nil
+	case private.LeafEncoding_LEAF_ENCODING_ADDRESS_AMOUNT_U64LE:
+		return enum.LeafEncodingAddressAmountU64LE, nil
+	case private.LeafEncoding_LEAF_ENCODING_ADDRESS_AMOUNT_U256BE:
+		return enum.LeafEncodingAddressAmountU256BE, nil
+	default:
+		return "", fmt.Errorf("unknown leaf encoding %s: %w", encoding, svcerrs.ErrInvalidData)
+	}
+}
+
+func convertSvcLeafEncodingToTransport(encoding enum.LeafEncoding) private.LeafEncoding {
+	switch encoding {
+	case enum.LeafEncodingAddress:
+		return private.LeafEncoding_LEAF_ENCODING_ADDRESS
+	case enum.LeafEncodingAddressAmountU64LE:
+		return private.LeafEncoding_LEAF_ENCODING_ADDRESS_AMOUNT_U64LE
+	case enum.LeafEncodingAddressAmountU256BE:
+		return private.LeafEncoding_LEAF_ENCODING_ADDRESS_AMOUNT_U256BE
+	default:
+		return private.LeafEncoding_LEAF_ENCODING_UNDEFINED
+	}
+}
@@ -0,0 +1,40 @@
+package private
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+
+	"wallets-service/internal/domain/enum"
+)
+
+func (c *Controller) CreateOwnershipChallenge(ctx context.Context, req *private.CreateOwnershipChallengeRequest) (*private.CreateOwnershipChallengeResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "private: CreateOwnershipChallenge")
+	defer span.End()
+
+	challenge, err := c.svc.CreateOwnershipChallenge(ctx, uint(req.GetUserId()), req.GetStatement(), req.GetPurpose())
+	if err != nil {
+		return nil, fmt.Errorf("svc.CreateOwnershipChallenge: %w", err)
+	}
+
+	return &private.CreateOwnershipChallengeResponse{
+		ChallengeId:   challenge.ChallengeID,
+		MessageToSign: challenge.MessageToSign,
+		MessageFormat: convertSvcMessageFormatToTransport(challenge.MessageFormat),
+	}, nil
+}
+
+func convertSvcMessageFormatToTransport(format enum.MessageFormat) private.MessageFormat {
+	switch format {
+	case enum.MessageFormatText:
+		return private.MessageFormat_MESSAGE_FORMAT_TEXT
+	case enum.MessageFormatSIWS:
+		return private.MessageFormat_MESSAGE_FORMAT_SIWS
+	case enum.MessageFormatSolanaOffchain:
+		return private.MessageFormat_MESSAGE_FORMAT_SOLANA_OFFCHAIN
+	default:
+		return private.MessageFormat_MESSAGE_FORMAT_NATIVE
+	}
+}
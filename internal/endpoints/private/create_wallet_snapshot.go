@@ -0,0 +1,35 @@
+package private
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+
+	"wallets-service/internal/domain/dto"
+)
+
+func (c *Controller) CreateWalletSnapshot(ctx context.Context, req *private.CreateWalletSnapshotRequest) (*private.CreateWalletSnapshotResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "private: CreateWalletSnapshot")
+	defer span.End()
+
+	snapshot, err := c.svc.CreateWalletSnapshot(ctx, req.GetLabel())
+	if err != nil {
+		return nil, fmt.Errorf("svc.CreateWalletSnapshot: %w", err)
+	}
+
+	return &private.CreateWalletSnapshotResponse{
+		Snapshot: convertSvcWalletSnapshotToTransport(snapshot),
+	}, nil
+}
+
+func convertSvcWalletSnapshotToTransport(snapshot dto.WalletSnapshot) *private.WalletSnapshot {
+	return &private.WalletSnapshot{
+		Id:          uint64(snapshot.ID),
+		Label:       snapshot.Label,
+		TakenAt:     snapshot.TakenAt.Unix(),
+		WalletCount: uint32(snapshot.WalletCount),
+		ContentHash: snapshot.ContentHash,
+	}
+}
@@ -0,0 +1,35 @@
+package private
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+
+	"wallets-service/internal/domain/dto"
+)
+
+func (c *Controller) CreateWebhookSubscription(ctx context.Context, req *private.CreateWebhookSubscriptionRequest) (*private.CreateWebhookSubscriptionResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "private: CreateWebhookSubscription")
+	defer span.End()
+
+	subscription, err := c.svc.CreateWebhookSubscription(ctx, req.GetUrl(), req.GetEventTypes(), req.GetSecret())
+	if err != nil {
+		return nil, fmt.Errorf("svc.CreateWebhookSubscription: %w", err)
+	}
+
+	return &private.CreateWebhookSubscriptionResponse{
+		Subscription: convertSvcWebhookSubscriptionToTransport(subscription),
+		Secret:       subscription.Secret,
+	}, nil
+}
+
+func convertSvcWebhookSubscriptionToTransport(subscription dto.WebhookSubscription) *private.WebhookSubscription {
+	return &private.WebhookSubscription{
+		Id:         uint64(subscription.ID),
+		Url:        subscription.URL,
+		EventTypes: subscription.EventTypes,
+		CreatedAt:  subscription.CreatedAt.Unix(),
+	}
+}
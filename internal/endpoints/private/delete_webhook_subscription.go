@@ -0,0 +1,20 @@
+package private
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+)
+
+func (c *Controller) DeleteWebhookSubscription(ctx context.Context, req *private.DeleteWebhookSubscriptionRequest) (*private.DeleteWebhookSubscriptionResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "private: DeleteWebhookSubscription")
+	defer span.End()
+
+	if err := c.svc.DeleteWebhookSubscription(ctx, uint(req.GetSubscriptionId())); err != nil {
+		return nil, fmt.Errorf("svc.DeleteWebhookSubscription: %w", err)
+	}
+
+	return &private.DeleteWebhookSubscriptionResponse{}, nil
+}
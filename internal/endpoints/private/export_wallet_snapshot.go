@@ -0,0 +1,64 @@
+package private
+
+import (
+	"bufio"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+	"google.golang.org/grpc"
+
+	"wallets-service/internal/domain/enum"
+)
+
+// exportChunkSize is the size of the chunks a snapshot export is streamed in.
+const exportChunkSize = 64 << 10
+
+func (c *Controller) ExportWalletSnapshot(req *private.ExportWalletSnapshotRequest, stream grpc.ServerStreamingServer[private.ExportWalletSnapshotChunk]) error {
+	ctx, span := tracing.StartSpan(stream.Context(), "private: ExportWalletSnapshot")
+	defer span.End()
+
+	format, err := convertTransportSnapshotFormatToSvc(req.GetFormat())
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriterSize(chunkWriter(func(data []byte) error {
+		return stream.Send(&private.ExportWalletSnapshotChunk{Data: data})
+	}), exportChunkSize)
+
+	if err = c.svc.ExportWalletSnapshot(ctx, uint(req.GetSnapshotId()), format, w); err != nil {
+		return fmt.Errorf("svc.ExportWalletSnapshot: %w", err)
+	}
+
+	if err = w.Flush(); err != nil {
+		return fmt.Errorf("w.Flush: %w", err)
+	}
+
+	return nil
+}
+
+// chunkWriter sends every write as a separate chunk.
+type chunkWriter func(data []byte) error
+
+func (c chunkWriter) Write(p []byte) (int, error) {
+	// The buffer is reused by the caller, while the message may be marshalled later.
+	data := make([]byte, len(p))
+	copy(data, p)
+	if err := c(data); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func convertTransportSnapshotFormatToSvc(format private.SnapshotFormat) (enum.SnapshotFormat, error) {
+	switch format {
+	case private.SnapshotFormat_SNAPSHOT_FORMAT_CSV:
+		return enum.SnapshotFormatCSV, nil
+	case private.SnapshotFormat_SNAPSHOT_FORMAT_JSON_LINES:
+		return enum.SnapshotFormatJSONLines, nil
+	default:
+		return "", fmt.Errorf("unknown snapshot format %s: %w", format, svcerrs.ErrInvalidData)
+	}
+}
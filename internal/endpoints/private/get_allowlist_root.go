@@ -0,0 +1,23 @@
+package private
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+)
+
+func (c *Controller) GetAllowlistRoot(ctx context.Context, req *private.GetAllowlistRootRequest) (*private.GetAllowlistRootResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "private: GetAllowlistRoot")
+	defer span.End()
+
+	allowlist, err := c.svc.GetAllowlist(ctx, uint(req.GetAllowlistId()))
+	if err != nil {
+		return nil, fmt.Errorf("svc.GetAllowlist: %w", err)
+	}
+
+	return &private.GetAllowlistRootResponse{
+		Allowlist: convertSvcAllowlistToTransport(allowlist),
+	}, nil
+}
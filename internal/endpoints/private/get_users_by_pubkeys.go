@@ -0,0 +1,37 @@
+package private
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+)
+
+func (c *Controller) GetUsersByPubkeys(ctx context.Context, req *private.GetUsersByPubkeysRequest) (*private.GetUsersByPubkeysResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "private: GetUsersByPubkeys")
+	defer span.End()
+
+	lookups, err := c.svc.GetWalletsByPubkeys(ctx, req.GetPubkeys())
+	if err != nil {
+		return nil, fmt.Errorf("svc.GetWalletsByPubkeys: %w", err)
+	}
+
+	resp := &private.GetUsersByPubkeysResponse{
+		Results: make([]*private.PubkeyWalletResult, 0, len(lookups)),
+	}
+	for _, lookup := range lookups {
+		result := &private.PubkeyWalletResult{
+			Pubkey: lookup.Pubkey,
+			Found:  lookup.Found,
+		}
+		if lookup.Found {
+			if result.Wallet, err = convertSvcWalletToTransport(lookup.Wallet); err != nil {
+				return nil, err
+			}
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,49 @@
+package private
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+)
+
+func (c *Controller) GetVerificationProofs(ctx context.Context, req *private.GetVerificationProofsRequest) (*private.GetVerificationProofsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "private: GetVerificationProofs")
+	defer span.End()
+
+	proofs, err := c.svc.ListVerificationProofs(ctx, uint(req.GetWalletId()), uint(req.GetUserId()), req.GetPubkey())
+	if err != nil {
+		return nil, fmt.Errorf("svc.ListVerificationProofs: %w", err)
+	}
+
+	resp := &private.GetVerificationProofsResponse{
+		Proofs: make([]*private.VerificationProof, 0, len(proofs)),
+	}
+	for _, proof := range proofs {
+		transportProvider, err := convertSvcProviderToTransport(proof.Provider)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Proofs = append(resp.Proofs, &private.VerificationProof{
+			Id:              uint64(proof.ID),
+			WalletId:        uint64(proof.WalletID),
+			UserId:          uint64(proof.UserID),
+			Pubkey:          proof.Pubkey,
+			Provider:        transportProvider,
+			ChallengeId:     proof.ChallengeID,
+			Statement:       proof.Statement,
+			Message:         proof.Message,
+			SignedPayload:   proof.SignedPayload,
+			Signature:       proof.Signature,
+			Encoding:        proof.Encoding,
+			VerifierVersion: proof.VerifierVersion,
+			IpHash:          proof.IPHash,
+			UserAgentHash:   proof.UserAgentHash,
+			CreatedAt:       proof.CreatedAt.Unix(),
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,28 @@
+package private
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+)
+
+func (c *Controller) GetWalletByPubkey(ctx context.Context, req *private.GetWalletByPubkeyRequest) (*private.GetWalletByPubkeyResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "private: GetWalletByPubkey")
+	defer span.End()
+
+	wallet, err := c.svc.GetWalletByPubkey(ctx, req.GetPubkey())
+	if err != nil {
+		return nil, fmt.Errorf("svc.GetWalletByPubkey: %w", err)
+	}
+
+	transportWallet, err := convertSvcWalletToTransport(wallet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &private.GetWalletByPubkeyResponse{
+		Wallet: transportWallet,
+	}, nil
+}
@@ -3,6 +3,7 @@ package private
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/knstch/knstch-libs/tracing"
 	private "github.com/knstch/wallets-ido-api/private"
@@ -25,10 +26,12 @@ func (c *Controller) GetWalletByUserID(ctx context.Context, req *private.GetWall
 	}
 
 	return &private.GetWalletByUserIDResponse{
-		Id:         uint64(wallet.ID),
-		Pubkey:     wallet.Pubkey,
-		Provider:   transportProvider,
-		IsVerified: wallet.VerifiedAt != nil,
+		Id:                    uint64(wallet.ID),
+		Pubkey:                wallet.Pubkey,
+		Provider:              transportProvider,
+		IsVerified:            isVerificationValid(wallet.VerificationStatus),
+		VerificationStatus:    convertSvcVerificationStatusToTransport(wallet.VerificationStatus),
+		VerificationExpiresAt: unixOrZero(wallet.VerificationExpiresAt),
 	}, nil
 }
 
@@ -36,7 +39,51 @@ func convertSvcProviderToTransport(provider enum.Provider) (private.Provider, er
 	switch provider {
 	case enum.ProviderPhantom:
 		return private.Provider_PROVIDER_PHANTOM, nil
+	case enum.ProviderSolflare:
+		return private.Provider_PROVIDER_SOLFLARE, nil
+	case enum.ProviderBackpack:
+		return private.Provider_PROVIDER_BACKPACK, nil
+	case enum.ProviderGlow:
+		return private.Provider_PROVIDER_GLOW, nil
+	case enum.ProviderLedger:
+		return private.Provider_PROVIDER_LEDGER, nil
+	case enum.ProviderWalletStandard:
+		return private.Provider_PROVIDER_WALLET_STANDARD, nil
+	case enum.ProviderStellar:
+		return private.Provider_PROVIDER_STELLAR, nil
+	case enum.ProviderCosmos:
+		return private.Provider_PROVIDER_COSMOS, nil
+	case enum.ProviderAptos:
+		return private.Provider_PROVIDER_APTOS, nil
+	case enum.ProviderSui:
+		return private.Provider_PROVIDER_SUI, nil
 	default:
 		return private.Provider_PROVIDER_UNDEFINED, fmt.Errorf("unknown provider: %s", provider)
 	}
 }
+
+func convertSvcVerificationStatusToTransport(status enum.VerificationStatus) private.VerificationStatus {
+	switch status {
+	case enum.VerificationStatusUnverified:
+		return private.VerificationStatus_VERIFICATION_STATUS_UNVERIFIED
+	case enum.VerificationStatusVerified:
+		return private.VerificationStatus_VERIFICATION_STATUS_VERIFIED
+	case enum.VerificationStatusExpiringSoon:
+		return private.VerificationStatus_VERIFICATION_STATUS_EXPIRING_SOON
+	case enum.VerificationStatusExpired:
+		return private.VerificationStatus_VERIFICATION_STATUS_EXPIRED
+	default:
+		return private.VerificationStatus_VERIFICATION_STATUS_UNDEFINED
+	}
+}
+
+func isVerificationValid(status enum.VerificationStatus) bool {
+	return status == enum.VerificationStatusVerified || status == enum.VerificationStatusExpiringSoon
+}
+
+func unixOrZero(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,65 @@
+package private
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+
+	"wallets-service/internal/domain/enum"
+)
+
+func (c *Controller) GetWalletEvents(ctx context.Context, req *private.GetWalletEventsRequest) (*private.GetWalletEventsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "private: GetWalletEvents")
+	defer span.End()
+
+	events, err := c.svc.ListWalletEvents(ctx,
+		uint(req.GetUserId()),
+		uint(req.GetWalletId()),
+		req.GetPubkey(),
+		uint(req.GetBeforeId()),
+		int(req.GetLimit()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("svc.ListWalletEvents: %w", err)
+	}
+
+	resp := &private.GetWalletEventsResponse{
+		Events: make([]*private.WalletEvent, 0, len(events)),
+	}
+	for _, event := range events {
+		transportProvider, err := convertSvcProviderToTransport(event.Provider)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Events = append(resp.Events, &private.WalletEvent{
+			Id:        uint64(event.ID),
+			Type:      convertSvcWalletEventTypeToTransport(event.Type),
+			UserId:    uint64(event.UserID),
+			WalletId:  uint64(event.WalletID),
+			Pubkey:    event.Pubkey,
+			Provider:  transportProvider,
+			Actor:     event.Actor.String(),
+			RequestId: event.RequestID,
+			Metadata:  event.Metadata,
+			CreatedAt: event.CreatedAt.Unix(),
+		})
+	}
+
+	return resp, nil
+}
+
+func convertSvcWalletEventTypeToTransport(eventType enum.WalletEventType) private.WalletEventType {
+	switch eventType {
+	case enum.WalletEventAdded:
+		return private.WalletEventType_WALLET_EVENT_TYPE_ADDED
+	case enum.WalletEventVerified:
+		return private.WalletEventType_WALLET_EVENT_TYPE_VERIFIED
+	case enum.WalletEventUnlinked:
+		return private.WalletEventType_WALLET_EVENT_TYPE_UNLINKED
+	default:
+		return private.WalletEventType_WALLET_EVENT_TYPE_UNDEFINED
+	}
+}
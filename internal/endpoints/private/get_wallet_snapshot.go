@@ -0,0 +1,23 @@
+package private
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+)
+
+func (c *Controller) GetWalletSnapshot(ctx context.Context, req *private.GetWalletSnapshotRequest) (*private.GetWalletSnapshotResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "private: GetWalletSnapshot")
+	defer span.End()
+
+	snapshot, err := c.svc.GetWalletSnapshot(ctx, uint(req.GetSnapshotId()))
+	if err != nil {
+		return nil, fmt.Errorf("svc.GetWalletSnapshot: %w", err)
+	}
+
+	return &private.GetWalletSnapshotResponse{
+		Snapshot: convertSvcWalletSnapshotToTransport(snapshot),
+	}, nil
+}
@@ -0,0 +1,63 @@
+package private
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+
+	"wallets-service/internal/domain/dto"
+)
+
+func (c *Controller) GetWalletsByUserIDs(ctx context.Context, req *private.GetWalletsByUserIDsRequest) (*private.GetWalletsByUserIDsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "private: GetWalletsByUserIDs")
+	defer span.End()
+
+	userIDs := make([]uint, 0, len(req.GetUserIds()))
+	for _, userID := range req.GetUserIds() {
+		userIDs = append(userIDs, uint(userID))
+	}
+
+	lookups, err := c.svc.GetWalletsByUserIDs(ctx, userIDs, req.GetVerifiedOnly())
+	if err != nil {
+		return nil, fmt.Errorf("svc.GetWalletsByUserIDs: %w", err)
+	}
+
+	resp := &private.GetWalletsByUserIDsResponse{
+		Results: make([]*private.UserWalletResult, 0, len(lookups)),
+	}
+	for _, lookup := range lookups {
+		result := &private.UserWalletResult{
+			UserId: uint64(lookup.UserID),
+			Found:  lookup.Found,
+		}
+		if lookup.Found {
+			if result.Wallet, err = convertSvcWalletToTransport(lookup.Wallet); err != nil {
+				return nil, err
+			}
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	return resp, nil
+}
+
+func convertSvcWalletToTransport(wallet dto.Wallet) (*private.Wallet, error) {
+	transportProvider, err := convertSvcProviderToTransport(wallet.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	return &private.Wallet{
+		Id:                    uint64(wallet.ID),
+		UserId:                uint64(wallet.UserID),
+		Pubkey:                wallet.Pubkey,
+		Provider:              transportProvider,
+		IsVerified:            isVerificationValid(wallet.VerificationStatus),
+		VerificationStatus:    convertSvcVerificationStatusToTransport(wallet.VerificationStatus),
+		VerifiedAt:            unixOrZero(wallet.VerifiedAt),
+		VerificationExpiresAt: unixOrZero(wallet.VerificationExpiresAt),
+		CreatedAt:             wallet.CreatedAt.Unix(),
+	}, nil
+}
@@ -0,0 +1,28 @@
+package private
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+)
+
+func (c *Controller) ListWalletSnapshots(ctx context.Context, _ *private.ListWalletSnapshotsRequest) (*private.ListWalletSnapshotsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "private: ListWalletSnapshots")
+	defer span.End()
+
+	snapshots, err := c.svc.ListWalletSnapshots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("svc.ListWalletSnapshots: %w", err)
+	}
+
+	resp := &private.ListWalletSnapshotsResponse{
+		Snapshots: make([]*private.WalletSnapshot, 0, len(snapshots)),
+	}
+	for _, snapshot := range snapshots {
+		resp.Snapshots = append(resp.Snapshots, convertSvcWalletSnapshotToTransport(snapshot))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,77 @@
+package private
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+
+	"wallets-service/internal/domain/enum"
+)
+
+func (c *Controller) ListWebhookDeliveries(ctx context.Context, req *private.ListWebhookDeliveriesRequest) (*private.ListWebhookDeliveriesResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "private: ListWebhookDeliveries")
+	defer span.End()
+
+	deliveries, err := c.svc.ListWebhookDeliveries(ctx,
+		uint(req.GetSubscriptionId()),
+		convertTransportWebhookDeliveryStatusToSvc(req.GetStatus()),
+		int(req.GetLimit()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("svc.ListWebhookDeliveries: %w", err)
+	}
+
+	resp := &private.ListWebhookDeliveriesResponse{
+		Deliveries: make([]*private.WebhookDelivery, 0, len(deliveries)),
+	}
+	for _, delivery := range deliveries {
+		var deliveredAt int64
+		if delivery.DeliveredAt != nil {
+			deliveredAt = delivery.DeliveredAt.Unix()
+		}
+
+		resp.Deliveries = append(resp.Deliveries, &private.WebhookDelivery{
+			Id:             uint64(delivery.ID),
+			SubscriptionId: uint64(delivery.SubscriptionID),
+			EventId:        delivery.EventID,
+			EventType:      delivery.EventType,
+			Status:         convertSvcWebhookDeliveryStatusToTransport(delivery.Status),
+			Attempts:       uint32(delivery.Attempts),
+			NextAttemptAt:  delivery.NextAttemptAt.Unix(),
+			LastError:      delivery.LastError,
+			LastStatusCode: uint32(delivery.LastStatusCode),
+			DeliveredAt:    deliveredAt,
+			CreatedAt:      delivery.CreatedAt.Unix(),
+		})
+	}
+
+	return resp, nil
+}
+
+func convertTransportWebhookDeliveryStatusToSvc(status private.WebhookDeliveryStatus) enum.WebhookDeliveryStatus {
+	switch status {
+	case private.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING:
+		return enum.WebhookDeliveryStatusPending
+	case private.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED:
+		return enum.WebhookDeliveryStatusDelivered
+	case private.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD:
+		return enum.WebhookDeliveryStatusDead
+	default:
+		return ""
+	}
+}
+
+func convertSvcWebhookDeliveryStatusToTransport(status enum.WebhookDeliveryStatus) private.WebhookDeliveryStatus {
+	switch status {
+	case enum.WebhookDeliveryStatusPending:
+		return private.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_PENDING
+	case enum.WebhookDeliveryStatusDelivered:
+		return private.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DELIVERED
+	case enum.WebhookDeliveryStatusDead:
+		return private.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD
+	default:
+		return private.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_UNDEFINED
+	}
+}
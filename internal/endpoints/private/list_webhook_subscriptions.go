@@ -0,0 +1,28 @@
+package private
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+)
+
+func (c *Controller) ListWebhookSubscriptions(ctx context.Context, _ *private.ListWebhookSubscriptionsRequest) (*private.ListWebhookSubscriptionsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "private: ListWebhookSubscriptions")
+	defer span.End()
+
+	subscriptions, err := c.svc.ListWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("svc.ListWebhookSubscriptions: %w", err)
+	}
+
+	resp := &private.ListWebhookSubscriptionsResponse{
+		Subscriptions: make([]*private.WebhookSubscription, 0, len(subscriptions)),
+	}
+	for _, subscription := range subscriptions {
+		resp.Subscriptions = append(resp.Subscriptions, convertSvcWebhookSubscriptionToTransport(subscription))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,29 @@
+package private
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+)
+
+func (c *Controller) ReplayWebhookSubscription(ctx context.Context, req *private.ReplayWebhookSubscriptionRequest) (*private.ReplayWebhookSubscriptionResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "private: ReplayWebhookSubscription")
+	defer span.End()
+
+	var since time.Time
+	if req.GetSince() > 0 {
+		since = time.Unix(req.GetSince(), 0)
+	}
+
+	scheduled, err := c.svc.ReplayWebhookSubscription(ctx, uint(req.GetSubscriptionId()), since)
+	if err != nil {
+		return nil, fmt.Errorf("svc.ReplayWebhookSubscription: %w", err)
+	}
+
+	return &private.ReplayWebhookSubscriptionResponse{
+		Scheduled: uint64(scheduled),
+	}, nil
+}
@@ -0,0 +1,35 @@
+package private
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+)
+
+func (c *Controller) VerifyOwnership(ctx context.Context, req *private.VerifyOwnershipRequest) (*private.VerifyOwnershipResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "private: VerifyOwnership")
+	defer span.End()
+
+	proof, err := c.svc.VerifyOwnership(ctx, uint(req.GetUserId()), req.GetChallengeId(), req.GetPurpose(), req.GetSignature())
+	if err != nil {
+		return nil, fmt.Errorf("svc.VerifyOwnership: %w", err)
+	}
+
+	transportProvider, err := convertSvcProviderToTransport(proof.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	return &private.VerifyOwnershipResponse{
+		WalletId:   uint64(proof.WalletID),
+		Pubkey:     proof.Pubkey,
+		Provider:   transportProvider,
+		Purpose:    proof.Purpose,
+		Statement:  proof.Statement,
+		Message:    proof.Message,
+		Encoding:   proof.Encoding,
+		VerifiedAt: proof.VerifiedAt.Unix(),
+	}, nil
+}
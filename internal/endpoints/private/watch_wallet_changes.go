@@ -0,0 +1,104 @@
+package private
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+	private "github.com/knstch/wallets-ido-api/private"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+)
+
+// WatchWalletChanges streams wallet changes until the client goes away. When the server shuts down,
+// the stream ends with codes.Unavailable, so clients reconnect and resume from the last sequence they got.
+func (c *Controller) WatchWalletChanges(req *private.WatchWalletChangesRequest, stream grpc.ServerStreamingServer[private.WalletChange]) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	stopOnShutdown := context.AfterFunc(c.serverCtx, cancel)
+	defer stopOnShutdown()
+
+	ctx, span := tracing.StartSpan(ctx, "private: WatchWalletChanges")
+	defer span.End()
+
+	var provider enum.Provider
+	if req.GetProvider() != private.Provider_PROVIDER_UNDEFINED {
+		var err error
+		if provider, err = convertTransportProviderToSvc(req.GetProvider()); err != nil {
+			return err
+		}
+	}
+
+	err := c.svc.WatchWalletChanges(ctx, provider, req.GetVerifiedOnly(), uint(req.GetFromSequence()), func(change dto.WalletChange) error {
+		msg := &private.WalletChange{
+			Sequence: uint64(change.Sequence),
+			Type:     convertSvcWalletChangeTypeToTransport(change.Type),
+		}
+		if change.Type != enum.WalletChangeSnapshotEnd {
+			wallet, err := convertSvcWalletToTransport(change.Wallet)
+			if err != nil {
+				return err
+			}
+			msg.Wallet = wallet
+		}
+		return stream.Send(msg)
+	})
+	// Whatever the stream was doing, a shutdown interrupted it.
+	if c.serverCtx.Err() != nil && stream.Context().Err() == nil {
+		return status.Error(codes.Unavailable, "server is shutting down")
+	}
+	if err != nil {
+		return fmt.Errorf("svc.WatchWalletChanges: %w", err)
+	}
+
+	return nil
+}
+
+func convertTransportProviderToSvc(provider private.Provider) (enum.Provider, error) {
+	switch provider {
+	case private.Provider_PROVIDER_PHANTOM:
+		return enum.ProviderPhantom, nil
+	case private.Provider_PROVIDER_SOLFLARE:
+		return enum.ProviderSolflare, nil
+	case private.Provider_PROVIDER_BACKPACK:
+		return enum.ProviderBackpack, nil
+	case private.Provider_PROVIDER_GLOW:
+		return enum.ProviderGlow, nil
+	case private.Provider_PROVIDER_LEDGER:
+		return enum.ProviderLedger, nil
+	case private.Provider_PROVIDER_WALLET_STANDARD:
+		return enum.ProviderWalletStandard, nil
+	case private.Provider_PROVIDER_STELLAR:
+		return enum.ProviderStellar, nil
+	case private.Provider_PROVIDER_COSMOS:
+		return enum.ProviderCosmos, nil
+	case private.Provider_PROVIDER_APTOS:
+		return enum.ProviderAptos, nil
+	case private.Provider_PROVIDER_SUI:
+		return enum.ProviderSui, nil
+	default:
+		return "", fmt.Errorf("unknown provider %s: %w", provider, svcerrs.ErrInvalidData)
+	}
+}
+
+func convertSvcWalletChangeTypeToTransport(changeType enum.WalletChangeType) private.WalletChangeType {
+	switch changeType {
+	case enum.WalletChangeSnapshot:
+		return private.WalletChangeType_WALLET_CHANGE_TYPE_SNAPSHOT
+	case enum.WalletChangeSnapshotEnd:
+		return private.WalletChangeType_WALLET_CHANGE_TYPE_SNAPSHOT_END
+	case enum.WalletChangeAdded:
+		return private.WalletChangeType_WALLET_CHANGE_TYPE_ADDED
+	case enum.WalletChangeVerified:
+		return private.WalletChangeType_WALLET_CHANGE_TYPE_VERIFIED
+	case enum.WalletChangeUnlinked:
+		return private.WalletChangeType_WALLET_CHANGE_TYPE_UNLINKED
+	default:
+		return private.WalletChangeType_WALLET_CHANGE_TYPE_UNDEFINED
+	}
+}
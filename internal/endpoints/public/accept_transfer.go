@@ -0,0 +1,38 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/knstch/knstch-libs/auth"
+	"github.com/knstch/knstch-libs/tracing"
+	public "github.com/knstch/wallets-ido-api/public"
+)
+
+func MakeAcceptTransferEndpoint(c *Controller) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return c.AcceptTransfer(ctx, request.(*public.AcceptTransferRequest))
+	}
+}
+
+func (c *Controller) AcceptTransfer(ctx context.Context, req *public.AcceptTransferRequest) (*public.AcceptTransferResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "public: AcceptTransfer")
+	defer span.End()
+
+	user, err := auth.GetUserData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("auth.GetUserData: %w", err)
+	}
+
+	challenge, err := c.svc.AcceptTransfer(ctx, user.UserID, uint(req.GetTransferId()))
+	if err != nil {
+		return nil, fmt.Errorf("svc.AcceptTransfer: %w", err)
+	}
+
+	return &public.AcceptTransferResponse{
+		ChallengeId:   challenge.ChallengeID,
+		MessageToSign: challenge.MessageToSign,
+		MessageFormat: convertSvcMessageFormatToTransport(challenge.MessageFormat),
+	}, nil
+}
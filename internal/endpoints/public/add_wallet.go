@@ -41,6 +41,7 @@ func (c *Controller) AddWallet(ctx context.Context, req *public.AddWalletRequest
 	return &public.AddWalletResponse{
 		ChallengeId:   challenge.ChallengeID,
 		MessageToSign: challenge.MessageToSign,
+		MessageFormat: convertSvcMessageFormatToTransport(challenge.MessageFormat),
 	}, nil
 }
 
@@ -48,7 +49,38 @@ func convertTransportProviderToService(provider public.Provider) (enum.Provider,
 	switch provider {
 	case public.Provider_PROVIDER_PHANTOM:
 		return enum.ProviderPhantom, nil
+	case public.Provider_PROVIDER_SOLFLARE:
+		return enum.ProviderSolflare, nil
+	case public.Provider_PROVIDER_BACKPACK:
+		return enum.ProviderBackpack, nil
+	case public.Provider_PROVIDER_GLOW:
+		return enum.ProviderGlow, nil
+	case public.Provider_PROVIDER_LEDGER:
+		return enum.ProviderLedger, nil
+	case public.Provider_PROVIDER_WALLET_STANDARD:
+		return enum.ProviderWalletStandard, nil
+	case public.Provider_PROVIDER_STELLAR:
+		return enum.ProviderStellar, nil
+	case public.Provider_PROVIDER_COSMOS:
+		return enum.ProviderCosmos, nil
+	case public.Provider_PROVIDER_APTOS:
+		return enum.ProviderAptos, nil
+	case public.Provider_PROVIDER_SUI:
+		return enum.ProviderSui, nil
 	default:
 		return "", fmt.Errorf("unknown provider %s: %w", provider, svcerrs.ErrInvalidData)
 	}
 }
+
+func convertSvcMessageFormatToTransport(format enum.MessageFormat) public.MessageFormat {
+	switch format {
+	case enum.MessageFormatText:
+		return public.MessageFormat_MESSAGE_FORMAT_TEXT
+	case enum.MessageFormatSIWS:
+		return public.MessageFormat_MESSAGE_FORMAT_SIWS
+	case enum.MessageFormatSolanaOffchain:
+		return public.MessageFormat_MESSAGE_FORMAT_SOLANA_OFFCHAIN
+	default:
+		return public.MessageFormat_MESSAGE_FORMAT_NATIVE
+	}
+}
@@ -0,0 +1,33 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/knstch/knstch-libs/auth"
+	"github.com/knstch/knstch-libs/tracing"
+	public "github.com/knstch/wallets-ido-api/public"
+)
+
+func MakeCancelTransferEndpoint(c *Controller) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return c.CancelTransfer(ctx, request.(*public.CancelTransferRequest))
+	}
+}
+
+func (c *Controller) CancelTransfer(ctx context.Context, req *public.CancelTransferRequest) (*public.CancelTransferResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "public: CancelTransfer")
+	defer span.End()
+
+	user, err := auth.GetUserData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("auth.GetUserData: %w", err)
+	}
+
+	if err = c.svc.CancelTransfer(ctx, user.UserID, uint(req.GetTransferId())); err != nil {
+		return nil, fmt.Errorf("svc.CancelTransfer: %w", err)
+	}
+
+	return &public.CancelTransferResponse{}, nil
+}
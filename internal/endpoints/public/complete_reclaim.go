@@ -0,0 +1,33 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/knstch/knstch-libs/auth"
+	"github.com/knstch/knstch-libs/tracing"
+	public "github.com/knstch/wallets-ido-api/public"
+)
+
+func MakeCompleteReclaimEndpoint(c *Controller) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return c.CompleteReclaim(ctx, request.(*public.CompleteReclaimRequest))
+	}
+}
+
+func (c *Controller) CompleteReclaim(ctx context.Context, req *public.CompleteReclaimRequest) (*public.CompleteReclaimResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "public: CompleteReclaim")
+	defer span.End()
+
+	user, err := auth.GetUserData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("auth.GetUserData: %w", err)
+	}
+
+	if err = c.svc.CompleteReclaim(ctx, user.UserID, uint(req.GetReclaimId())); err != nil {
+		return nil, fmt.Errorf("svc.CompleteReclaim: %w", err)
+	}
+
+	return &public.CompleteReclaimResponse{}, nil
+}
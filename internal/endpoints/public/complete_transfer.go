@@ -0,0 +1,41 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/knstch/knstch-libs/auth"
+	"github.com/knstch/knstch-libs/tracing"
+	public "github.com/knstch/wallets-ido-api/public"
+)
+
+func MakeCompleteTransferEndpoint(c *Controller) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return c.CompleteTransfer(ctx, request.(*public.CompleteTransferRequest))
+	}
+}
+
+func (c *Controller) CompleteTransfer(ctx context.Context, req *public.CompleteTransferRequest) (*public.CompleteTransferResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "public: CompleteTransfer")
+	defer span.End()
+
+	user, err := auth.GetUserData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("auth.GetUserData: %w", err)
+	}
+
+	transfer, err := c.svc.CompleteTransfer(ctx, user.UserID, req.GetChallengeId(), req.GetSignature())
+	if err != nil {
+		return nil, fmt.Errorf("svc.CompleteTransfer: %w", err)
+	}
+
+	transportTransfer, err := convertSvcTransferToTransport(transfer, user.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &public.CompleteTransferResponse{
+		Transfer: transportTransfer,
+	}, nil
+}
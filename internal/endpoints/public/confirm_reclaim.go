@@ -0,0 +1,41 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/knstch/knstch-libs/auth"
+	"github.com/knstch/knstch-libs/tracing"
+	public "github.com/knstch/wallets-ido-api/public"
+)
+
+func MakeConfirmReclaimEndpoint(c *Controller) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return c.ConfirmReclaim(ctx, request.(*public.ConfirmReclaimRequest))
+	}
+}
+
+func (c *Controller) ConfirmReclaim(ctx context.Context, req *public.ConfirmReclaimRequest) (*public.ConfirmReclaimResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "public: ConfirmReclaim")
+	defer span.End()
+
+	user, err := auth.GetUserData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("auth.GetUserData: %w", err)
+	}
+
+	reclaim, err := c.svc.ConfirmReclaim(ctx, user.UserID, req.GetChallengeId(), req.GetSignature())
+	if err != nil {
+		return nil, fmt.Errorf("svc.ConfirmReclaim: %w", err)
+	}
+
+	transportReclaim, err := convertSvcReclaimToTransport(reclaim, user.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &public.ConfirmReclaimResponse{
+		Reclaim: transportReclaim,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/knstch/knstch-libs/auth"
+	"github.com/knstch/knstch-libs/tracing"
+	public "github.com/knstch/wallets-ido-api/public"
+)
+
+func MakeConfirmUnlinkEndpoint(c *Controller) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return c.ConfirmUnlink(ctx, request.(*public.ConfirmUnlinkRequest))
+	}
+}
+
+func (c *Controller) ConfirmUnlink(ctx context.Context, req *public.ConfirmUnlinkRequest) (*public.ConfirmUnlinkResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "public: ConfirmUnlink")
+	defer span.End()
+
+	user, err := auth.GetUserData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("auth.GetUserData: %w", err)
+	}
+
+	if err = c.svc.ConfirmUnlink(ctx, user.UserID, req.GetChallengeId(), req.GetSignature(), req.GetSecondFactorCode()); err != nil {
+		return nil, fmt.Errorf("svc.ConfirmUnlink: %w", err)
+	}
+
+	return &public.ConfirmUnlinkResponse{}, nil
+}
@@ -0,0 +1,33 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/knstch/knstch-libs/auth"
+	"github.com/knstch/knstch-libs/tracing"
+	public "github.com/knstch/wallets-ido-api/public"
+)
+
+func MakeContestReclaimEndpoint(c *Controller) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return c.ContestReclaim(ctx, request.(*public.ContestReclaimRequest))
+	}
+}
+
+func (c *Controller) ContestReclaim(ctx context.Context, req *public.ContestReclaimRequest) (*public.ContestReclaimResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "public: ContestReclaim")
+	defer span.End()
+
+	user, err := auth.GetUserData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("auth.GetUserData: %w", err)
+	}
+
+	if err = c.svc.ContestReclaim(ctx, user.UserID, uint(req.GetReclaimId())); err != nil {
+		return nil, fmt.Errorf("svc.ContestReclaim: %w", err)
+	}
+
+	return &public.ContestReclaimResponse{}, nil
+}
@@ -34,7 +34,7 @@ func NewController(svc wallets.Service, lg *log.Logger, cfg *config.Config) *Con
 }
 
 func (c *Controller) Endpoints() []endpoints.Endpoint {
-	defaultMiddlewares := []middleware.Middleware{middleware.WithCookieAuth(c.cfg.JwtSecret)}
+	defaultMiddlewares := []middleware.Middleware{middleware.WithCookieAuth(c.cfg.JwtSecret), withRequestMeta, withErrorCode}
 
 	return []endpoints.Endpoint{
 		{
@@ -61,6 +61,14 @@ func (c *Controller) Endpoints() []endpoints.Endpoint {
 			Encoder: httptransport.EncodeJSONResponse,
 			Mdw:     defaultMiddlewares,
 		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/confirmUnlink",
+			Handler: MakeConfirmUnlinkEndpoint(c),
+			Decoder: transport.DecodeJSONRequest[public.ConfirmUnlinkRequest],
+			Encoder: httptransport.EncodeJSONResponse,
+			Mdw:     defaultMiddlewares,
+		},
 		{
 			Method:  http.MethodGet,
 			Path:    "/getWallet",
@@ -69,5 +77,118 @@ func (c *Controller) Endpoints() []endpoints.Endpoint {
 			Encoder: httptransport.EncodeJSONResponse,
 			Mdw:     defaultMiddlewares,
 		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/getWalletAttestation",
+			Handler: MakeGetWalletAttestationEndpoint(c),
+			Decoder: transport.DecodeDefaultRequest,
+			Encoder: httptransport.EncodeJSONResponse,
+			Mdw:     defaultMiddlewares,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/requestReclaim",
+			Handler: MakeRequestReclaimEndpoint(c),
+			Decoder: transport.DecodeJSONRequest[public.RequestReclaimRequest],
+			Encoder: httptransport.EncodeJSONResponse,
+			Mdw:     defaultMiddlewares,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/confirmReclaim",
+			Handler: MakeConfirmReclaimEndpoint(c),
+			Decoder: transport.DecodeJSONRequest[public.ConfirmReclaimRequest],
+			Encoder: httptransport.EncodeJSONResponse,
+			Mdw:     defaultMiddlewares,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/contestReclaim",
+			Handler: MakeContestReclaimEndpoint(c),
+			Decoder: transport.DecodeJSONRequest[public.ContestReclaimRequest],
+			Encoder: httptransport.EncodeJSONResponse,
+			Mdw:     defaultMiddlewares,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/completeReclaim",
+			Handler: MakeCompleteReclaimEndpoint(c),
+			Decoder: transport.DecodeJSONRequest[public.CompleteReclaimRequest],
+			Encoder: httptransport.EncodeJSONResponse,
+			Mdw:     defaultMiddlewares,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/getReclaims",
+			Handler: MakeGetReclaimsEndpoint(c),
+			Decoder: transport.DecodeDefaultRequest,
+			Encoder: httptransport.EncodeJSONResponse,
+			Mdw:     defaultMiddlewares,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/initiateTransfer",
+			Handler: MakeInitiateTransferEndpoint(c),
+			Decoder: transport.DecodeJSONRequest[public.InitiateTransferRequest],
+			Encoder: httptransport.EncodeJSONResponse,
+			Mdw:     defaultMiddlewares,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/acceptTransfer",
+			Handler: MakeAcceptTransferEndpoint(c),
+			Decoder: transport.DecodeJSONRequest[public.AcceptTransferRequest],
+			Encoder: httptransport.EncodeJSONResponse,
+			Mdw:     defaultMiddlewares,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/completeTransfer",
+			Handler: MakeCompleteTransferEndpoint(c),
+			Decoder: transport.DecodeJSONRequest[public.CompleteTransferRequest],
+			Encoder: httptransport.EncodeJSONResponse,
+			Mdw:     defaultMiddlewares,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/cancelTransfer",
+			Handler: MakeCancelTransferEndpoint(c),
+			Decoder: transport.DecodeJSONRequest[public.CancelTransferRequest],
+			Encoder: httptransport.EncodeJSONResponse,
+			Mdw:     defaultMiddlewares,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/getTransfers",
+			Handler: MakeGetTransfersEndpoint(c),
+			Decoder: transport.DecodeDefaultRequest,
+			Encoder: httptransport.EncodeJSONResponse,
+			Mdw:     defaultMiddlewares,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/getWalletEvents",
+			Handler: MakeGetWalletEventsEndpoint(c),
+			Decoder: transport.DecodeQueryRequest[public.GetWalletEventsRequest],
+			Encoder: httptransport.EncodeJSONResponse,
+			Mdw:     defaultMiddlewares,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/getAllowlistProof",
+			Handler: MakeGetAllowlistProofEndpoint(c),
+			Decoder: transport.DecodeJSONRequest[public.GetAllowlistProofRequest],
+			Encoder: httptransport.EncodeJSONResponse,
+			Mdw:     defaultMiddlewares,
+		},
+		{
+			// The key set is public, so verifiers can fetch it without a session.
+			Method:  http.MethodGet,
+			Path:    "/.well-known/jwks.json",
+			Handler: MakeGetJWKSEndpoint(c),
+			Decoder: transport.DecodeDefaultRequest,
+			Encoder: httptransport.EncodeJSONResponse,
+			Opts:    []httptransport.ServerOption{httptransport.ServerAfter(setJWKSCacheControl)},
+		},
 	}
 }
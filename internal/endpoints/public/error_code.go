@@ -0,0 +1,45 @@
+package public
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"wallets-service/internal/wallets"
+)
+
+// codedErrorResponse is the HTTP body of errors with a machine-readable code. It keeps the "error" field
+// of the default error encoder and adds the code next to it.
+type codedErrorResponse struct {
+	Error string `json:"error"`
+	Code  string `json:"code"`
+
+	status int
+}
+
+// StatusCode makes httptransport.EncodeJSONResponse write the status of the error instead of 200.
+func (r codedErrorResponse) StatusCode() int {
+	return r.status
+}
+
+// withErrorCode returns errors with a machine-readable code as a response carrying the code,
+// since the default error encoder only writes the error message.
+func withErrorCode(next endpoint.Endpoint) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		resp, err := next(ctx, request)
+		if err == nil {
+			return resp, nil
+		}
+
+		code := wallets.ErrorCode(err)
+		if code == "" {
+			return nil, err
+		}
+		return codedErrorResponse{
+			Error:  err.Error(),
+			Code:   code,
+			status: http.StatusForbidden,
+		}, nil
+	}
+}
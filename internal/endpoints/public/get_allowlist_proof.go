@@ -0,0 +1,75 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/knstch/knstch-libs/auth"
+	"github.com/knstch/knstch-libs/tracing"
+	public "github.com/knstch/wallets-ido-api/public"
+
+	"wallets-service/internal/domain/enum"
+)
+
+func MakeGetAllowlistProofEndpoint(c *Controller) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return c.GetAllowlistProof(ctx, request.(*public.GetAllowlistProofRequest))
+	}
+}
+
+func (c *Controller) GetAllowlistProof(ctx context.Context, req *public.GetAllowlistProofRequest) (*public.GetAllowlistProofResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "public: GetAllowlistProof")
+	defer span.End()
+
+	user, err := auth.GetUserData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("auth.GetUserData: %w", err)
+	}
+
+	proof, err := c.svc.GetAllowlistProof(ctx, user.UserID, uint(req.GetAllowlistId()))
+	if err != nil {
+		return nil, fmt.Errorf("svc.GetAllowlistProof: %w", err)
+	}
+
+	transportProvider, err := convertSvcProviderToTransport(proof.Leaf.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	return &public.GetAllowlistProofResponse{
+		AllowlistId:  uint64(proof.Allowlist.ID),
+		Root:         proof.Allowlist.Root,
+		Hash:         convertSvcMerkleHashToTransport(proof.Allowlist.Hash),
+		LeafEncoding: convertSvcLeafEncodingToTransport(proof.Allowlist.Encoding),
+		Pubkey:       proof.Leaf.Pubkey,
+		Provider:     transportProvider,
+		Amount:       proof.Leaf.Amount,
+		Leaf:         proof.Leaf.Hash,
+		Proof:        proof.Proof,
+	}, nil
+}
+
+func convertSvcMerkleHashToTransport(hash enum.MerkleHash) public.MerkleHash {
+	switch hash {
+	case enum.MerkleHashKeccak256:
+		return public.MerkleHash_MERKLE_HASH_KECCAK256
+	case enum.MerkleHashSHA256:
+		return public.MerkleHash_MERKLE_HASH_SHA256
+	default:
+		return public.MerkleHash_MERKLE_HASH_UNDEFINED
+	}
+}
+
+func convertSvcLeafEncodingToTransport(encoding enum.LeafEncoding) public.LeafEncoding {
+	switch encoding {
+	case enum.LeafEncodingAddress:
+		return public.LeafEncoding_LEAF_ENCODING_ADDRESS
+	case enum.LeafEncodingAddressAmountU64LE:
+		return public.LeafEncoding_LEAF_ENCODING_ADDRESS_AMOUNT_U64LE
+	case enum.LeafEncodingAddressAmountU256BE:
+		return public.LeafEncoding_LEAF_ENCODING_ADDRESS_AMOUNT_U256BE
+	default:
+		return public.LeafEncoding_LEAF_ENCODING_UNDEFINED
+	}
+}
@@ -0,0 +1,79 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/knstch/knstch-libs/auth"
+	"github.com/knstch/knstch-libs/tracing"
+	public "github.com/knstch/wallets-ido-api/public"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+)
+
+func MakeGetReclaimsEndpoint(c *Controller) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return c.GetReclaims(ctx, &public.GetReclaimsRequest{})
+	}
+}
+
+func (c *Controller) GetReclaims(ctx context.Context, _ *public.GetReclaimsRequest) (*public.GetReclaimsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "public: GetReclaims")
+	defer span.End()
+
+	user, err := auth.GetUserData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("auth.GetUserData: %w", err)
+	}
+
+	reclaims, err := c.svc.ListReclaims(ctx, user.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("svc.ListReclaims: %w", err)
+	}
+
+	resp := &public.GetReclaimsResponse{
+		Reclaims: make([]*public.Reclaim, 0, len(reclaims)),
+	}
+	for _, reclaim := range reclaims {
+		transportReclaim, err := convertSvcReclaimToTransport(reclaim, user.UserID)
+		if err != nil {
+			return nil, err
+		}
+		resp.Reclaims = append(resp.Reclaims, transportReclaim)
+	}
+
+	return resp, nil
+}
+
+func convertSvcReclaimToTransport(reclaim dto.WalletReclaim, userID uint) (*public.Reclaim, error) {
+	transportProvider, err := convertSvcProviderToTransport(reclaim.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	return &public.Reclaim{
+		Id:          uint64(reclaim.ID),
+		Pubkey:      reclaim.Pubkey,
+		Provider:    transportProvider,
+		Status:      convertSvcReclaimStatusToTransport(reclaim.Status),
+		AvailableAt: reclaim.AvailableAt.Unix(),
+		IsIncoming:  reclaim.FromUserID == userID,
+	}, nil
+}
+
+func convertSvcReclaimStatusToTransport(status enum.ReclaimStatus) public.ReclaimStatus {
+	switch status {
+	case enum.ReclaimStatusPending:
+		return public.ReclaimStatus_RECLAIM_STATUS_PENDING
+	case enum.ReclaimStatusContested:
+		return public.ReclaimStatus_RECLAIM_STATUS_CONTESTED
+	case enum.ReclaimStatusCompleted:
+		return public.ReclaimStatus_RECLAIM_STATUS_COMPLETED
+	case enum.ReclaimStatusCancelled:
+		return public.ReclaimStatus_RECLAIM_STATUS_CANCELLED
+	default:
+		return public.ReclaimStatus_RECLAIM_STATUS_UNDEFINED
+	}
+}
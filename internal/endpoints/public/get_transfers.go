@@ -0,0 +1,82 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/knstch/knstch-libs/auth"
+	"github.com/knstch/knstch-libs/tracing"
+	public "github.com/knstch/wallets-ido-api/public"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+)
+
+func MakeGetTransfersEndpoint(c *Controller) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return c.GetTransfers(ctx, &public.GetTransfersRequest{})
+	}
+}
+
+func (c *Controller) GetTransfers(ctx context.Context, _ *public.GetTransfersRequest) (*public.GetTransfersResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "public: GetTransfers")
+	defer span.End()
+
+	user, err := auth.GetUserData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("auth.GetUserData: %w", err)
+	}
+
+	transfers, err := c.svc.ListTransfers(ctx, user.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("svc.ListTransfers: %w", err)
+	}
+
+	resp := &public.GetTransfersResponse{
+		Transfers: make([]*public.Transfer, 0, len(transfers)),
+	}
+	for _, transfer := range transfers {
+		transportTransfer, err := convertSvcTransferToTransport(transfer, user.UserID)
+		if err != nil {
+			return nil, err
+		}
+		resp.Transfers = append(resp.Transfers, transportTransfer)
+	}
+
+	return resp, nil
+}
+
+func convertSvcTransferToTransport(transfer dto.WalletTransfer, userID uint) (*public.Transfer, error) {
+	transportProvider, err := convertSvcProviderToTransport(transfer.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	return &public.Transfer{
+		Id:         uint64(transfer.ID),
+		WalletId:   uint64(transfer.WalletID),
+		Pubkey:     transfer.Pubkey,
+		Provider:   transportProvider,
+		Status:     convertSvcTransferStatusToTransport(transfer.Status),
+		ExpiresAt:  transfer.ExpiresAt.Unix(),
+		IsIncoming: transfer.ToUserID == userID,
+	}, nil
+}
+
+func convertSvcTransferStatusToTransport(status enum.TransferStatus) public.TransferStatus {
+	switch status {
+	case enum.TransferStatusPending:
+		return public.TransferStatus_TRANSFER_STATUS_PENDING
+	case enum.TransferStatusAccepted:
+		return public.TransferStatus_TRANSFER_STATUS_ACCEPTED
+	case enum.TransferStatusCompleted:
+		return public.TransferStatus_TRANSFER_STATUS_COMPLETED
+	case enum.TransferStatusCancelled:
+		return public.TransferStatus_TRANSFER_STATUS_CANCELLED
+	case enum.TransferStatusExpired:
+		return public.TransferStatus_TRANSFER_STATUS_EXPIRED
+	default:
+		return public.TransferStatus_TRANSFER_STATUS_UNDEFINED
+	}
+}
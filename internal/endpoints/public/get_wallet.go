@@ -3,6 +3,7 @@ package public
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-kit/kit/endpoint"
 	"github.com/knstch/knstch-libs/auth"
@@ -38,9 +39,11 @@ func (c *Controller) GetWallet(ctx context.Context, _ *public.GetWalletRequest)
 	}
 
 	return &public.GetWalletResponse{
-		Id:         uint64(wallet.ID),
-		Provider:   transportProvider,
-		IsVerified: wallet.VerifiedAt != nil,
+		Id:                    uint64(wallet.ID),
+		Provider:              transportProvider,
+		IsVerified:            isVerificationValid(wallet.VerificationStatus),
+		VerificationStatus:    convertSvcVerificationStatusToTransport(wallet.VerificationStatus),
+		VerificationExpiresAt: unixOrZero(wallet.VerificationExpiresAt),
 	}, nil
 }
 
@@ -48,7 +51,51 @@ func convertSvcProviderToTransport(provider enum.Provider) (public.Provider, err
 	switch provider {
 	case enum.ProviderPhantom:
 		return public.Provider_PROVIDER_PHANTOM, nil
+	case enum.ProviderSolflare:
+		return public.Provider_PROVIDER_SOLFLARE, nil
+	case enum.ProviderBackpack:
+		return public.Provider_PROVIDER_BACKPACK, nil
+	case enum.ProviderGlow:
+		return public.Provider_PROVIDER_GLOW, nil
+	case enum.ProviderLedger:
+		return public.Provider_PROVIDER_LEDGER, nil
+	case enum.ProviderWalletStandard:
+		return public.Provider_PROVIDER_WALLET_STANDARD, nil
+	case enum.ProviderStellar:
+		return public.Provider_PROVIDER_STELLAR, nil
+	case enum.ProviderCosmos:
+		return public.Provider_PROVIDER_COSMOS, nil
+	case enum.ProviderAptos:
+		return public.Provider_PROVIDER_APTOS, nil
+	case enum.ProviderSui:
+		return public.Provider_PROVIDER_SUI, nil
 	default:
 		return public.Provider_PROVIDER_UNDEFINED, fmt.Errorf("unknown provider: %s", provider)
 	}
 }
+
+func convertSvcVerificationStatusToTransport(status enum.VerificationStatus) public.VerificationStatus {
+	switch status {
+	case enum.VerificationStatusUnverified:
+		return public.VerificationStatus_VERIFICATION_STATUS_UNVERIFIED
+	case enum.VerificationStatusVerified:
+		return public.VerificationStatus_VERIFICATION_STATUS_VERIFIED
+	case enum.VerificationStatusExpiringSoon:
+		return public.VerificationStatus_VERIFICATION_STATUS_EXPIRING_SOON
+	case enum.VerificationStatusExpired:
+		return public.VerificationStatus_VERIFICATION_STATUS_EXPIRED
+	default:
+		return public.VerificationStatus_VERIFICATION_STATUS_UNDEFINED
+	}
+}
+
+func isVerificationValid(status enum.VerificationStatus) bool {
+	return status == enum.VerificationStatusVerified || status == enum.VerificationStatusExpiringSoon
+}
+
+func unixOrZero(t *time.Time) int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Unix()
+}
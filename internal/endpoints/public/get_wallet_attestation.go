@@ -0,0 +1,39 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/knstch/knstch-libs/auth"
+	"github.com/knstch/knstch-libs/tracing"
+	public "github.com/knstch/wallets-ido-api/public"
+)
+
+func MakeGetWalletAttestationEndpoint(c *Controller) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return c.GetWalletAttestation(ctx, &public.GetWalletAttestationRequest{})
+	}
+}
+
+func (c *Controller) GetWalletAttestation(ctx context.Context, _ *public.GetWalletAttestationRequest) (*public.GetWalletAttestationResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "public: GetWalletAttestation")
+	defer span.End()
+
+	user, err := auth.GetUserData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("auth.GetUserData: %w", err)
+	}
+
+	attestation, err := c.svc.IssueWalletAttestation(ctx, user.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("svc.IssueWalletAttestation: %w", err)
+	}
+
+	return &public.GetWalletAttestationResponse{
+		Attestation: &public.WalletAttestation{
+			Token:     attestation.Token,
+			ExpiresAt: attestation.ExpiresAt.Unix(),
+		},
+	}, nil
+}
@@ -0,0 +1,69 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/knstch/knstch-libs/auth"
+	"github.com/knstch/knstch-libs/tracing"
+	public "github.com/knstch/wallets-ido-api/public"
+
+	"wallets-service/internal/domain/enum"
+)
+
+func MakeGetWalletEventsEndpoint(c *Controller) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return c.GetWalletEvents(ctx, request.(*public.GetWalletEventsRequest))
+	}
+}
+
+func (c *Controller) GetWalletEvents(ctx context.Context, req *public.GetWalletEventsRequest) (*public.GetWalletEventsResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "public: GetWalletEvents")
+	defer span.End()
+
+	user, err := auth.GetUserData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("auth.GetUserData: %w", err)
+	}
+
+	events, err := c.svc.ListWalletEvents(ctx, user.UserID, 0, "", uint(req.GetBeforeId()), int(req.GetLimit()))
+	if err != nil {
+		return nil, fmt.Errorf("svc.ListWalletEvents: %w", err)
+	}
+
+	resp := &public.GetWalletEventsResponse{
+		Events: make([]*public.WalletEvent, 0, len(events)),
+	}
+	for _, event := range events {
+		transportProvider, err := convertSvcProviderToTransport(event.Provider)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Events = append(resp.Events, &public.WalletEvent{
+			Id:        uint64(event.ID),
+			Type:      convertSvcWalletEventTypeToTransport(event.Type),
+			WalletId:  uint64(event.WalletID),
+			Pubkey:    event.Pubkey,
+			Provider:  transportProvider,
+			Metadata:  event.Metadata,
+			CreatedAt: event.CreatedAt.Unix(),
+		})
+	}
+
+	return resp, nil
+}
+
+func convertSvcWalletEventTypeToTransport(eventType enum.WalletEventType) public.WalletEventType {
+	switch eventType {
+	case enum.WalletEventAdded:
+		return public.WalletEventType_WALLET_EVENT_TYPE_ADDED
+	case enum.WalletEventVerified:
+		return public.WalletEventType_WALLET_EVENT_TYPE_VERIFIED
+	case enum.WalletEventUnlinked:
+		return public.WalletEventType_WALLET_EVENT_TYPE_UNLINKED
+	default:
+		return public.WalletEventType_WALLET_EVENT_TYPE_UNDEFINED
+	}
+}
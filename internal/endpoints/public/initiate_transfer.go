@@ -0,0 +1,41 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/knstch/knstch-libs/auth"
+	"github.com/knstch/knstch-libs/tracing"
+	public "github.com/knstch/wallets-ido-api/public"
+)
+
+func MakeInitiateTransferEndpoint(c *Controller) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return c.InitiateTransfer(ctx, request.(*public.InitiateTransferRequest))
+	}
+}
+
+func (c *Controller) InitiateTransfer(ctx context.Context, req *public.InitiateTransferRequest) (*public.InitiateTransferResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "public: InitiateTransfer")
+	defer span.End()
+
+	user, err := auth.GetUserData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("auth.GetUserData: %w", err)
+	}
+
+	transfer, err := c.svc.InitiateTransfer(ctx, user.UserID, uint(req.GetWalletId()), uint(req.GetToUserId()))
+	if err != nil {
+		return nil, fmt.Errorf("svc.InitiateTransfer: %w", err)
+	}
+
+	transportTransfer, err := convertSvcTransferToTransport(transfer, user.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &public.InitiateTransferResponse{
+		Transfer: transportTransfer,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package public
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/knstch/knstch-libs/tracing"
+
+	"wallets-service/internal/attestations"
+)
+
+// jwksCacheControl lets verifiers cache the key set briefly, so a rotated key is picked up within minutes.
+const jwksCacheControl = "public, max-age=300"
+
+func MakeGetJWKSEndpoint(c *Controller) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return c.GetJWKS(ctx), nil
+	}
+}
+
+// GetJWKS returns the JSON Web Key Set wallet attestations are verified with.
+func (c *Controller) GetJWKS(ctx context.Context) attestations.KeySet {
+	ctx, span := tracing.StartSpan(ctx, "public: GetJWKS")
+	defer span.End()
+
+	return c.svc.AttestationKeySet(ctx)
+}
+
+func setJWKSCacheControl(ctx context.Context, w http.ResponseWriter) context.Context {
+	w.Header().Set("Cache-Control", jwksCacheControl)
+	return ctx
+}
@@ -0,0 +1,41 @@
+package public
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/go-kit/kit/endpoint"
+	httptransport "github.com/go-kit/kit/transport/http"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/wallets"
+)
+
+// withRequestMeta passes the client address, user agent and request ID of the HTTP request to the service.
+func withRequestMeta(next endpoint.Endpoint) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		userAgent, _ := ctx.Value(httptransport.ContextKeyRequestUserAgent).(string)
+		requestID, _ := ctx.Value(httptransport.ContextKeyRequestXRequestID).(string)
+
+		return next(wallets.WithRequestMeta(ctx, dto.RequestMeta{
+			IP:        clientIP(ctx),
+			UserAgent: userAgent,
+			RequestID: requestID,
+		}), request)
+	}
+}
+
+// clientIP returns the first X-Forwarded-For address, or the remote address of the connection.
+func clientIP(ctx context.Context) string {
+	if forwardedFor, _ := ctx.Value(httptransport.ContextKeyRequestXForwardedFor).(string); forwardedFor != "" {
+		first, _, _ := strings.Cut(forwardedFor, ",")
+		return strings.TrimSpace(first)
+	}
+
+	remoteAddr, _ := ctx.Value(httptransport.ContextKeyRequestRemoteAddr).(string)
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		return host
+	}
+	return remoteAddr
+}
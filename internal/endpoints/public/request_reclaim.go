@@ -0,0 +1,43 @@
+package public
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/knstch/knstch-libs/auth"
+	"github.com/knstch/knstch-libs/tracing"
+	public "github.com/knstch/wallets-ido-api/public"
+)
+
+func MakeRequestReclaimEndpoint(c *Controller) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return c.RequestReclaim(ctx, request.(*public.RequestReclaimRequest))
+	}
+}
+
+func (c *Controller) RequestReclaim(ctx context.Context, req *public.RequestReclaimRequest) (*public.RequestReclaimResponse, error) {
+	ctx, span := tracing.StartSpan(ctx, "public: RequestReclaim")
+	defer span.End()
+
+	user, err := auth.GetUserData(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("auth.GetUserData: %w", err)
+	}
+
+	provider, err := convertTransportProviderToService(req.GetProvider())
+	if err != nil {
+		return nil, fmt.Errorf("convertTransportProviderToService: %w", err)
+	}
+
+	challenge, err := c.svc.RequestReclaim(ctx, user.UserID, req.GetPubkey(), provider)
+	if err != nil {
+		return nil, fmt.Errorf("svc.RequestReclaim: %w", err)
+	}
+
+	return &public.RequestReclaimResponse{
+		ChallengeId:   challenge.ChallengeID,
+		MessageToSign: challenge.MessageToSign,
+		MessageFormat: convertSvcMessageFormatToTransport(challenge.MessageFormat),
+	}, nil
+}
@@ -25,9 +25,18 @@ func (c *Controller) UnlinkWallet(ctx context.Context, req *public.UnlinkWalletR
 		return nil, fmt.Errorf("auth.GetUserData: %w", err)
 	}
 
-	if err = c.svc.UnlinkWallet(ctx, uint(req.GetWalletId()), user.UserID); err != nil {
+	result, err := c.svc.UnlinkWallet(ctx, uint(req.GetWalletId()), user.UserID)
+	if err != nil {
 		return nil, fmt.Errorf("svc.UnlinkWallet: %w", err)
 	}
+	if result.Unlinked {
+		return &public.UnlinkWalletResponse{}, nil
+	}
 
-	return &public.UnlinkWalletResponse{}, nil
+	return &public.UnlinkWalletResponse{
+		ConfirmationRequired: true,
+		ChallengeId:          result.Challenge.ChallengeID,
+		MessageToSign:        result.Challenge.MessageToSign,
+		MessageFormat:        convertSvcMessageFormatToTransport(result.Challenge.MessageFormat),
+	}, nil
 }
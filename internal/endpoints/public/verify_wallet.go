@@ -6,6 +6,8 @@ import (
 
 	"github.com/go-kit/kit/endpoint"
 	"github.com/knstch/knstch-libs/auth"
+	"github.com/knstch/knstch-libs/log"
+	"github.com/knstch/knstch-libs/svcerrs"
 	"github.com/knstch/knstch-libs/tracing"
 	public "github.com/knstch/wallets-ido-api/public"
 )
@@ -25,9 +27,31 @@ func (c *Controller) VerifyWallet(ctx context.Context, req *public.VerifyWalletR
 		return nil, fmt.Errorf("auth.GetUserData: %w", err)
 	}
 
+	// Reject the request before the challenge is redeemed, so it can be retried without the attestation.
+	if req.GetIssueAttestation() && !c.svc.AttestationsEnabled(ctx) {
+		return nil, fmt.Errorf("wallet attestations are not configured: %w", svcerrs.ErrInvalidData)
+	}
+
 	if err = c.svc.VerifyWallet(ctx, user.UserID, req.GetChallengeId(), req.GetSignature(), req.GetPubkey()); err != nil {
 		return nil, fmt.Errorf("svc.VerifyWallet: %w", err)
 	}
 
-	return &public.VerifyWalletResponse{}, nil
+	resp := &public.VerifyWalletResponse{}
+	if req.GetIssueAttestation() {
+		// The wallet is verified by now, so a minting failure doesn't fail the call; the attestation
+		// can be fetched with GetWalletAttestation.
+		attestation, err := c.svc.IssueWalletAttestation(ctx, user.UserID)
+		if err != nil {
+			c.lg.Error("failed to issue wallet attestation", err,
+				log.AddMessage("user_id", user.UserID),
+			)
+			return resp, nil
+		}
+		resp.Attestation = &public.WalletAttestation{
+			Token:     attestation.Token,
+			ExpiresAt: attestation.ExpiresAt.Unix(),
+		}
+	}
+
+	return resp, nil
 }
@@ -0,0 +1,79 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	"github.com/knstch/knstch-libs/log"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"wallets-service/config"
+)
+
+// Check reports whether a dependency of the service is usable.
+type Check func(ctx context.Context) error
+
+// Checker runs the dependency checks periodically and reports the result through a gRPC health server.
+//
+// The overall ("") status and the status of every registered service are SERVING only while all
+// checks pass.
+type Checker struct {
+	lg       *log.Logger
+	server   *grpchealth.Server
+	checks   map[string]Check
+	services []string
+	cfg      config.HealthConfig
+}
+
+// NewChecker constructs a Checker updating the status of services on server.
+func NewChecker(lg *log.Logger, server *grpchealth.Server, checks map[string]Check, services []string, cfg config.HealthConfig) *Checker {
+	return &Checker{
+		lg:       lg,
+		server:   server,
+		checks:   checks,
+		services: services,
+		cfg:      cfg,
+	}
+}
+
+// Run checks the dependencies right away and then every CheckInterval until ctx is cancelled.
+func (c *Checker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(c.cfg.CheckInterval)
+	defer ticker.Stop()
+
+	for {
+		c.CheckAll(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckAll runs every check once and updates the serving status.
+func (c *Checker) CheckAll(ctx context.Context) {
+	servingStatus := healthpb.HealthCheckResponse_SERVING
+	for name, check := range c.checks {
+		if err := c.runCheck(ctx, check); err != nil {
+			c.lg.Error("health check failed", err,
+				log.AddMessage("check", name),
+			)
+			servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+		}
+	}
+
+	c.server.SetServingStatus("", servingStatus)
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, servingStatus)
+	}
+}
+
+func (c *Checker) runCheck(ctx context.Context, check Check) error {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.CheckTimeout)
+	defer cancel()
+
+	return check(ctx)
+}
@@ -0,0 +1,33 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// DBCheck pings the Postgres connection pool behind db.
+func DBCheck(db *gorm.DB) Check {
+	return func(ctx context.Context) error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return fmt.Errorf("db.DB: %w", err)
+		}
+		if err = sqlDB.PingContext(ctx); err != nil {
+			return fmt.Errorf("sqlDB.PingContext: %w", err)
+		}
+		return nil
+	}
+}
+
+// RedisCheck pings the Redis server behind client.
+func RedisCheck(client *redis.Client) Check {
+	return func(ctx context.Context) error {
+		if err := client.Ping(ctx).Err(); err != nil {
+			return fmt.Errorf("client.Ping: %w", err)
+		}
+		return nil
+	}
+}
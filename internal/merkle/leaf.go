@@ -0,0 +1,33 @@
+package merkle
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"wallets-service/internal/domain/enum"
+)
+
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// Leaf returns the leaf hash of an address and its allocation amount, which is ignored by enum.LeafEncodingAddress.
+//
+// If the amount is negative or does not fit the encoding, Leaf returns an error.
+func Leaf(hashFunction enum.MerkleHash, leafEncoding enum.LeafEncoding, address []byte, amount *big.Int) ([]byte, error) {
+	switch leafEncoding {
+	case enum.LeafEncodingAddress:
+		return Sum(hashFunction, address), nil
+	case enum.LeafEncodingAddressAmountU64LE:
+		if amount.Sign() < 0 || !amount.IsUint64() {
+			return nil, fmt.Errorf("amount %s does not fit uint64", amount)
+		}
+		return Sum(hashFunction, address, binary.LittleEndian.AppendUint64(nil, amount.Uint64())), nil
+	case enum.LeafEncodingAddressAmountU256BE:
+		if amount.Sign() < 0 || amount.Cmp(maxUint256) > 0 {
+			return nil, fmt.Errorf("amount %s does not fit uint256", amount)
+		}
+		return Sum(hashFunction, address, amount.FillBytes(make([]byte, 32))), nil
+	default:
+		return nil, fmt.Errorf("unsupported leaf encoding: %s", leafEncoding)
+	}
+}
@@ -0,0 +1,115 @@
+// Package merkle builds Merkle trees for on-chain allowlists and the inclusion proofs of their leaves.
+//
+// Trees follow the OpenZeppelin MerkleProof convention: leaves are sorted, every internal node hashes the
+// concatenation of its two children in ascending byte order, and a node without a sibling is promoted to the
+// next level unchanged. Proofs therefore carry no position bits and verify with a plain sorted-pair fold.
+package merkle
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"hash"
+	"slices"
+
+	"golang.org/x/crypto/sha3"
+
+	"wallets-service/internal/domain/enum"
+)
+
+func newHash(hashFunction enum.MerkleHash) hash.Hash {
+	if hashFunction == enum.MerkleHashKeccak256 {
+		return sha3.NewLegacyKeccak256()
+	}
+	return sha256.New()
+}
+
+// Sum returns the hash of the concatenation of parts.
+func Sum(hashFunction enum.MerkleHash, parts ...[]byte) []byte {
+	hasher := newHash(hashFunction)
+	for _, part := range parts {
+		hasher.Write(part)
+	}
+	return hasher.Sum(nil)
+}
+
+// Tree is a Merkle tree; Levels[0] holds the sorted leaves and the last level holds the root.
+type Tree struct {
+	Levels [][][]byte
+}
+
+// Build returns the tree of the given leaf hashes. Leaves are sorted first; duplicates are rejected
+// because their proofs would be ambiguous.
+func Build(hashFunction enum.MerkleHash, leaves [][]byte) (Tree, error) {
+	if len(leaves) == 0 {
+		return Tree{}, fmt.Errorf("no leaves")
+	}
+
+	level := slices.Clone(leaves)
+	slices.SortFunc(level, bytes.Compare)
+	for i := 1; i < len(level); i++ {
+		if bytes.Equal(level[i-1], level[i]) {
+			return Tree{}, fmt.Errorf("duplicate leaf %x", level[i])
+		}
+	}
+
+	tree := Tree{Levels: [][][]byte{level}}
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, hashPair(hashFunction, level[i], level[i+1]))
+		}
+		tree.Levels = append(tree.Levels, next)
+		level = next
+	}
+
+	return tree, nil
+}
+
+// Root returns the root of the tree.
+func (t Tree) Root() []byte {
+	return t.Levels[len(t.Levels)-1][0]
+}
+
+// ProofPositions returns the level and position of every node in the proof of the leaf at position,
+// for a tree with leafCount leaves, from the bottom up. It lets proofs be read from stored nodes.
+func ProofPositions(leafCount, position int) [][2]int {
+	var positions [][2]int
+	for level, size := 0, leafCount; size > 1; level, size = level+1, (size+1)/2 {
+		if sibling := position ^ 1; sibling < size {
+			positions = append(positions, [2]int{level, sibling})
+		}
+		position /= 2
+	}
+	return positions
+}
+
+// Proof returns the inclusion proof of the leaf at position in Levels[0].
+func (t Tree) Proof(position int) [][]byte {
+	positions := ProofPositions(len(t.Levels[0]), position)
+	proof := make([][]byte, 0, len(positions))
+	for _, p := range positions {
+		proof = append(proof, t.Levels[p[0]][p[1]])
+	}
+	return proof
+}
+
+// Verify reports whether proof proves that leaf is in the tree with the given root.
+func Verify(hashFunction enum.MerkleHash, root, leaf []byte, proof [][]byte) bool {
+	node := leaf
+	for _, sibling := range proof {
+		node = hashPair(hashFunction, node, sibling)
+	}
+	return bytes.Equal(node, root)
+}
+
+func hashPair(hashFunction enum.MerkleHash, a, b []byte) []byte {
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	return Sum(hashFunction, a, b)
+}
@@ -27,6 +27,36 @@ var (
 		Name:      "unlink_total",
 		Help:      "Total number of UnlinkWallet calls.",
 	})
+	walletsReclaimTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "wallets_service",
+		Subsystem: "wallets",
+		Name:      "reclaim_total",
+		Help:      "Total number of wallet reclaim calls by stage.",
+	}, []string{"stage"})
+	walletsTransferTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "wallets_service",
+		Subsystem: "wallets",
+		Name:      "transfer_total",
+		Help:      "Total number of wallet transfer calls by stage.",
+	}, []string{"stage"})
+	walletsSignatureEncodingTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "wallets_service",
+		Subsystem: "wallets",
+		Name:      "signature_encoding_total",
+		Help:      "Total number of verified signatures by provider and submitted encoding.",
+	}, []string{"provider", "encoding"})
+	walletsOutboxPublishTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "wallets_service",
+		Subsystem: "wallets",
+		Name:      "outbox_publish_total",
+		Help:      "Total number of outbox publish attempts by result.",
+	}, []string{"result"})
+	walletsWebhookDeliveryTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "wallets_service",
+		Subsystem: "wallets",
+		Name:      "webhook_delivery_total",
+		Help:      "Total number of webhook delivery attempts by result.",
+	}, []string{"result"})
 )
 
 func registerWallets() {
@@ -35,6 +65,11 @@ func registerWallets() {
 			walletsAddTotal,
 			walletsVerifyTotal,
 			walletsUnlinkTotal,
+			walletsReclaimTotal,
+			walletsTransferTotal,
+			walletsSignatureEncodingTotal,
+			walletsOutboxPublishTotal,
+			walletsWebhookDeliveryTotal,
 		)
 	})
 }
@@ -56,3 +91,33 @@ func IncUnlinkWallet() {
 	registerWallets()
 	walletsUnlinkTotal.Inc()
 }
+
+// IncReclaimWallet increments the wallet reclaim Prometheus counter for the stage.
+func IncReclaimWallet(stage string) {
+	registerWallets()
+	walletsReclaimTotal.WithLabelValues(stage).Inc()
+}
+
+// IncTransferWallet increments the wallet transfer Prometheus counter for the stage.
+func IncTransferWallet(stage string) {
+	registerWallets()
+	walletsTransferTotal.WithLabelValues(stage).Inc()
+}
+
+// IncSignatureEncoding increments the verified signature counter for the provider and encoding.
+func IncSignatureEncoding(provider, encoding string) {
+	registerWallets()
+	walletsSignatureEncodingTotal.WithLabelValues(provider, encoding).Inc()
+}
+
+// IncOutboxPublish increments the outbox publish attempts Prometheus counter for the result.
+func IncOutboxPublish(result string) {
+	registerWallets()
+	walletsOutboxPublishTotal.WithLabelValues(result).Inc()
+}
+
+// IncWebhookDelivery increments the webhook delivery attempts Prometheus counter for the result.
+func IncWebhookDelivery(result string) {
+	registerWallets()
+	walletsWebhookDeliveryTotal.WithLabelValues(result).Inc()
+}
@@ -0,0 +1,62 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nats-io/nats.go"
+)
+
+// natsKeyHeader carries the ordering key of the event.
+const natsKeyHeader = "Wallets-Event-Key"
+
+// NATSPublisher publishes events to NATS JetStream on "<prefix>.<event type>" subjects.
+//
+// Publishing waits for the stream acknowledgement and sets the event ID as Nats-Msg-Id,
+// so JetStream drops redeliveries within its duplicate window.
+type NATSPublisher struct {
+	conn          *nats.Conn
+	js            nats.JetStreamContext
+	subjectPrefix string
+}
+
+// NewNATSPublisher connects to the NATS server at url.
+func NewNATSPublisher(url, subjectPrefix string) (*NATSPublisher, error) {
+	conn, err := nats.Connect(url, nats.Name("wallets-service outbox"))
+	if err != nil {
+		return nil, fmt.Errorf("nats.Connect: %w", err)
+	}
+
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("conn.JetStream: %w", err)
+	}
+
+	return &NATSPublisher{
+		conn:          conn,
+		js:            js,
+		subjectPrefix: subjectPrefix,
+	}, nil
+}
+
+// Publish sends the event and waits for JetStream to store it.
+func (p *NATSPublisher) Publish(ctx context.Context, msg Message) error {
+	natsMsg := nats.NewMsg(p.subjectPrefix + "." + msg.Type)
+	natsMsg.Data = msg.Payload
+	natsMsg.Header.Set(natsKeyHeader, msg.Key)
+
+	if _, err := p.js.PublishMsg(natsMsg, nats.MsgId(msg.ID), nats.Context(ctx)); err != nil {
+		return fmt.Errorf("js.PublishMsg: %w", err)
+	}
+
+	return nil
+}
+
+// Close flushes pending messages and closes the connection.
+func (p *NATSPublisher) Close() error {
+	if err := p.conn.Drain(); err != nil {
+		return fmt.Errorf("conn.Drain: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+// Package outbox relays wallet domain events from the transactional outbox table to a message broker.
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+
+	"wallets-service/config"
+)
+
+// Message is a domain event handed to an EventPublisher.
+type Message struct {
+	// ID is the unique event ID. It is stable across redeliveries, so consumers can deduplicate by it.
+	ID string
+	// Type is the domain event type, e.g. "wallet.verified".
+	Type string
+	// Key is the ordering key of the event (the user ID). Events with the same key are published in order.
+	Key string
+	// Payload is the JSON-encoded event.
+	Payload []byte
+}
+
+// EventPublisher delivers domain events to a message broker.
+type EventPublisher interface {
+	// Publish returns nil only once the broker has accepted the message.
+	Publish(ctx context.Context, msg Message) error
+	// Close releases the broker connection.
+	Close() error
+}
+
+// NewPublisher returns the EventPublisher selected by cfg.Publisher, or nil if publishing is disabled.
+func NewPublisher(cfg config.OutboxConfig, redisClient *redis.Client) (EventPublisher, error) {
+	switch cfg.Publisher {
+	case "":
+		return nil, nil
+	case "redis":
+		return NewRedisStreamsPublisher(redisClient, cfg.RedisStream, cfg.RedisStreamMaxLen), nil
+	case "nats":
+		publisher, err := NewNATSPublisher(cfg.NATSURL, cfg.NATSSubjectPrefix)
+		if err != nil {
+			return nil, fmt.Errorf("NewNATSPublisher: %w", err)
+		}
+		return publisher, nil
+	default:
+		return nil, fmt.Errorf("unknown outbox publisher %q", cfg.Publisher)
+	}
+}
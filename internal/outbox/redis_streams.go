@@ -0,0 +1,48 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisStreamsPublisher appends events to a single Redis stream, which keeps them in publish order.
+type RedisStreamsPublisher struct {
+	client *redis.Client
+	stream string
+	maxLen int64
+}
+
+// NewRedisStreamsPublisher constructs a RedisStreamsPublisher. A positive maxLen approximately caps the stream length.
+func NewRedisStreamsPublisher(client *redis.Client, stream string, maxLen int64) *RedisStreamsPublisher {
+	return &RedisStreamsPublisher{
+		client: client,
+		stream: stream,
+		maxLen: maxLen,
+	}
+}
+
+// Publish adds the event to the stream.
+func (p *RedisStreamsPublisher) Publish(ctx context.Context, msg Message) error {
+	if err := p.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: p.stream,
+		MaxLen: p.maxLen,
+		Approx: p.maxLen > 0,
+		Values: map[string]any{
+			"event_id": msg.ID,
+			"type":     msg.Type,
+			"key":      msg.Key,
+			"payload":  msg.Payload,
+		},
+	}).Err(); err != nil {
+		return fmt.Errorf("redis.XAdd: %w", err)
+	}
+
+	return nil
+}
+
+// Close is a no-op: the Redis client is shared with the service.
+func (p *RedisStreamsPublisher) Close() error {
+	return nil
+}
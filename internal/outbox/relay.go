@@ -0,0 +1,125 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/knstch/knstch-libs/log"
+
+	"wallets-service/config"
+	"wallets-service/internal/metrics"
+	"wallets-service/internal/wallets/repo"
+)
+
+// relayLockKey is the Postgres advisory lock that lets a single relay publish at a time,
+// which keeps the events of a user in order when several service instances run.
+const relayLockKey int64 = 0x77616c6c6574 // "wallet"
+
+// Relay publishes outbox messages with at-least-once delivery.
+//
+// A message is marked published only after the publisher accepted it, so a crash in between leads to
+// a redelivery with the same event ID. Failed messages are retried with exponential backoff, and later
+// messages of the same user wait until the failed one is published.
+type Relay struct {
+	lg        *log.Logger
+	repo      repo.Repository
+	publisher EventPublisher
+	cfg       config.OutboxConfig
+}
+
+// NewRelay constructs a Relay.
+func NewRelay(lg *log.Logger, repo repo.Repository, publisher EventPublisher, cfg config.OutboxConfig) *Relay {
+	return &Relay{
+		lg:        lg,
+		repo:      repo,
+		publisher: publisher,
+		cfg:       cfg,
+	}
+}
+
+// Run relays messages every PollInterval until ctx is cancelled.
+func (r *Relay) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		// Keep going while messages are published: the next message of a user only becomes due
+		// once the previous one is out.
+		for {
+			published, err := r.RelayDue(ctx)
+			if err != nil {
+				r.lg.Error("outbox relay failed", err)
+				break
+			}
+			if published == 0 {
+				break
+			}
+		}
+	}
+}
+
+// RelayDue makes one publish attempt for every due message and returns how many were published.
+//
+// If another relay is already running, RelayDue returns right away.
+func (r *Relay) RelayDue(ctx context.Context) (int, error) {
+	var published int
+	if err := r.repo.Transaction(func(st repo.Repository) error {
+		locked, err := st.TryAdvisoryLock(ctx, relayLockKey)
+		if err != nil {
+			return fmt.Errorf("st.TryAdvisoryLock: %w", err)
+		}
+		if !locked {
+			return nil
+		}
+
+		messages, err := st.ListDueOutboxMessages(ctx, r.cfg.BatchSize)
+		if err != nil {
+			return fmt.Errorf("st.ListDueOutboxMessages: %w", err)
+		}
+
+		for _, msg := range messages {
+			if err = r.publisher.Publish(ctx, Message{
+				ID:      msg.EventID,
+				Type:    msg.Type,
+				Key:     strconv.FormatUint(uint64(msg.UserID), 10),
+				Payload: msg.Payload,
+			}); err != nil {
+				metrics.IncOutboxPublish("failed")
+				if err = st.MarkOutboxMessageFailed(ctx, msg.ID, time.Now().Add(r.backoff(msg.Attempts+1)), err.Error()); err != nil {
+					return fmt.Errorf("st.MarkOutboxMessageFailed: %w", err)
+				}
+				continue
+			}
+
+			metrics.IncOutboxPublish("published")
+			if err = st.MarkOutboxMessagePublished(ctx, msg.ID); err != nil {
+				return fmt.Errorf("st.MarkOutboxMessagePublished: %w", err)
+			}
+			published++
+		}
+
+		return nil
+	}); err != nil {
+		return 0, fmt.Errorf("repo.Transaction: %w", err)
+	}
+
+	return published, nil
+}
+
+// backoff returns the delay before the given attempt: RetryMinBackoff doubled for every failed attempt,
+// capped at RetryMaxBackoff.
+func (r *Relay) backoff(attempt int) time.Duration {
+	delay := r.cfg.RetryMinBackoff
+	for i := 1; i < attempt && delay < r.cfg.RetryMaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, r.cfg.RetryMaxBackoff)
+}
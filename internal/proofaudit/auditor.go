@@ -0,0 +1,179 @@
+// Package proofaudit re-verifies stored wallet verification proofs offline.
+package proofaudit
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/wallets/chains"
+	"wallets-service/internal/wallets/filters"
+	"wallets-service/internal/wallets/repo"
+)
+
+const defaultPageSize = 500
+
+// Auditor checks stored verification proofs against the current verifier code.
+type Auditor struct {
+	repo     repo.Repository
+	chains   *chains.Registry
+	pageSize int
+}
+
+// NewAuditor constructs an Auditor reading proofs and wallets in pages of pageSize.
+// A non-positive pageSize selects the default.
+func NewAuditor(repo repo.Repository, chains *chains.Registry, pageSize int) *Auditor {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	return &Auditor{
+		repo:     repo,
+		chains:   chains,
+		pageSize: pageSize,
+	}
+}
+
+// Run streams every stored proof and every verified wallet and reports what does not check out:
+// proofs the current verifier rejects or whose stored message is not the one signed, and verified wallets
+// whose current owner has no stored proof.
+// Rejected proofs don't count as proof of ownership.
+func (a *Auditor) Run(ctx context.Context) (Report, error) {
+	var report Report
+
+	// provers holds the users that proved ownership of each wallet.
+	provers := make(map[uint]map[uint]struct{})
+
+	var afterID uint
+	for {
+		proofs, err := a.repo.ListVerificationProofs(ctx, filters.VerificationProofsFilter{
+			AfterID: afterID,
+		}, a.pageSize)
+		if err != nil {
+			return Report{}, fmt.Errorf("repo.ListVerificationProofs: %w", err)
+		}
+
+		for _, proof := range proofs {
+			report.ProofsChecked++
+			if finding, ok := a.checkProof(proof); !ok {
+				report.Findings = append(report.Findings, finding)
+				continue
+			}
+
+			if provers[proof.WalletID] == nil {
+				provers[proof.WalletID] = make(map[uint]struct{})
+			}
+			provers[proof.WalletID][proof.UserID] = struct{}{}
+		}
+
+		if len(proofs) < a.pageSize {
+			break
+		}
+		afterID = proofs[len(proofs)-1].ID
+	}
+
+	afterID = 0
+	for {
+		wallets, err := a.repo.ListWallets(ctx, filters.WalletsFilter{
+			IsVerified: filters.BoolPtr(true),
+			AfterID:    afterID,
+		}, a.pageSize)
+		if err != nil {
+			return Report{}, fmt.Errorf("repo.ListWallets: %w", err)
+		}
+
+		for _, wallet := range wallets {
+			report.WalletsChecked++
+			if finding, ok := checkWallet(wallet, provers[wallet.ID]); !ok {
+				report.Findings = append(report.Findings, finding)
+			}
+		}
+
+		if len(wallets) < a.pageSize {
+			break
+		}
+		afterID = wallets[len(wallets)-1].ID
+	}
+
+	return report, nil
+}
+
+// checkProof re-runs signature verification of the proof as of the time it was verified and checks that
+// the stored message and signed payload are the ones the signature answers.
+func (a *Auditor) checkProof(proof dto.VerificationProof) (Finding, bool) {
+	finding := Finding{
+		Kind:     FindingSignatureMismatch,
+		ProofID:  proof.ID,
+		WalletID: proof.WalletID,
+		UserID:   proof.UserID,
+		Pubkey:   proof.Pubkey,
+		Provider: proof.Provider.String(),
+	}
+
+	chain, err := a.chains.Get(proof.Provider)
+	if err != nil {
+		finding.Detail = err.Error()
+		return finding, false
+	}
+
+	// Proofs stored before the verification time was recorded are checked at the time they were stored.
+	checkedAt := proof.VerifiedAt
+	if checkedAt.IsZero() {
+		checkedAt = proof.CreatedAt
+	}
+
+	verification, err := chain.VerifySignature(chains.Challenge{
+		ID:        proof.ChallengeID,
+		Pubkey:    proof.Pubkey,
+		Nonce:     proof.Nonce,
+		ExpiresAt: proof.ExpiresAt,
+		Format:    proof.Format,
+		Statement: proof.Statement,
+		At:        checkedAt,
+		Stored:    true,
+	}, proof.Signature)
+	if err != nil {
+		finding.Detail = fmt.Sprintf("verifier %s rejects proof accepted by verifier %s: %v", chains.VerifierVersion, proof.VerifierVersion, err)
+		return finding, false
+	}
+	if verification.Encoding.String() != proof.Encoding {
+		finding.Detail = fmt.Sprintf("signature decoded as %s, stored as %s", verification.Encoding, proof.Encoding)
+		return finding, false
+	}
+
+	if !bytes.Equal([]byte(verification.Message), proof.Message) {
+		finding.Kind = FindingMessageMismatch
+		finding.Detail = "stored message differs from the rebuilt challenge message"
+		return finding, false
+	}
+	// Proofs stored before signed payloads were recorded have none.
+	if len(proof.SignedPayload) > 0 && !bytes.Equal(verification.SignedPayload, proof.SignedPayload) {
+		finding.Kind = FindingMessageMismatch
+		finding.Detail = "stored signed payload differs from the rebuilt one"
+		return finding, false
+	}
+
+	return Finding{}, true
+}
+
+// checkWallet reports a verified wallet whose current owner is not among the users that proved its ownership.
+func checkWallet(wallet dto.Wallet, provers map[uint]struct{}) (Finding, bool) {
+	finding := Finding{
+		WalletID: wallet.ID,
+		UserID:   wallet.UserID,
+		Pubkey:   wallet.Pubkey,
+		Provider: wallet.Provider.String(),
+	}
+
+	if len(provers) == 0 {
+		finding.Kind = FindingMissingProof
+		return finding, false
+	}
+	if _, ok := provers[wallet.UserID]; !ok {
+		finding.Kind = FindingVerifiedWithoutProof
+		finding.Detail = "no stored proof was made by the current owner"
+		return finding, false
+	}
+
+	return Finding{}, true
+}
@@ -0,0 +1,35 @@
+package proofaudit
+
+// FindingKind classifies a problem found by the audit.
+type FindingKind string
+
+const (
+	// FindingSignatureMismatch is a stored proof the current verifier code rejects.
+	FindingSignatureMismatch FindingKind = "signature_mismatch"
+	// FindingMessageMismatch is a stored proof whose message or signed payload differs from the one
+	// rebuilt from its challenge, i.e. the signature does not answer the stored message.
+	FindingMessageMismatch FindingKind = "message_mismatch"
+	// FindingMissingProof is a verified wallet without any stored proof.
+	FindingMissingProof FindingKind = "missing_proof"
+	// FindingVerifiedWithoutProof is a verified wallet whose stored proofs were all made by other users,
+	// so its current owner never proved ownership.
+	FindingVerifiedWithoutProof FindingKind = "verified_without_proof"
+)
+
+// Finding is a single problem found by the audit.
+type Finding struct {
+	Kind     FindingKind `json:"kind"`
+	ProofID  uint        `json:"proof_id,omitempty"`
+	WalletID uint        `json:"wallet_id"`
+	UserID   uint        `json:"user_id"`
+	Pubkey   string      `json:"pubkey"`
+	Provider string      `json:"provider"`
+	Detail   string      `json:"detail,omitempty"`
+}
+
+// Report summarizes an audit run.
+type Report struct {
+	ProofsChecked  int       `json:"proofs_checked"`
+	WalletsChecked int       `json:"wallets_checked"`
+	Findings       []Finding `json:"findings"`
+}
@@ -2,12 +2,9 @@ package wallets
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"time"
 
-	"github.com/google/uuid"
 	"github.com/knstch/knstch-libs/svcerrs"
 	"github.com/knstch/knstch-libs/tracing"
 
@@ -16,66 +13,39 @@ import (
 	"wallets-service/internal/metrics"
 	"wallets-service/internal/wallets/filters"
 	"wallets-service/internal/wallets/repo"
-	"wallets-service/internal/wallets/utils"
 )
 
-const (
-	nonceLen = 32
-
-	challengeExpirationPeriod = time.Minute * 15
-
-	messageToSignToVerifyWallet = "Please, verify your wallet"
-)
-
-func buildMessageToSign(challengeID, pubkey, nonce string, expiresAt int64) string {
-	return fmt.Sprintf(
-		"%s\n\nPubkey: %s\nChallengeId: %s\nNonce: %s\nExpiresAt: %d",
-		messageToSignToVerifyWallet,
-		pubkey,
-		challengeID,
-		nonce,
-		expiresAt,
-	)
-}
-
 // AddWallet creates a wallet record for the user and returns a verification challenge.
 //
 // Behavior:
-//   - If the wallet is new, it is created in Postgres and the challenge is stored in Redis.
+//   - If the wallet is new, it is created in Postgres together with an added wallet event (audit log and outbox)
+//     and the challenge is stored in Redis.
 //   - If the wallet already exists and is NOT verified, the service re-issues a new challenge (re-verify flow).
-//   - If the wallet already exists and is verified (or belongs to another user due to unique constraints),
-//     AddWallet returns svcerrs.ErrConflict.
+//   - If the wallet already exists and its verification is expiring soon or expired, the service issues
+//     a re-verification challenge.
+//   - If the wallet already exists and its verification is still valid (or it belongs to another user due to
+//     unique constraints), AddWallet returns svcerrs.ErrConflict.
+//   - If the pubkey was recently unlinked by another account, AddWallet returns ErrRelinkCooldown;
+//     if the user linked and unlinked wallets too often, it returns ErrLinkChurnLimit.
 //
 // The returned MessageToSign must be signed by the wallet owner and then validated via VerifyWallet.
+// Its format depends on the provider's chain: plain text for Cosmos, Aptos and Sui wallets and a server-signed
+// SEP-10 challenge transaction (base64 XDR) for Stellar wallets. Solana wallets get plain text, a SIWS message
+// or text to be wrapped into an off-chain message envelope, as chosen by the provider capability table.
 func (s *ServiceImpl) AddWallet(ctx context.Context, userID uint, pubkey string, provider enum.Provider) (dto.ChallengeForUser, error) {
 	defer metrics.IncAddWallet()
 
 	ctx, span := tracing.StartSpan(ctx, "wallets: AddWallet")
 	defer span.End()
 
-	challengeID := uuid.New()
-	expiresAt := time.Now().Add(challengeExpirationPeriod)
-	nonce, err := utils.RandomString(nonceLen)
-	if err != nil {
-		return dto.ChallengeForUser{}, fmt.Errorf("utils.RandomString: %w", err)
-	}
-
-	challenge := &Challenge{
-		UserID:    userID,
-		PubKey:    pubkey,
-		Provider:  provider.String(),
-		Nonce:     nonce,
-		ExpiresAt: expiresAt.Unix(),
-	}
-	msg := buildMessageToSign(challengeID.String(), pubkey, nonce, expiresAt.Unix())
-
-	jsonChallenge, err := json.Marshal(challenge)
+	challenge, jsonChallenge, err := s.newChallenge(userID, pubkey, provider, challengePurposeVerify, 0)
 	if err != nil {
-		return dto.ChallengeForUser{}, fmt.Errorf("json.Marshal: %w", err)
+		return dto.ChallengeForUser{}, fmt.Errorf("newChallenge: %w", err)
 	}
 
 	if err = s.repo.Transaction(func(st repo.Repository) error {
-		if err := st.CreateWallet(ctx, userID, pubkey, provider); err != nil {
+		walletID, err := st.CreateWallet(ctx, userID, pubkey, provider)
+		if err != nil {
 			// Bubble up conflict as-is so we can handle it outside the transaction.
 			if errors.Is(err, svcerrs.ErrConflict) {
 				return svcerrs.ErrConflict
@@ -83,46 +53,54 @@ func (s *ServiceImpl) AddWallet(ctx context.Context, userID uint, pubkey string,
 			return fmt.Errorf("st.CreateWallet: %w", err)
 		}
 
-		if err := s.redis.Set(
-			ctx,
-			GetChallengeByIDKey(challengeID.String()),
-			jsonChallenge,
-			challengeExpirationPeriod,
-		).Err(); err != nil {
-			return fmt.Errorf("redis.Set: %w", err)
+		if err := s.checkRelinkAllowed(ctx, st, userID, pubkey); err != nil {
+			return fmt.Errorf("checkRelinkAllowed: %w", err)
+		}
+
+		if err := st.CreateLinkEvent(ctx, userID, pubkey, enum.LinkActionLink); err != nil {
+			return fmt.Errorf("st.CreateLinkEvent: %w", err)
+		}
+
+		if err := s.storeChallenge(ctx, challenge.ChallengeID, pubkey, jsonChallenge); err != nil {
+			return fmt.Errorf("storeChallenge: %w", err)
+		}
+
+		if err := recordWalletEvents(ctx, st, newWalletEvent(ctx, enum.WalletEventAdded, dto.Wallet{
+			ID:       walletID,
+			UserID:   userID,
+			Pubkey:   pubkey,
+			Provider: provider,
+		}, map[string]string{
+			"challenge_id": challenge.ChallengeID,
+		})); err != nil {
+			return fmt.Errorf("recordWalletEvents: %w", err)
 		}
 
 		return nil
 	}); err != nil {
 		if errors.Is(err, svcerrs.ErrConflict) {
-			isVerified := false
-			if _, getErr := s.repo.GetWallet(ctx, filters.WalletsFilter{
-				UserID:     userID,
-				Pubkey:     pubkey,
-				Provider:   provider,
-				IsVerified: &isVerified,
-			}); getErr != nil {
+			wallet, getErr := s.repo.GetWallet(ctx, filters.WalletsFilter{
+				UserID:   userID,
+				Pubkey:   pubkey,
+				Provider: provider,
+			})
+			if getErr != nil {
 				if errors.Is(getErr, svcerrs.ErrDataNotFound) {
-			return dto.ChallengeForUser{}, fmt.Errorf("attempt to re-verify wallet: %w", svcerrs.ErrConflict)
+					return dto.ChallengeForUser{}, fmt.Errorf("wallet belongs to another user: %w", svcerrs.ErrConflict)
 				}
 				return dto.ChallengeForUser{}, fmt.Errorf("repo.GetWallet: %w", getErr)
 			}
+			if s.withVerificationStatus(wallet).VerificationStatus == enum.VerificationStatusVerified {
+				return dto.ChallengeForUser{}, fmt.Errorf("attempt to re-verify wallet: %w", svcerrs.ErrConflict)
+			}
 
-			if err := s.redis.Set(
-				ctx,
-				GetChallengeByIDKey(challengeID.String()),
-				jsonChallenge,
-				challengeExpirationPeriod,
-			).Err(); err != nil {
-				return dto.ChallengeForUser{}, fmt.Errorf("redis.Set: %w", err)
+			if err := s.storeChallenge(ctx, challenge.ChallengeID, pubkey, jsonChallenge); err != nil {
+				return dto.ChallengeForUser{}, fmt.Errorf("storeChallenge: %w", err)
 			}
 		} else {
 			return dto.ChallengeForUser{}, fmt.Errorf("repo.Transaction: %w", err)
 		}
 	}
 
-	return dto.ChallengeForUser{
-		ChallengeID:   challengeID.String(),
-		MessageToSign: msg,
-	}, nil
+	return challenge, nil
 }
@@ -0,0 +1,263 @@
+package wallets
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/merkle"
+	"wallets-service/internal/wallets/filters"
+	"wallets-service/internal/wallets/repo"
+)
+
+// CreateAllowlist builds a Merkle tree over the wallets of a snapshot and stores its root, leaves and nodes.
+//
+// Each leaf hashes the raw address bytes of a wallet, followed by its allocation for encodings with
+// an amount. Wallets with a zero allocation are left out of such allowlists. Allocations are matched
+// against the snapshot after normalizing their pubkeys; entries for wallets outside the snapshot are ignored.
+//
+// If the snapshot does not exist, CreateAllowlist returns an error wrapping svcerrs.ErrDataNotFound.
+func (s *ServiceImpl) CreateAllowlist(ctx context.Context, spec dto.AllowlistSpec) (dto.Allowlist, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: CreateAllowlist")
+	defer span.End()
+
+	if len(spec.Label) > maxWalletSnapshotLabelLen {
+		return dto.Allowlist{}, fmt.Errorf("label must be at most %d bytes: %w", maxWalletSnapshotLabelLen, svcerrs.ErrInvalidData)
+	}
+	if _, err := enum.GetMerkleHash(spec.Hash.String()); err != nil {
+		return dto.Allowlist{}, fmt.Errorf("enum.GetMerkleHash: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+	if _, err := enum.GetLeafEncoding(spec.Encoding.String()); err != nil {
+		return dto.Allowlist{}, fmt.Errorf("enum.GetLeafEncoding: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+	for _, provider := range spec.Providers {
+		if _, err := enum.GetProvider(provider.String()); err != nil {
+			return dto.Allowlist{}, fmt.Errorf("enum.GetProvider: %w", errors.Join(err, svcerrs.ErrInvalidData))
+		}
+	}
+
+	defaultAmount, allocations, err := s.parseAllocations(spec)
+	if err != nil {
+		return dto.Allowlist{}, fmt.Errorf("parseAllocations: %w", err)
+	}
+
+	if _, err = s.repo.GetWalletSnapshot(ctx, spec.SnapshotID); err != nil {
+		return dto.Allowlist{}, fmt.Errorf("repo.GetWalletSnapshot: %w", err)
+	}
+
+	var (
+		leaves     []dto.AllowlistLeaf
+		leafHashes [][]byte
+	)
+	var afterPubkey string
+	for {
+		entries, err := s.repo.ListWalletSnapshotEntries(ctx, spec.SnapshotID, afterPubkey, walletSnapshotExportPageSize)
+		if err != nil {
+			return dto.Allowlist{}, fmt.Errorf("repo.ListWalletSnapshotEntries: %w", err)
+		}
+
+		for _, entry := range entries {
+			if len(spec.Providers) != 0 && !slices.Contains(spec.Providers, entry.Provider) {
+				continue
+			}
+
+			amount := defaultAmount
+			if allocation, ok := allocations[entry.Pubkey]; ok {
+				amount = allocation
+			}
+			if spec.Encoding.HasAmount() && amount.Sign() == 0 {
+				continue
+			}
+
+			leafHash, err := s.allowlistLeafHash(spec, entry, amount)
+			if err != nil {
+				return dto.Allowlist{}, fmt.Errorf("allowlistLeafHash: %w", err)
+			}
+
+			leaf := dto.AllowlistLeaf{
+				UserID:   entry.UserID,
+				WalletID: entry.WalletID,
+				Pubkey:   entry.Pubkey,
+				Provider: entry.Provider,
+			}
+			if spec.Encoding.HasAmount() {
+				leaf.Amount = amount.String()
+			}
+			leaves = append(leaves, leaf)
+			leafHashes = append(leafHashes, leafHash)
+		}
+
+		if len(entries) < walletSnapshotExportPageSize {
+			break
+		}
+		afterPubkey = entries[len(entries)-1].Pubkey
+	}
+
+	tree, err := merkle.Build(spec.Hash, leafHashes)
+	if err != nil {
+		return dto.Allowlist{}, fmt.Errorf("merkle.Build: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+
+	positions := make(map[string]int, len(tree.Levels[0]))
+	for position, leafHash := range tree.Levels[0] {
+		positions[string(leafHash)] = position
+	}
+	for i := range leaves {
+		leaves[i].Position = positions[string(leafHashes[i])]
+	}
+
+	var allowlist dto.Allowlist
+	if err = s.repo.Transaction(func(st repo.Repository) error {
+		if allowlist, err = st.CreateAllowlist(ctx, dto.Allowlist{
+			SnapshotID: spec.SnapshotID,
+			Label:      spec.Label,
+			Hash:       spec.Hash,
+			Encoding:   spec.Encoding,
+			Root:       "0x" + hex.EncodeToString(tree.Root()),
+			LeafCount:  len(leaves),
+		}, leaves, tree.Levels); err != nil {
+			return fmt.Errorf("st.CreateAllowlist: %w", err)
+		}
+		return nil
+	}); err != nil {
+		return dto.Allowlist{}, fmt.Errorf("repo.Transaction: %w", err)
+	}
+
+	return allowlist, nil
+}
+
+// parseAllocations returns the default allocation of spec and its allocations keyed by canonical pubkey.
+func (s *ServiceImpl) parseAllocations(spec dto.AllowlistSpec) (*big.Int, map[string]*big.Int, error) {
+	if !spec.Encoding.HasAmount() {
+		if spec.DefaultAmount != "" || len(spec.Allocations) != 0 {
+			return nil, nil, fmt.Errorf("leaf encoding %s takes no amounts: %w", spec.Encoding, svcerrs.ErrInvalidData)
+		}
+		return new(big.Int), nil, nil
+	}
+
+	defaultAmount := new(big.Int)
+	if spec.DefaultAmount != "" {
+		var err error
+		if defaultAmount, err = parseAmount(spec.DefaultAmount); err != nil {
+			return nil, nil, fmt.Errorf("parseAmount: %w", err)
+		}
+	}
+
+	allocations := make(map[string]*big.Int, len(spec.Allocations))
+	for pubkey, value := range spec.Allocations {
+		amount, err := parseAmount(value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parseAmount: %w", err)
+		}
+
+		normalized := s.chains.NormalizePubkey(pubkey)
+		if len(normalized) == 0 {
+			return nil, nil, fmt.Errorf("allocation pubkey %q is not a supported address: %w", pubkey, svcerrs.ErrInvalidData)
+		}
+		for _, canonical := range normalized {
+			if prev, ok := allocations[canonical]; ok && prev.Cmp(amount) != 0 {
+				return nil, nil, fmt.Errorf("conflicting allocations for %s: %w", canonical, svcerrs.ErrInvalidData)
+			}
+			allocations[canonical] = amount
+		}
+	}
+
+	return defaultAmount, allocations, nil
+}
+
+// parseAmount parses a non-negative decimal allocation.
+func parseAmount(value string) (*big.Int, error) {
+	amount, ok := new(big.Int).SetString(value, 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("amount %q must be a non-negative decimal integer: %w", value, svcerrs.ErrInvalidData)
+	}
+	return amount, nil
+}
+
+// allowlistLeafHash returns the leaf hash of a snapshot entry with the given allocation.
+func (s *ServiceImpl) allowlistLeafHash(spec dto.AllowlistSpec, entry dto.WalletSnapshotEntry, amount *big.Int) ([]byte, error) {
+	chain, err := s.chains.Get(entry.Provider)
+	if err != nil {
+		return nil, fmt.Errorf("chains.Get: %w", err)
+	}
+
+	address, err := chain.AddressBytes(entry.Pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("chain.AddressBytes: %w", err)
+	}
+
+	leafHash, err := merkle.Leaf(spec.Hash, spec.Encoding, address, amount)
+	if err != nil {
+		return nil, fmt.Errorf("merkle.Leaf: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+
+	return leafHash, nil
+}
+
+// GetAllowlist returns the allowlist with the given ID.
+//
+// If it does not exist, GetAllowlist returns an error wrapping svcerrs.ErrDataNotFound.
+func (s *ServiceImpl) GetAllowlist(ctx context.Context, allowlistID uint) (dto.Allowlist, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: GetAllowlist")
+	defer span.End()
+
+	allowlist, err := s.repo.GetAllowlist(ctx, allowlistID)
+	if err != nil {
+		return dto.Allowlist{}, fmt.Errorf("repo.GetAllowlist: %w", err)
+	}
+
+	return allowlist, nil
+}
+
+// GetAllowlistProof returns the inclusion proof of the verified wallet of the user in an allowlist.
+//
+// If the wallet of the user is unverified or its verification expired, GetAllowlistProof returns an error
+// wrapping svcerrs.ErrForbidden. If the allowlist, the wallet or its leaf does not exist, it returns
+// an error wrapping svcerrs.ErrDataNotFound.
+func (s *ServiceImpl) GetAllowlistProof(ctx context.Context, userID, allowlistID uint) (dto.AllowlistProof, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: GetAllowlistProof")
+	defer span.End()
+
+	allowlist, err := s.repo.GetAllowlist(ctx, allowlistID)
+	if err != nil {
+		return dto.AllowlistProof{}, fmt.Errorf("repo.GetAllowlist: %w", err)
+	}
+
+	wallet, err := s.repo.GetWallet(ctx, filters.WalletsFilter{UserID: userID})
+	if err != nil {
+		return dto.AllowlistProof{}, fmt.Errorf("repo.GetWallet: %w", err)
+	}
+	wallet = s.withVerificationStatus(wallet)
+	if wallet.VerificationStatus == enum.VerificationStatusUnverified || wallet.VerificationStatus == enum.VerificationStatusExpired {
+		return dto.AllowlistProof{}, fmt.Errorf("wallet is not verified: %w", svcerrs.ErrForbidden)
+	}
+
+	leaf, err := s.repo.GetAllowlistLeaf(ctx, allowlistID, wallet.Pubkey)
+	if err != nil {
+		return dto.AllowlistProof{}, fmt.Errorf("repo.GetAllowlistLeaf: %w", err)
+	}
+
+	nodes, err := s.repo.ListAllowlistNodes(ctx, allowlistID, merkle.ProofPositions(allowlist.LeafCount, leaf.Position))
+	if err != nil {
+		return dto.AllowlistProof{}, fmt.Errorf("repo.ListAllowlistNodes: %w", err)
+	}
+
+	proof := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		proof = append(proof, "0x"+hex.EncodeToString(node))
+	}
+
+	return dto.AllowlistProof{
+		Allowlist: allowlist,
+		Leaf:      leaf,
+		Proof:     proof,
+	}, nil
+}
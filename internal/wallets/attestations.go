@@ -0,0 +1,84 @@
+package wallets
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+
+	"wallets-service/internal/attestations"
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/filters"
+)
+
+// SetAttestationSigner enables minting wallet-ownership attestations with the given signer.
+func (s *ServiceImpl) SetAttestationSigner(signer *attestations.Signer) {
+	s.attestations = signer
+}
+
+// IssueWalletAttestation returns a signed attestation that the user owns their verified wallet.
+//
+// The attestation expires after the configured TTL, or when the verification of the wallet expires if that is
+// sooner. Errors:
+//   - svcerrs.ErrInvalidData if attestations are not configured;
+//   - svcerrs.ErrDataNotFound if the user has no wallet;
+//   - svcerrs.ErrForbidden if the wallet is unverified or its verification expired.
+func (s *ServiceImpl) IssueWalletAttestation(ctx context.Context, userID uint) (dto.WalletAttestation, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: IssueWalletAttestation")
+	defer span.End()
+
+	if s.attestations == nil {
+		return dto.WalletAttestation{}, fmt.Errorf("wallet attestations are not configured: %w", svcerrs.ErrInvalidData)
+	}
+
+	wallet, err := s.repo.GetWallet(ctx, filters.WalletsFilter{UserID: userID})
+	if err != nil {
+		return dto.WalletAttestation{}, fmt.Errorf("repo.GetWallet: %w", err)
+	}
+	wallet = s.withVerificationStatus(wallet)
+	if wallet.VerificationStatus == enum.VerificationStatusUnverified || wallet.VerificationStatus == enum.VerificationStatusExpired {
+		return dto.WalletAttestation{}, fmt.Errorf("wallet is not verified: %w", svcerrs.ErrForbidden)
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(s.attestations.TTL())
+	if wallet.VerificationExpiresAt != nil && wallet.VerificationExpiresAt.Before(expiresAt) {
+		expiresAt = *wallet.VerificationExpiresAt
+	}
+
+	token, err := s.attestations.Sign(attestations.WalletClaims{
+		Subject:    strconv.FormatUint(uint64(userID), 10),
+		UserID:     userID,
+		Pubkey:     wallet.Pubkey,
+		Provider:   wallet.Provider.String(),
+		VerifiedAt: wallet.VerifiedAt.Unix(),
+		IssuedAt:   now.Unix(),
+		ExpiresAt:  expiresAt.Unix(),
+	})
+	if err != nil {
+		return dto.WalletAttestation{}, fmt.Errorf("attestations.Sign: %w", err)
+	}
+
+	return dto.WalletAttestation{
+		Token:     token,
+		ExpiresAt: time.Unix(expiresAt.Unix(), 0).UTC(),
+	}, nil
+}
+
+// AttestationsEnabled reports whether an attestation signer is configured.
+func (s *ServiceImpl) AttestationsEnabled(_ context.Context) bool {
+	return s.attestations != nil
+}
+
+// AttestationKeySet returns the public keys attestations are verified with; it is empty if attestations
+// are not configured.
+func (s *ServiceImpl) AttestationKeySet(_ context.Context) attestations.KeySet {
+	if s.attestations == nil {
+		return attestations.KeySet{Keys: []attestations.JWK{}}
+	}
+	return s.attestations.KeySet()
+}
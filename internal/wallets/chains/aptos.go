@@ -0,0 +1,124 @@
+package chains
+
+import (
+	"crypto/ed25519"
+	"crypto/sha3"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+)
+
+// aptosSchemeEd25519 is the authentication key scheme of single-signer ed25519 accounts.
+const aptosSchemeEd25519 byte = 0x00
+
+// aptos verifies messages signed with the Aptos wallet standard signMessage feature.
+//
+// The dapp calls signMessage with the challenge text as message and the challenge ID as nonce,
+// without requesting address, application or chain ID, so the wallet signs
+// "APTOS\nmessage: <challenge text>\nnonce: <challenge ID>".
+type aptos struct{}
+
+func newAptos() *aptos {
+	return &aptos{}
+}
+
+// aptosSignature is the proof returned by the dapp: the account public key and the signMessage signature.
+type aptosSignature struct {
+	PublicKey string `json:"public_key"`
+	Signature string `json:"signature"`
+}
+
+// ValidatePubkey checks that pubkey is a canonical Aptos account address.
+func (a *aptos) ValidatePubkey(pubkey string) error {
+	if _, err := decodeMoveAddress(pubkey); err != nil {
+		return fmt.Errorf("decodeMoveAddress: %w", err)
+	}
+	return nil
+}
+
+// NormalizePubkey expands the address to its canonical long form.
+func (a *aptos) NormalizePubkey(pubkey string) (string, error) {
+	normalized, err := normalizeMoveAddress(pubkey)
+	if err != nil {
+		return "", fmt.Errorf("normalizeMoveAddress: %w", err)
+	}
+	return normalized, nil
+}
+
+// AddressBytes returns the 32-byte account address.
+func (a *aptos) AddressBytes(pubkey string) ([]byte, error) {
+	addrBytes, err := decodeMoveAddress(pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("decodeMoveAddress: %w", err)
+	}
+	return addrBytes, nil
+}
+
+// BuildMessage returns the challenge text the dapp has to pass to signMessage.
+func (a *aptos) BuildMessage(ch Challenge) (string, error) {
+	return challengeText(ch), nil
+}
+
+// VerifySignature checks the ed25519 signature of the full message and that the public key
+// derives the claimed account address.
+func (a *aptos) VerifySignature(ch Challenge, signature string) (Verification, error) {
+	if signature == "" {
+		return Verification{}, fmt.Errorf("signature is empty: %w", svcerrs.ErrInvalidData)
+	}
+
+	addrBytes, err := decodeMoveAddress(ch.Pubkey)
+	if err != nil {
+		return Verification{}, fmt.Errorf("decodeMoveAddress: %w", err)
+	}
+
+	var proof aptosSignature
+	if err = json.Unmarshal([]byte(signature), &proof); err != nil {
+		return Verification{}, fmt.Errorf("json.Unmarshal: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+
+	pubKeyBytes, err := decodeHexBytes(proof.PublicKey, ed25519.PublicKeySize)
+	if err != nil {
+		return Verification{}, fmt.Errorf("decodeHexBytes(public_key): %w", err)
+	}
+	sigBytes, err := decodeHexBytes(proof.Signature, ed25519.SignatureSize)
+	if err != nil {
+		return Verification{}, fmt.Errorf("decodeHexBytes(signature): %w", err)
+	}
+
+	// Accounts created from an ed25519 key use sha3-256(pubkey || scheme) as both
+	// authentication key and address. Rotated keys are not supported.
+	authKey := sha3.Sum256(append(slices.Clone(pubKeyBytes), aptosSchemeEd25519))
+	if !slices.Equal(authKey[:], addrBytes) {
+		return Verification{}, fmt.Errorf("pubkey does not match address: %w", svcerrs.ErrInvalidData)
+	}
+
+	msg := challengeText(ch)
+	fullMessage := []byte(aptosFullMessage(msg, ch.ID))
+	if !ed25519.Verify(pubKeyBytes, fullMessage, sigBytes) {
+		return Verification{}, fmt.Errorf("aptos signature is invalid: %w", svcerrs.ErrInvalidData)
+	}
+
+	return Verification{Encoding: SignatureEncodingJSON, Message: msg, SignedPayload: fullMessage, CheckedAt: ch.checkedAt()}, nil
+}
+
+// aptosFullMessage returns the message wallets actually sign for signMessage without optional fields.
+func aptosFullMessage(message, nonce string) string {
+	return "APTOS\nmessage: " + message + "\nnonce: " + nonce
+}
+
+// decodeHexBytes decodes a hex string with an optional 0x prefix and checks its length.
+func decodeHexBytes(s string, size int) ([]byte, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("hex.DecodeString: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+	if len(b) != size {
+		return nil, fmt.Errorf("invalid length: got %d, want %d: %w", len(b), size, svcerrs.ErrInvalidData)
+	}
+	return b, nil
+}
@@ -0,0 +1,53 @@
+package chains
+
+import (
+	"fmt"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/enum"
+)
+
+// Capabilities describes the message signing features of a Solana wallet provider.
+type Capabilities struct {
+	// SignMessage reports whether the wallet can sign arbitrary bytes with signMessage.
+	SignMessage bool
+	// SIWS reports whether the wallet understands Sign In With Solana messages.
+	SIWS bool
+	// OffchainMessageEnvelope reports whether the wallet only signs messages wrapped into
+	// the "\xffsolana offchain" envelope, as hardware wallets do.
+	OffchainMessageEnvelope bool
+}
+
+// solanaCapabilities is the capability table of the supported Solana wallet providers.
+var solanaCapabilities = map[enum.Provider]Capabilities{
+	enum.ProviderPhantom:        {SignMessage: true, SIWS: true},
+	enum.ProviderSolflare:       {SignMessage: true, SIWS: true},
+	enum.ProviderBackpack:       {SignMessage: true, SIWS: true},
+	enum.ProviderGlow:           {SignMessage: true},
+	enum.ProviderLedger:         {OffchainMessageEnvelope: true},
+	enum.ProviderWalletStandard: {SignMessage: true},
+}
+
+// MessageFormat chooses the challenge format for the provider from the capability table.
+//
+// Providers outside the table use enum.MessageFormatNative. SIWS is only chosen when a SIWS domain is configured.
+// If a Solana provider can sign neither plain nor enveloped messages, MessageFormat returns an error
+// wrapping svcerrs.ErrInvalidData.
+func (r *Registry) MessageFormat(provider enum.Provider) (enum.MessageFormat, error) {
+	caps, ok := r.capabilities[provider]
+	if !ok {
+		return enum.MessageFormatNative, nil
+	}
+
+	switch {
+	case caps.OffchainMessageEnvelope:
+		return enum.MessageFormatSolanaOffchain, nil
+	case caps.SIWS && r.siwsEnabled:
+		return enum.MessageFormatSIWS, nil
+	case caps.SignMessage:
+		return enum.MessageFormatText, nil
+	default:
+		return "", fmt.Errorf("provider %s cannot sign messages: %w", provider, svcerrs.ErrInvalidData)
+	}
+}
@@ -0,0 +1,170 @@
+package chains
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/config"
+	"wallets-service/internal/domain/enum"
+)
+
+const messageToSignToVerifyWallet = "Please, verify your wallet"
+
+// VerifierVersion identifies the signature verification rules of the chains. It is stored with every
+// verification proof; bump it whenever a chain starts accepting or rejecting different proofs.
+const VerifierVersion = "1"
+
+// Challenge carries the data a chain needs to build and check an ownership proof.
+type Challenge struct {
+	// ID is the challenge identifier returned to the client.
+	ID string
+	// Pubkey is the wallet address in the chain's native format.
+	Pubkey string
+	// Nonce is a random string to prevent replay/signature reuse.
+	Nonce string
+	// ExpiresAt is a unix timestamp (seconds) after which the challenge is invalid.
+	ExpiresAt int64
+	// Format is the message format chosen for the provider (see Registry.MessageFormat).
+	Format enum.MessageFormat
+	// Statement is the human-readable text the wallet owner agrees to by signing; empty means
+	// the wallet verification statement.
+	Statement string
+	// At is the time the proof is checked at, zero meaning now. Chains whose proofs carry
+	// time bounds validate them against it, which lets stored proofs be re-verified later.
+	At time.Time
+	// Stored marks a challenge rebuilt from a stored proof. Chains whose challenges are countersigned
+	// by the service then check the countersignature against the key recorded in the proof, since the
+	// service key may have been rotated since the proof was made.
+	Stored bool
+}
+
+// checkedAt returns the time the proof is checked at.
+func (ch Challenge) checkedAt() time.Time {
+	if ch.At.IsZero() {
+		return time.Now()
+	}
+	return ch.At
+}
+
+// Verification describes an accepted ownership proof.
+type Verification struct {
+	// Encoding is the format the signature was submitted in.
+	Encoding SignatureEncoding
+	// Message is the challenge message the signature answers, rebuilt exactly as BuildMessage issued it.
+	Message string
+	// SignedPayload is the exact byte string the signature covers, before any hashing the chain's
+	// signature scheme applies to it, so the proof can be checked without re-deriving the chain's wrapping.
+	SignedPayload []byte
+	// CheckedAt is the time the proof was checked at, so time bounds can be checked again at the same time later.
+	CheckedAt time.Time
+}
+
+// Chain builds and verifies wallet ownership proofs for a family of wallet providers.
+type Chain interface {
+	// ValidatePubkey checks that pubkey is a well-formed address for the chain.
+	ValidatePubkey(pubkey string) error
+	// NormalizePubkey returns the canonical form of an address the chain accepts in several spellings,
+	// e.g. as seen by an indexer, which is the form wallets are stored in.
+	//
+	// If pubkey is not an address of the chain, NormalizePubkey returns an error wrapping svcerrs.ErrInvalidData.
+	NormalizePubkey(pubkey string) (string, error)
+	// AddressBytes returns the raw bytes of an address as on-chain programs see it, e.g. to hash it
+	// into an allowlist leaf.
+	//
+	// If pubkey is not an address of the chain, AddressBytes returns an error wrapping svcerrs.ErrInvalidData.
+	AddressBytes(pubkey string) ([]byte, error)
+	// BuildMessage returns the payload the wallet has to sign for the challenge.
+	BuildMessage(ch Challenge) (string, error)
+	// VerifySignature checks that signature proves ownership of ch.Pubkey for the challenge
+	// and reports the encoding the signature was submitted in and the payload it covers.
+	//
+	// If the proof is malformed or invalid, VerifySignature returns an error wrapping svcerrs.ErrInvalidData.
+	VerifySignature(ch Challenge, signature string) (Verification, error)
+}
+
+// challengeText returns the human-readable challenge statement shared by chains that sign arbitrary text.
+func challengeText(ch Challenge) string {
+	return fmt.Sprintf(
+		"%s\n\nPubkey: %s\nChallengeId: %s\nNonce: %s\nExpiresAt: %d",
+		ch.statement(),
+		ch.Pubkey,
+		ch.ID,
+		ch.Nonce,
+		ch.ExpiresAt,
+	)
+}
+
+// statement returns the statement of the challenge, defaulting to the wallet verification statement.
+func (ch Challenge) statement() string {
+	if ch.Statement == "" {
+		return messageToSignToVerifyWallet
+	}
+	return ch.Statement
+}
+
+// Registry resolves the Chain used by a wallet provider.
+type Registry struct {
+	chains       map[enum.Provider]Chain
+	capabilities map[enum.Provider]Capabilities
+	siwsEnabled  bool
+}
+
+// NewRegistry constructs a Registry with every supported provider.
+func NewRegistry(cfg config.Config) *Registry {
+	r := &Registry{
+		chains: map[enum.Provider]Chain{
+			enum.ProviderStellar: newStellar(cfg.StellarConfig),
+			enum.ProviderCosmos:  newCosmos(cfg.CosmosConfig),
+			enum.ProviderAptos:   newAptos(),
+			enum.ProviderSui:     newSui(),
+		},
+		capabilities: solanaCapabilities,
+		siwsEnabled:  cfg.SolanaConfig.SIWSDomain != "",
+	}
+
+	solana := newSolana(cfg.SolanaConfig)
+	for provider := range solanaCapabilities {
+		r.chains[provider] = solana
+	}
+
+	return r
+}
+
+// Get returns the Chain for the provider.
+//
+// If the provider is not supported, Get returns an error wrapping svcerrs.ErrInvalidData.
+func (r *Registry) Get(provider enum.Provider) (Chain, error) {
+	chain, ok := r.chains[provider]
+	if !ok {
+		return nil, fmt.Errorf("unsupported provider %s: %w", provider, svcerrs.ErrInvalidData)
+	}
+	return chain, nil
+}
+
+// NormalizePubkey returns the canonical forms of pubkey under every chain it is an address of.
+//
+// Most chains use their own address format. The Move chains (Aptos and Sui) share the 0x-prefixed
+// 64 hex digit one and normalize it the same way, so their results are deduplicated. An empty result
+// means pubkey is not an address of any supported chain.
+func (r *Registry) NormalizePubkey(pubkey string) []string {
+	var normalized []string
+	seen := make(map[Chain]struct{}, len(r.chains))
+	for _, chain := range r.chains {
+		if _, ok := seen[chain]; ok {
+			continue
+		}
+		seen[chain] = struct{}{}
+
+		canonical, err := chain.NormalizePubkey(pubkey)
+		if err != nil || slices.Contains(normalized, canonical) {
+			continue
+		}
+		normalized = append(normalized, canonical)
+	}
+
+	slices.Sort(normalized)
+	return normalized
+}
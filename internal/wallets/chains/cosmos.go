@@ -0,0 +1,240 @@
+package chains
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cosmos/btcutil/bech32"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/knstch/knstch-libs/svcerrs"
+	"golang.org/x/crypto/ripemd160"
+
+	"wallets-service/config"
+)
+
+const (
+	cosmosAddressLen       = ripemd160.Size
+	cosmosSignatureLen     = 64
+	cosmosPubKeyTypeSecp   = "tendermint/PubKeySecp256k1"
+	cosmosMsgSignDataType  = "sign/MsgSignData"
+	cosmosBech32CharsLimit = 90
+)
+
+// cosmos verifies ADR-036 off-chain signatures produced by Keplr, Leap and compatible wallets.
+//
+// The wallet signs the challenge text with signArbitrary, which wraps it into an amino
+// sign/MsgSignData StdSignDoc with an empty chain ID, zero account number and sequence.
+type cosmos struct {
+	cfg config.CosmosConfig
+}
+
+func newCosmos(cfg config.CosmosConfig) *cosmos {
+	return &cosmos{
+		cfg: cfg,
+	}
+}
+
+// cosmosStdSignature is the StdSignature JSON returned by signArbitrary.
+type cosmosStdSignature struct {
+	PubKey struct {
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	} `json:"pub_key"`
+	Signature string `json:"signature"`
+}
+
+// The types below mirror the amino JSON sign doc. Fields are declared in alphabetical
+// order, so encoding/json produces the canonical sorted-key encoding.
+type cosmosSignDoc struct {
+	AccountNumber string          `json:"account_number"`
+	ChainID       string          `json:"chain_id"`
+	Fee           cosmosFee       `json:"fee"`
+	Memo          string          `json:"memo"`
+	Msgs          []cosmosSignMsg `json:"msgs"`
+	Sequence      string          `json:"sequence"`
+}
+
+type cosmosFee struct {
+	Amount []struct{} `json:"amount"`
+	Gas    string     `json:"gas"`
+}
+
+type cosmosSignMsg struct {
+	Type  string            `json:"type"`
+	Value cosmosMsgSignData `json:"value"`
+}
+
+type cosmosMsgSignData struct {
+	Data   string `json:"data"`
+	Signer string `json:"signer"`
+}
+
+// ValidatePubkey checks that pubkey is a bech32 account address with one of the configured prefixes.
+func (c *cosmos) ValidatePubkey(pubkey string) error {
+	if _, err := c.decodeAddress(pubkey); err != nil {
+		return fmt.Errorf("decodeAddress: %w", err)
+	}
+	return nil
+}
+
+// NormalizePubkey lower-cases the address, as bech32 also allows the all-uppercase form.
+func (c *cosmos) NormalizePubkey(pubkey string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(pubkey))
+	if _, err := c.decodeAddress(normalized); err != nil {
+		return "", fmt.Errorf("decodeAddress: %w", err)
+	}
+	return normalized, nil
+}
+
+// AddressBytes returns the bech32 data of the address, i.e. the account hash without the prefix.
+func (c *cosmos) AddressBytes(pubkey string) ([]byte, error) {
+	addrBytes, err := c.decodeAddress(pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("decodeAddress: %w", err)
+	}
+	return addrBytes, nil
+}
+
+// BuildMessage returns the challenge text the wallet has to pass to signArbitrary.
+func (c *cosmos) BuildMessage(ch Challenge) (string, error) {
+	return challengeText(ch), nil
+}
+
+// VerifySignature checks the StdSignature JSON returned by signArbitrary.
+//
+// The supplied public key must hash to the claimed address and the secp256k1 signature
+// must cover the ADR-036 sign doc built around the challenge text.
+func (c *cosmos) VerifySignature(ch Challenge, signature string) (Verification, error) {
+	if signature == "" {
+		return Verification{}, fmt.Errorf("signature is empty: %w", svcerrs.ErrInvalidData)
+	}
+
+	addrBytes, err := c.decodeAddress(ch.Pubkey)
+	if err != nil {
+		return Verification{}, fmt.Errorf("decodeAddress: %w", err)
+	}
+
+	var stdSig cosmosStdSignature
+	if err = json.Unmarshal([]byte(signature), &stdSig); err != nil {
+		return Verification{}, fmt.Errorf("json.Unmarshal: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+	if stdSig.PubKey.Type != cosmosPubKeyTypeSecp {
+		return Verification{}, fmt.Errorf("unsupported pubkey type %q: %w", stdSig.PubKey.Type, svcerrs.ErrInvalidData)
+	}
+
+	pubKeyBytes, err := base64.StdEncoding.DecodeString(stdSig.PubKey.Value)
+	if err != nil {
+		return Verification{}, fmt.Errorf("base64.StdEncoding.DecodeString: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+	// The Cosmos SDK only accepts compressed secp256k1 keys, which the address is derived from.
+	if len(pubKeyBytes) != secp256k1.PubKeyBytesLenCompressed {
+		return Verification{}, fmt.Errorf("invalid pubkey length: got %d, want %d: %w", len(pubKeyBytes), secp256k1.PubKeyBytesLenCompressed, svcerrs.ErrInvalidData)
+	}
+	pubKey, err := secp256k1.ParsePubKey(pubKeyBytes)
+	if err != nil {
+		return Verification{}, fmt.Errorf("secp256k1.ParsePubKey: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+	if !slices.Equal(cosmosAddressFromPubKey(pubKey), addrBytes) {
+		return Verification{}, fmt.Errorf("pubkey does not match address: %w", svcerrs.ErrInvalidData)
+	}
+
+	sigBytes, err := base64.StdEncoding.DecodeString(stdSig.Signature)
+	if err != nil {
+		return Verification{}, fmt.Errorf("base64.StdEncoding.DecodeString: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+	if len(sigBytes) != cosmosSignatureLen {
+		return Verification{}, fmt.Errorf("invalid signature length: got %d, want %d: %w", len(sigBytes), cosmosSignatureLen, svcerrs.ErrInvalidData)
+	}
+
+	var r, s secp256k1.ModNScalar
+	if overflow := r.SetByteSlice(sigBytes[:32]); overflow || r.IsZero() {
+		return Verification{}, fmt.Errorf("invalid signature R: %w", svcerrs.ErrInvalidData)
+	}
+	if overflow := s.SetByteSlice(sigBytes[32:]); overflow || s.IsZero() {
+		return Verification{}, fmt.Errorf("invalid signature S: %w", svcerrs.ErrInvalidData)
+	}
+	// Cosmos SDK only accepts low-S signatures to rule out malleability.
+	if s.IsOverHalfOrder() {
+		return Verification{}, fmt.Errorf("signature S is not normalized: %w", svcerrs.ErrInvalidData)
+	}
+
+	msg := challengeText(ch)
+	signDoc, err := buildCosmosSignDoc(ch.Pubkey, []byte(msg))
+	if err != nil {
+		return Verification{}, fmt.Errorf("buildCosmosSignDoc: %w", err)
+	}
+	hash := sha256.Sum256(signDoc)
+
+	if !ecdsa.NewSignature(&r, &s).Verify(hash[:], pubKey) {
+		return Verification{}, fmt.Errorf("cosmos signature is invalid: %w", svcerrs.ErrInvalidData)
+	}
+
+	return Verification{Encoding: SignatureEncodingJSON, Message: msg, SignedPayload: signDoc, CheckedAt: ch.checkedAt()}, nil
+}
+
+func (c *cosmos) decodeAddress(address string) ([]byte, error) {
+	if address == "" {
+		return nil, fmt.Errorf("pubkey is empty: %w", svcerrs.ErrInvalidData)
+	}
+
+	hrp, data, err := bech32.Decode(address, cosmosBech32CharsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("bech32.Decode: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+	// bech32 accepts all-uppercase strings, but only the canonical lowercase form is stored.
+	if address != strings.ToLower(address) {
+		return nil, fmt.Errorf("address must be lowercase: %w", svcerrs.ErrInvalidData)
+	}
+	if !slices.Contains(c.cfg.Bech32Prefixes, hrp) {
+		return nil, fmt.Errorf("unsupported bech32 prefix %q: %w", hrp, svcerrs.ErrInvalidData)
+	}
+
+	addrBytes, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, fmt.Errorf("bech32.ConvertBits: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+	if len(addrBytes) != cosmosAddressLen {
+		return nil, fmt.Errorf("invalid address length: got %d, want %d: %w", len(addrBytes), cosmosAddressLen, svcerrs.ErrInvalidData)
+	}
+
+	return addrBytes, nil
+}
+
+// cosmosAddressFromPubKey returns ripemd160(sha256(compressed pubkey)).
+func cosmosAddressFromPubKey(pubKey *secp256k1.PublicKey) []byte {
+	sha := sha256.Sum256(pubKey.SerializeCompressed())
+	hasher := ripemd160.New()
+	hasher.Write(sha[:])
+	return hasher.Sum(nil)
+}
+
+// buildCosmosSignDoc returns the ADR-036 amino JSON sign bytes for data signed by signer.
+func buildCosmosSignDoc(signer string, data []byte) ([]byte, error) {
+	signDoc, err := json.Marshal(cosmosSignDoc{
+		AccountNumber: "0",
+		ChainID:       "",
+		Fee: cosmosFee{
+			Amount: []struct{}{},
+			Gas:    "0",
+		},
+		Memo: "",
+		Msgs: []cosmosSignMsg{{
+			Type: cosmosMsgSignDataType,
+			Value: cosmosMsgSignData{
+				Data:   base64.StdEncoding.EncodeToString(data),
+				Signer: signer,
+			},
+		}},
+		Sequence: "0",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+	return signDoc, nil
+}
@@ -0,0 +1,129 @@
+package chains
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/mr-tron/base58"
+)
+
+// SignatureEncoding names the format a signature was submitted in.
+type SignatureEncoding string
+
+func (e SignatureEncoding) String() string {
+	return string(e)
+}
+
+const (
+	// SignatureEncodingBase58 is used by Phantom deeplinks and some Solana SDKs.
+	SignatureEncodingBase58 SignatureEncoding = "base58"
+	// SignatureEncodingHex is lowercase or uppercase hex, with or without the 0x prefix.
+	SignatureEncodingHex SignatureEncoding = "hex"
+	// SignatureEncodingBase64 is standard base64, with or without padding.
+	SignatureEncodingBase64 SignatureEncoding = "base64"
+	// SignatureEncodingBase64URL is URL-safe base64, with or without padding.
+	SignatureEncodingBase64URL SignatureEncoding = "base64url"
+	// SignatureEncodingByteArray is a JSON array of bytes, e.g. a serialized Uint8Array.
+	SignatureEncodingByteArray SignatureEncoding = "byte_array"
+	// SignatureEncodingJSON is a provider-specific JSON proof object.
+	SignatureEncodingJSON SignatureEncoding = "json"
+	// SignatureEncodingXDR is a base64 XDR transaction envelope.
+	SignatureEncodingXDR SignatureEncoding = "xdr"
+)
+
+type signatureDecoder struct {
+	encoding SignatureEncoding
+	decode   func(s string) ([]byte, error)
+}
+
+// signatureDecoders lists the textual encodings tried for signatures that are not JSON byte arrays.
+//
+// Base64 decoders are strict, so non-zero trailing bits are rejected instead of being silently dropped.
+var signatureDecoders = []signatureDecoder{
+	{encoding: SignatureEncodingHex, decode: hex.DecodeString},
+	{encoding: SignatureEncodingHex, decode: decodePrefixedHex},
+	{encoding: SignatureEncodingBase58, decode: base58.Decode},
+	{encoding: SignatureEncodingBase64, decode: base64.StdEncoding.Strict().DecodeString},
+	{encoding: SignatureEncodingBase64, decode: base64.RawStdEncoding.Strict().DecodeString},
+	{encoding: SignatureEncodingBase64URL, decode: base64.URLEncoding.Strict().DecodeString},
+	{encoding: SignatureEncodingBase64URL, decode: base64.RawURLEncoding.Strict().DecodeString},
+}
+
+// decodeSignature decodes a raw signature of exactly size bytes.
+//
+// JSON byte arrays are recognized by their prefix. Any other input is decoded with every supported
+// encoding and the results of the expected size are compared. If they disagree the input is ambiguous
+// and decodeSignature returns an error wrapping svcerrs.ErrInvalidData.
+func decodeSignature(signature string, size int) ([]byte, SignatureEncoding, error) {
+	signature = strings.TrimSpace(signature)
+	if signature == "" {
+		return nil, "", fmt.Errorf("signature is empty: %w", svcerrs.ErrInvalidData)
+	}
+
+	if strings.HasPrefix(signature, "[") {
+		sigBytes, err := decodeByteArray(signature)
+		if err != nil {
+			return nil, "", fmt.Errorf("decodeByteArray: %w", err)
+		}
+		if len(sigBytes) != size {
+			return nil, "", fmt.Errorf("invalid signature length: got %d, want %d: %w", len(sigBytes), size, svcerrs.ErrInvalidData)
+		}
+		return sigBytes, SignatureEncodingByteArray, nil
+	}
+
+	var (
+		sigBytes []byte
+		encoding SignatureEncoding
+	)
+	for _, decoder := range signatureDecoders {
+		decoded, err := decoder.decode(signature)
+		if err != nil || len(decoded) != size {
+			continue
+		}
+		if sigBytes == nil {
+			sigBytes, encoding = decoded, decoder.encoding
+			continue
+		}
+		// Standard and URL-safe base64 agree on inputs without "+/-_", which is not ambiguous.
+		if !bytes.Equal(sigBytes, decoded) {
+			return nil, "", fmt.Errorf("signature is ambiguous: valid as both %s and %s, resubmit it as 0x-prefixed hex or padded base64: %w",
+				encoding, decoder.encoding, svcerrs.ErrInvalidData)
+		}
+	}
+	if sigBytes == nil {
+		return nil, "", fmt.Errorf("signature is not a base58, hex, base64 or byte array encoding of %d bytes: %w", size, svcerrs.ErrInvalidData)
+	}
+
+	return sigBytes, encoding, nil
+}
+
+// decodePrefixedHex decodes hex with a 0x or 0X prefix.
+func decodePrefixedHex(s string) ([]byte, error) {
+	if !strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X") {
+		return nil, errors.New("missing 0x prefix")
+	}
+	return hex.DecodeString(s[2:])
+}
+
+// decodeByteArray decodes a JSON array of integers in [0, 255].
+func decodeByteArray(s string) ([]byte, error) {
+	var values []int
+	if err := json.Unmarshal([]byte(s), &values); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+
+	out := make([]byte, len(values))
+	for i, v := range values {
+		if v < 0 || v > 255 {
+			return nil, fmt.Errorf("byte array value %d at index %d is out of range: %w", v, i, svcerrs.ErrInvalidData)
+		}
+		out[i] = byte(v)
+	}
+	return out, nil
+}
@@ -0,0 +1,54 @@
+package chains
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+)
+
+// moveAddressLen is the length of an Aptos/Sui account address in bytes.
+const moveAddressLen = 32
+
+// decodeMoveAddress decodes a Move-ecosystem account address.
+//
+// Only the canonical long form (0x followed by 64 lowercase hex characters) is accepted,
+// so the same account can't be linked twice under different spellings.
+func decodeMoveAddress(address string) ([]byte, error) {
+	if address == "" {
+		return nil, fmt.Errorf("pubkey is empty: %w", svcerrs.ErrInvalidData)
+	}
+	if !strings.HasPrefix(address, "0x") || len(address) != 2+2*moveAddressLen || address != strings.ToLower(address) {
+		return nil, fmt.Errorf("address must be 0x followed by %d lowercase hex characters: %w", 2*moveAddressLen, svcerrs.ErrInvalidData)
+	}
+
+	addrBytes, err := hex.DecodeString(address[2:])
+	if err != nil {
+		return nil, fmt.Errorf("hex.DecodeString: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+
+	return addrBytes, nil
+}
+
+// normalizeMoveAddress returns the canonical long form of a Move-ecosystem account address
+// given in any case and in the short form without leading zeros, e.g. 0x1.
+func normalizeMoveAddress(address string) (string, error) {
+	address = strings.ToLower(strings.TrimSpace(address))
+	if !strings.HasPrefix(address, "0x") {
+		return "", fmt.Errorf("address must start with 0x: %w", svcerrs.ErrInvalidData)
+	}
+
+	digits := address[2:]
+	if digits == "" || len(digits) > 2*moveAddressLen {
+		return "", fmt.Errorf("address must have 1 to %d hex characters: %w", 2*moveAddressLen, svcerrs.ErrInvalidData)
+	}
+
+	normalized := "0x" + strings.Repeat("0", 2*moveAddressLen-len(digits)) + digits
+	if _, err := decodeMoveAddress(normalized); err != nil {
+		return "", fmt.Errorf("decodeMoveAddress: %w", err)
+	}
+
+	return normalized, nil
+}
@@ -0,0 +1,208 @@
+package chains
+
+import (
+	"crypto/ed25519"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/mr-tron/base58"
+
+	"wallets-service/config"
+	"wallets-service/internal/domain/enum"
+)
+
+const (
+	// solanaOffchainSigningDomain prefixes every Solana off-chain message so it can never be a valid transaction.
+	solanaOffchainSigningDomain = "\xffsolana offchain"
+	solanaOffchainHeaderVersion = 0
+	// solanaOffchainMaxLedgerLen is the longest message Ledger devices can sign in a single packet.
+	solanaOffchainMaxLedgerLen = 1212
+)
+
+// Solana off-chain message formats.
+const (
+	solanaOffchainRestrictedASCII byte = iota
+	solanaOffchainLimitedUTF8
+	solanaOffchainExtendedUTF8
+)
+
+// solana verifies messages signed with the Wallet Standard signMessage feature.
+//
+// Depending on the provider capabilities the signed message is the plain challenge text,
+// a Sign In With Solana message, or the challenge text wrapped into an off-chain message envelope.
+type solana struct {
+	cfg config.SolanaConfig
+}
+
+func newSolana(cfg config.SolanaConfig) *solana {
+	return &solana{
+		cfg: cfg,
+	}
+}
+
+// ValidatePubkey checks that pubkey is a base58-encoded ed25519 public key.
+func (s *solana) ValidatePubkey(pubkey string) error {
+	_, err := decodeSolanaPubkey(pubkey)
+	return err
+}
+
+// NormalizePubkey re-encodes the decoded key, which drops redundant leading zero digits.
+func (s *solana) NormalizePubkey(pubkey string) (string, error) {
+	pubKeyBytes, err := decodeSolanaPubkey(strings.TrimSpace(pubkey))
+	if err != nil {
+		return "", fmt.Errorf("decodeSolanaPubkey: %w", err)
+	}
+	return base58.Encode(pubKeyBytes), nil
+}
+
+// AddressBytes returns the 32-byte ed25519 public key.
+func (s *solana) AddressBytes(pubkey string) ([]byte, error) {
+	pubKeyBytes, err := decodeSolanaPubkey(pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("decodeSolanaPubkey: %w", err)
+	}
+	return pubKeyBytes, nil
+}
+
+// BuildMessage returns the human-readable message the wallet has to sign.
+//
+// For enum.MessageFormatSolanaOffchain it is the text the wallet wraps into the envelope before signing.
+func (s *solana) BuildMessage(ch Challenge) (string, error) {
+	switch ch.Format {
+	case enum.MessageFormatNative, enum.MessageFormatText, enum.MessageFormatSolanaOffchain:
+		return challengeText(ch), nil
+	case enum.MessageFormatSIWS:
+		if s.cfg.SIWSDomain == "" {
+			return "", fmt.Errorf("SIWS domain is not configured: %w", svcerrs.ErrInvalidData)
+		}
+		// The SIWS statement is a single line of the message.
+		if strings.Contains(ch.Statement, "\n") {
+			return "", fmt.Errorf("SIWS statement must be a single line: %w", svcerrs.ErrInvalidData)
+		}
+		return s.siwsMessage(ch), nil
+	default:
+		return "", fmt.Errorf("unsupported message format %q: %w", ch.Format, svcerrs.ErrInvalidData)
+	}
+}
+
+// VerifySignature checks the ed25519 signature of the challenge message.
+//
+// The signature may be submitted in any encoding accepted by decodeSignature.
+func (s *solana) VerifySignature(ch Challenge, signature string) (Verification, error) {
+	msg, err := s.BuildMessage(ch)
+	if err != nil {
+		return Verification{}, fmt.Errorf("BuildMessage: %w", err)
+	}
+
+	signed := []byte(msg)
+	if ch.Format == enum.MessageFormatSolanaOffchain {
+		if signed, err = solanaOffchainEnvelope(signed); err != nil {
+			return Verification{}, fmt.Errorf("solanaOffchainEnvelope: %w", err)
+		}
+	}
+
+	verified, encoding, err := verifySolanaSignMessage(ch.Pubkey, signed, signature)
+	if err != nil {
+		return Verification{}, fmt.Errorf("verifySolanaSignMessage: %w", err)
+	}
+	if !verified {
+		return Verification{}, fmt.Errorf("solana signature is invalid: %w", svcerrs.ErrInvalidData)
+	}
+
+	return Verification{Encoding: encoding, Message: msg, SignedPayload: signed, CheckedAt: ch.checkedAt()}, nil
+}
+
+// siwsMessage returns the ABNF message text defined by the Sign In With Solana specification.
+func (s *solana) siwsMessage(ch Challenge) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s wants you to sign in with your Solana account:\n%s", s.cfg.SIWSDomain, ch.Pubkey)
+	fmt.Fprintf(&b, "\n\n%s", ch.statement())
+
+	b.WriteString("\n")
+	if s.cfg.SIWSURI != "" {
+		fmt.Fprintf(&b, "\nURI: %s", s.cfg.SIWSURI)
+	}
+	b.WriteString("\nVersion: 1")
+	if s.cfg.ChainID != "" {
+		fmt.Fprintf(&b, "\nChain ID: %s", s.cfg.ChainID)
+	}
+	fmt.Fprintf(&b, "\nNonce: %s", ch.Nonce)
+	fmt.Fprintf(&b, "\nExpiration Time: %s", time.Unix(ch.ExpiresAt, 0).UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "\nRequest ID: %s", ch.ID)
+
+	return b.String()
+}
+
+// solanaOffchainEnvelope serializes msg as a version 0 Solana off-chain message:
+// signing domain || version || format || u16 LE length || message.
+func solanaOffchainEnvelope(msg []byte) ([]byte, error) {
+	if len(msg) == 0 || len(msg) > math.MaxUint16 {
+		return nil, fmt.Errorf("invalid off-chain message length %d: %w", len(msg), svcerrs.ErrInvalidData)
+	}
+
+	format := solanaOffchainExtendedUTF8
+	switch {
+	case isRestrictedASCII(msg) && len(msg) <= solanaOffchainMaxLedgerLen:
+		format = solanaOffchainRestrictedASCII
+	case utf8.Valid(msg) && len(msg) <= solanaOffchainMaxLedgerLen:
+		format = solanaOffchainLimitedUTF8
+	}
+
+	envelope := make([]byte, 0, len(solanaOffchainSigningDomain)+4+len(msg))
+	envelope = append(envelope, solanaOffchainSigningDomain...)
+	envelope = append(envelope, solanaOffchainHeaderVersion, format)
+	envelope = binary.LittleEndian.AppendUint16(envelope, uint16(len(msg)))
+	envelope = append(envelope, msg...)
+	return envelope, nil
+}
+
+// isRestrictedASCII reports whether msg only contains printable ASCII characters.
+func isRestrictedASCII(msg []byte) bool {
+	for _, c := range msg {
+		if c < 0x20 || c > 0x7e {
+			return false
+		}
+	}
+	return true
+}
+
+func decodeSolanaPubkey(pubkey string) (ed25519.PublicKey, error) {
+	if pubkey == "" {
+		return nil, fmt.Errorf("pubkey is empty: %w", svcerrs.ErrInvalidData)
+	}
+
+	pubKeyBytes, err := base58.Decode(pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("base58.Decode: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+	if len(pubKeyBytes) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid pubkey length: got %d, want %d: %w", len(pubKeyBytes), ed25519.PublicKeySize, svcerrs.ErrInvalidData)
+	}
+
+	return pubKeyBytes, nil
+}
+
+func verifySolanaSignMessage(pubkey string, messageToSign []byte, signature string) (bool, SignatureEncoding, error) {
+	if len(messageToSign) == 0 {
+		return false, "", fmt.Errorf("message is empty: %w", svcerrs.ErrInvalidData)
+	}
+
+	pubKeyBytes, err := decodeSolanaPubkey(pubkey)
+	if err != nil {
+		return false, "", fmt.Errorf("decodeSolanaPubkey: %w", err)
+	}
+
+	sigBytes, encoding, err := decodeSignature(signature, ed25519.SignatureSize)
+	if err != nil {
+		return false, "", fmt.Errorf("decodeSignature: %w", err)
+	}
+
+	ok := ed25519.Verify(pubKeyBytes, messageToSign, sigBytes)
+	return ok, encoding, nil
+}
@@ -0,0 +1,236 @@
+package chains
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/config"
+)
+
+const (
+	stellarBaseFee = 100
+
+	stellarWebAuthDomainKey = "web_auth_domain"
+)
+
+// stellar implements SEP-10 challenge transactions.
+//
+// The challenge is a transaction with sequence number 0 signed by the server account. Its first
+// manage_data operation has the client account as source, "<home domain> auth" as name and the
+// base64-encoded nonce as value. The wallet co-signs the transaction and returns the envelope XDR,
+// which is never submitted to the network.
+type stellar struct {
+	cfg config.StellarConfig
+}
+
+func newStellar(cfg config.StellarConfig) *stellar {
+	return &stellar{
+		cfg: cfg,
+	}
+}
+
+// ValidatePubkey checks that pubkey is a G-address.
+func (s *stellar) ValidatePubkey(pubkey string) error {
+	if _, err := decodeStrkey(strkeyVersionAccountID, pubkey); err != nil {
+		return fmt.Errorf("decodeStrkey: %w", err)
+	}
+	return nil
+}
+
+// NormalizePubkey upper-cases the G-address, as strkeys are case-insensitive base32.
+func (s *stellar) NormalizePubkey(pubkey string) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(pubkey))
+	if _, err := decodeStrkey(strkeyVersionAccountID, normalized); err != nil {
+		return "", fmt.Errorf("decodeStrkey: %w", err)
+	}
+	return normalized, nil
+}
+
+// AddressBytes returns the 32-byte ed25519 public key encoded in the G-address.
+func (s *stellar) AddressBytes(pubkey string) ([]byte, error) {
+	pubKeyBytes, err := decodeStrkey(strkeyVersionAccountID, pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("decodeStrkey: %w", err)
+	}
+	return pubKeyBytes, nil
+}
+
+// BuildMessage returns the base64-encoded XDR of the server-signed challenge transaction.
+//
+// SEP-10 wallets display the transaction rather than a text, so challenges with a custom statement are rejected.
+func (s *stellar) BuildMessage(ch Challenge) (string, error) {
+	if ch.Statement != "" {
+		return "", fmt.Errorf("stellar challenges cannot carry a statement: %w", svcerrs.ErrInvalidData)
+	}
+
+	serverKey, err := s.serverKey()
+	if err != nil {
+		return "", fmt.Errorf("serverKey: %w", err)
+	}
+	serverPub := serverKey.Public().(ed25519.PublicKey)
+
+	clientPub, err := decodeStrkey(strkeyVersionAccountID, ch.Pubkey)
+	if err != nil {
+		return "", fmt.Errorf("decodeStrkey: %w", err)
+	}
+
+	tx := stellarTx{
+		Source:  serverPub,
+		Fee:     stellarBaseFee * 2,
+		SeqNum:  0,
+		MinTime: uint64(time.Now().Unix()),
+		MaxTime: uint64(ch.ExpiresAt),
+		Operations: []stellarManageDataOp{
+			{
+				Source: clientPub,
+				Name:   s.authDataName(),
+				Value:  encodeStellarNonce(ch.Nonce),
+			},
+			{
+				Source: serverPub,
+				Name:   stellarWebAuthDomainKey,
+				Value:  []byte(s.cfg.WebAuthDomain),
+			},
+		},
+	}
+
+	txBytes := encodeStellarTx(tx)
+	hash := stellarTxHash(s.cfg.NetworkPassphrase, txBytes)
+	envelope := encodeStellarEnvelope(txBytes, []stellarSignature{{
+		Hint:      signatureHint(serverPub),
+		Signature: ed25519.Sign(serverKey, hash[:]),
+	}})
+
+	return base64.StdEncoding.EncodeToString(envelope), nil
+}
+
+// VerifySignature decodes the co-signed challenge transaction and validates it according to SEP-10.
+func (s *stellar) VerifySignature(ch Challenge, signature string) (Verification, error) {
+	if signature == "" {
+		return Verification{}, fmt.Errorf("signature is empty: %w", svcerrs.ErrInvalidData)
+	}
+
+	clientPub, err := decodeStrkey(strkeyVersionAccountID, ch.Pubkey)
+	if err != nil {
+		return Verification{}, fmt.Errorf("decodeStrkey: %w", err)
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return Verification{}, fmt.Errorf("base64.StdEncoding.DecodeString: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+
+	envelope, err := decodeStellarEnvelope(raw)
+	if err != nil {
+		return Verification{}, fmt.Errorf("decodeStellarEnvelope: %w", err)
+	}
+	tx := envelope.Tx
+
+	// A stored proof was countersigned with the server key of its time, which the transaction source records.
+	serverPub := tx.Source
+	if !ch.Stored {
+		serverKey, err := s.serverKey()
+		if err != nil {
+			return Verification{}, fmt.Errorf("serverKey: %w", err)
+		}
+		serverPub = serverKey.Public().(ed25519.PublicKey)
+	}
+
+	if !bytes.Equal(tx.Source, serverPub) {
+		return Verification{}, fmt.Errorf("transaction source is not the server account: %w", svcerrs.ErrInvalidData)
+	}
+	if tx.SeqNum != 0 {
+		return Verification{}, fmt.Errorf("transaction sequence number must be 0: %w", svcerrs.ErrInvalidData)
+	}
+
+	checkedAt := ch.checkedAt()
+	now := uint64(checkedAt.Unix())
+	if tx.MaxTime != uint64(ch.ExpiresAt) {
+		return Verification{}, fmt.Errorf("transaction time bounds do not match challenge: %w", svcerrs.ErrInvalidData)
+	}
+	if now < tx.MinTime || now > tx.MaxTime {
+		return Verification{}, fmt.Errorf("transaction is outside of its time bounds: %w", svcerrs.ErrInvalidData)
+	}
+
+	if len(tx.Operations) == 0 {
+		return Verification{}, fmt.Errorf("transaction has no operations: %w", svcerrs.ErrInvalidData)
+	}
+
+	authOp := tx.Operations[0]
+	if !bytes.Equal(authOp.Source, clientPub) {
+		return Verification{}, fmt.Errorf("first operation source is not the client account: %w", svcerrs.ErrInvalidData)
+	}
+	if authOp.Name != s.authDataName() {
+		return Verification{}, fmt.Errorf("home domain mismatch: %w", svcerrs.ErrInvalidData)
+	}
+	if !bytes.Equal(authOp.Value, encodeStellarNonce(ch.Nonce)) {
+		return Verification{}, fmt.Errorf("nonce mismatch: %w", svcerrs.ErrInvalidData)
+	}
+
+	for _, op := range tx.Operations[1:] {
+		if !bytes.Equal(op.Source, serverPub) {
+			return Verification{}, fmt.Errorf("operation %q has unexpected source: %w", op.Name, svcerrs.ErrInvalidData)
+		}
+		if op.Name == stellarWebAuthDomainKey && string(op.Value) != s.cfg.WebAuthDomain {
+			return Verification{}, fmt.Errorf("web auth domain mismatch: %w", svcerrs.ErrInvalidData)
+		}
+	}
+
+	payload := stellarSignaturePayload(s.cfg.NetworkPassphrase, envelope.txBytes)
+	hash := sha256.Sum256(payload)
+
+	var serverSig *stellarSignature
+	var clientSigned bool
+	for _, sig := range envelope.Signatures {
+		switch {
+		case serverSig == nil && sig.Hint == signatureHint(serverPub) && ed25519.Verify(serverPub, hash[:], sig.Signature):
+			serverSig = &sig
+		case !clientSigned && sig.Hint == signatureHint(clientPub) && ed25519.Verify(clientPub, hash[:], sig.Signature):
+			clientSigned = true
+		default:
+			return Verification{}, fmt.Errorf("transaction has unrecognized signatures: %w", svcerrs.ErrInvalidData)
+		}
+	}
+	if serverSig == nil {
+		return Verification{}, fmt.Errorf("transaction is not signed by the server: %w", svcerrs.ErrInvalidData)
+	}
+	if !clientSigned {
+		return Verification{}, fmt.Errorf("stellar signature is invalid: %w", svcerrs.ErrInvalidData)
+	}
+
+	// The issued challenge is the same transaction carrying the server signature only.
+	issued := encodeStellarEnvelope(envelope.txBytes, []stellarSignature{*serverSig})
+
+	return Verification{
+		Encoding:      SignatureEncodingXDR,
+		Message:       base64.StdEncoding.EncodeToString(issued),
+		SignedPayload: payload,
+		CheckedAt:     checkedAt,
+	}, nil
+}
+
+func (s *stellar) authDataName() string {
+	return s.cfg.HomeDomain + " auth"
+}
+
+func (s *stellar) serverKey() (ed25519.PrivateKey, error) {
+	seed, err := decodeStrkey(strkeyVersionSeed, s.cfg.SigningSeed)
+	if err != nil {
+		// Don't wrap: a broken server key is a configuration error, not invalid client input.
+		return nil, fmt.Errorf("invalid stellar signing seed: %v", err)
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// encodeStellarNonce encodes the challenge nonce the way SEP-10 expects it: 48 random bytes, base64-encoded.
+func encodeStellarNonce(nonce string) []byte {
+	return []byte(base64.StdEncoding.EncodeToString([]byte(nonce)))
+}
@@ -0,0 +1,84 @@
+package chains
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/stretchr/testify/require"
+
+	"wallets-service/config"
+)
+
+func testStellarConfig(t *testing.T) config.StellarConfig {
+	_, serverKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	return config.StellarConfig{
+		SigningSeed:       encodeStrkey(strkeyVersionSeed, serverKey.Seed()),
+		NetworkPassphrase: "Test SDF Network ; September 2015",
+		HomeDomain:        "wallets.test",
+		WebAuthDomain:     "auth.wallets.test",
+	}
+}
+
+// cosignStellarChallenge adds the client signature to the issued challenge the way a wallet does.
+func cosignStellarChallenge(t *testing.T, cfg config.StellarConfig, clientKey ed25519.PrivateKey, challenge string) string {
+	raw, err := base64.StdEncoding.DecodeString(challenge)
+	require.NoError(t, err)
+	envelope, err := decodeStellarEnvelope(raw)
+	require.NoError(t, err)
+
+	hash := sha256.Sum256(stellarSignaturePayload(cfg.NetworkPassphrase, envelope.txBytes))
+	clientPub := clientKey.Public().(ed25519.PublicKey)
+	signatures := append(envelope.Signatures, stellarSignature{
+		Hint:      signatureHint(clientPub),
+		Signature: ed25519.Sign(clientKey, hash[:]),
+	})
+
+	return base64.StdEncoding.EncodeToString(encodeStellarEnvelope(envelope.txBytes, signatures))
+}
+
+func TestStellarVerifySignature_StoredProofAfterKeyRotation(t *testing.T) {
+	cfg := testStellarConfig(t)
+	clientPub, clientKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	issuedAt := time.Now()
+	ch := Challenge{
+		ID:        "challenge",
+		Pubkey:    encodeStrkey(strkeyVersionAccountID, clientPub),
+		Nonce:     "nonce",
+		ExpiresAt: issuedAt.Add(time.Minute).Unix(),
+	}
+	message, err := newStellar(cfg).BuildMessage(ch)
+	require.NoError(t, err)
+	signature := cosignStellarChallenge(t, cfg, clientKey, message)
+
+	verification, err := newStellar(cfg).VerifySignature(ch, signature)
+	require.NoError(t, err)
+	require.Equal(t, message, verification.Message)
+
+	rotated := testStellarConfig(t)
+
+	// Live challenges must be countersigned with the current key.
+	_, err = newStellar(rotated).VerifySignature(ch, signature)
+	require.ErrorIs(t, err, svcerrs.ErrInvalidData)
+
+	// Stored proofs are checked against the key they were countersigned with, at the time they were verified.
+	ch.Stored = true
+	ch.At = verification.CheckedAt
+	stored, err := newStellar(rotated).VerifySignature(ch, signature)
+	require.NoError(t, err)
+	require.Equal(t, message, stored.Message)
+	require.Equal(t, verification.SignedPayload, stored.SignedPayload)
+
+	// The time bounds still apply.
+	ch.At = issuedAt.Add(2 * time.Minute)
+	_, err = newStellar(rotated).VerifySignature(ch, signature)
+	require.ErrorIs(t, err, svcerrs.ErrInvalidData)
+}
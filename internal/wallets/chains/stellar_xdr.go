@@ -0,0 +1,376 @@
+package chains
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+)
+
+// This file contains the minimal subset of Stellar XDR and strkey encoding needed for SEP-10
+// challenge transactions: a V1 transaction envelope with time bounds, no memo and manage_data operations.
+
+const (
+	strkeyVersionAccountID byte = 6 << 3
+	strkeyVersionSeed      byte = 18 << 3
+
+	xdrEnvelopeTypeTx   = 2
+	xdrKeyTypeEd25519   = 0
+	xdrPrecondTime      = 1
+	xdrMemoNone         = 0
+	xdrOpTypeManageData = 10
+
+	xdrMaxSignatures = 20
+	xdrMaxOperations = 100
+	xdrMaxDataName   = 64
+	xdrMaxDataValue  = 64
+)
+
+var stellarBase32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func crc16XModem(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+func encodeStrkey(version byte, payload []byte) string {
+	raw := make([]byte, 0, 1+len(payload)+2)
+	raw = append(raw, version)
+	raw = append(raw, payload...)
+	raw = binary.LittleEndian.AppendUint16(raw, crc16XModem(raw))
+	return stellarBase32.EncodeToString(raw)
+}
+
+func decodeStrkey(version byte, s string) ([]byte, error) {
+	raw, err := stellarBase32.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("base32.DecodeString: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+	if len(raw) != 1+ed25519.PublicKeySize+2 {
+		return nil, fmt.Errorf("invalid strkey length: %w", svcerrs.ErrInvalidData)
+	}
+	if raw[0] != version {
+		return nil, fmt.Errorf("invalid strkey version byte: %w", svcerrs.ErrInvalidData)
+	}
+
+	body, checksum := raw[:len(raw)-2], raw[len(raw)-2:]
+	if crc16XModem(body) != binary.LittleEndian.Uint16(checksum) {
+		return nil, fmt.Errorf("invalid strkey checksum: %w", svcerrs.ErrInvalidData)
+	}
+
+	return body[1:], nil
+}
+
+type stellarManageDataOp struct {
+	// Source is the operation source account; nil means the transaction source account.
+	Source ed25519.PublicKey
+	Name   string
+	// Value is nil when the data entry is deleted.
+	Value []byte
+}
+
+type stellarSignature struct {
+	Hint      [4]byte
+	Signature []byte
+}
+
+type stellarTx struct {
+	Source     ed25519.PublicKey
+	Fee        uint32
+	SeqNum     int64
+	MinTime    uint64
+	MaxTime    uint64
+	Operations []stellarManageDataOp
+}
+
+type stellarEnvelope struct {
+	Tx         stellarTx
+	Signatures []stellarSignature
+
+	// txBytes is the XDR encoding of Tx exactly as received, used for hashing.
+	txBytes []byte
+}
+
+// stellarTxHash returns the hash signed by every transaction signer.
+func stellarTxHash(networkPassphrase string, txBytes []byte) [32]byte {
+	return sha256.Sum256(stellarSignaturePayload(networkPassphrase, txBytes))
+}
+
+// stellarSignaturePayload returns the TransactionSignaturePayload whose sha256 the transaction signers sign.
+func stellarSignaturePayload(networkPassphrase string, txBytes []byte) []byte {
+	networkID := sha256.Sum256([]byte(networkPassphrase))
+
+	payload := make([]byte, 0, len(networkID)+4+len(txBytes))
+	payload = append(payload, networkID[:]...)
+	payload = binary.BigEndian.AppendUint32(payload, xdrEnvelopeTypeTx)
+	return append(payload, txBytes...)
+}
+
+func signatureHint(pub ed25519.PublicKey) [4]byte {
+	var hint [4]byte
+	copy(hint[:], pub[len(pub)-4:])
+	return hint
+}
+
+type xdrWriter struct {
+	buf bytes.Buffer
+}
+
+func (w *xdrWriter) uint32(v uint32) {
+	w.buf.Write(binary.BigEndian.AppendUint32(nil, v))
+}
+
+func (w *xdrWriter) uint64(v uint64) {
+	w.buf.Write(binary.BigEndian.AppendUint64(nil, v))
+}
+
+func (w *xdrWriter) fixed(b []byte) {
+	w.buf.Write(b)
+	if pad := (4 - len(b)%4) % 4; pad > 0 {
+		w.buf.Write(make([]byte, pad))
+	}
+}
+
+func (w *xdrWriter) opaque(b []byte) {
+	w.uint32(uint32(len(b)))
+	w.fixed(b)
+}
+
+func (w *xdrWriter) account(pub ed25519.PublicKey) {
+	w.uint32(xdrKeyTypeEd25519)
+	w.fixed(pub)
+}
+
+func encodeStellarTx(tx stellarTx) []byte {
+	var w xdrWriter
+
+	w.account(tx.Source)
+	w.uint32(tx.Fee)
+	w.uint64(uint64(tx.SeqNum))
+	w.uint32(xdrPrecondTime)
+	w.uint64(tx.MinTime)
+	w.uint64(tx.MaxTime)
+	w.uint32(xdrMemoNone)
+
+	w.uint32(uint32(len(tx.Operations)))
+	for _, op := range tx.Operations {
+		if op.Source != nil {
+			w.uint32(1)
+			w.account(op.Source)
+		} else {
+			w.uint32(0)
+		}
+		w.uint32(xdrOpTypeManageData)
+		w.opaque([]byte(op.Name))
+		if op.Value != nil {
+			w.uint32(1)
+			w.opaque(op.Value)
+		} else {
+			w.uint32(0)
+		}
+	}
+
+	// Transaction extension, always v0.
+	w.uint32(0)
+
+	return w.buf.Bytes()
+}
+
+func encodeStellarEnvelope(txBytes []byte, signatures []stellarSignature) []byte {
+	var w xdrWriter
+
+	w.uint32(xdrEnvelopeTypeTx)
+	w.buf.Write(txBytes)
+	w.uint32(uint32(len(signatures)))
+	for _, sig := range signatures {
+		w.fixed(sig.Hint[:])
+		w.opaque(sig.Signature)
+	}
+
+	return w.buf.Bytes()
+}
+
+type xdrReader struct {
+	data []byte
+	pos  int
+	err  error
+}
+
+func (r *xdrReader) fail(format string, args ...any) {
+	if r.err == nil {
+		r.err = fmt.Errorf(format+": %w", append(args, svcerrs.ErrInvalidData)...)
+	}
+}
+
+func (r *xdrReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || len(r.data)-r.pos < n {
+		r.fail("unexpected end of xdr at offset %d", r.pos)
+		return nil
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b
+}
+
+func (r *xdrReader) uint32() uint32 {
+	b := r.next(4)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(b)
+}
+
+func (r *xdrReader) uint64() uint64 {
+	b := r.next(8)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+func (r *xdrReader) fixed(n int) []byte {
+	b := r.next(n)
+	if pad := (4 - n%4) % 4; pad > 0 {
+		for _, p := range r.next(pad) {
+			if p != 0 {
+				r.fail("non-zero xdr padding")
+			}
+		}
+	}
+	return b
+}
+
+func (r *xdrReader) opaque(maxLen int) []byte {
+	n := r.uint32()
+	if r.err == nil && n > uint32(maxLen) {
+		r.fail("xdr opaque length %d exceeds %d", n, maxLen)
+		return nil
+	}
+	return r.fixed(int(n))
+}
+
+func (r *xdrReader) account() ed25519.PublicKey {
+	if keyType := r.uint32(); r.err == nil && keyType != xdrKeyTypeEd25519 {
+		r.fail("unsupported account key type %d", keyType)
+		return nil
+	}
+	return r.fixed(ed25519.PublicKeySize)
+}
+
+func (r *xdrReader) optional() bool {
+	switch flag := r.uint32(); flag {
+	case 0:
+		return false
+	case 1:
+		return true
+	default:
+		r.fail("invalid xdr optional flag %d", flag)
+		return false
+	}
+}
+
+func decodeStellarTx(r *xdrReader) stellarTx {
+	var tx stellarTx
+
+	tx.Source = r.account()
+	tx.Fee = r.uint32()
+	tx.SeqNum = int64(r.uint64())
+
+	if precond := r.uint32(); r.err == nil && precond != xdrPrecondTime {
+		r.fail("unsupported preconditions type %d", precond)
+		return tx
+	}
+	tx.MinTime = r.uint64()
+	tx.MaxTime = r.uint64()
+
+	if memo := r.uint32(); r.err == nil && memo != xdrMemoNone {
+		r.fail("unsupported memo type %d", memo)
+		return tx
+	}
+
+	opsCount := r.uint32()
+	if r.err == nil && opsCount > xdrMaxOperations {
+		r.fail("too many operations: %d", opsCount)
+		return tx
+	}
+	for i := uint32(0); i < opsCount && r.err == nil; i++ {
+		var op stellarManageDataOp
+		if r.optional() {
+			op.Source = r.account()
+		}
+		if opType := r.uint32(); r.err == nil && opType != xdrOpTypeManageData {
+			r.fail("unsupported operation type %d", opType)
+			return tx
+		}
+		op.Name = string(r.opaque(xdrMaxDataName))
+		if r.optional() {
+			op.Value = r.opaque(xdrMaxDataValue)
+		}
+		tx.Operations = append(tx.Operations, op)
+	}
+
+	if ext := r.uint32(); r.err == nil && ext != 0 {
+		r.fail("unsupported transaction extension %d", ext)
+	}
+
+	return tx
+}
+
+func decodeStellarEnvelope(data []byte) (stellarEnvelope, error) {
+	r := &xdrReader{data: data}
+
+	if envType := r.uint32(); r.err == nil && envType != xdrEnvelopeTypeTx {
+		return stellarEnvelope{}, fmt.Errorf("unsupported envelope type %d: %w", envType, svcerrs.ErrInvalidData)
+	}
+
+	start := r.pos
+	tx := decodeStellarTx(r)
+	txBytes := r.data[start:r.pos]
+
+	sigCount := r.uint32()
+	if r.err == nil && sigCount > xdrMaxSignatures {
+		r.fail("too many signatures: %d", sigCount)
+	}
+	if r.err != nil {
+		return stellarEnvelope{}, r.err
+	}
+
+	signatures := make([]stellarSignature, 0, min(sigCount, xdrMaxSignatures))
+	for i := uint32(0); i < sigCount && r.err == nil; i++ {
+		var sig stellarSignature
+		copy(sig.Hint[:], r.fixed(4))
+		sig.Signature = r.opaque(ed25519.SignatureSize)
+		signatures = append(signatures, sig)
+	}
+
+	if r.err == nil && r.pos != len(r.data) {
+		r.fail("trailing bytes after envelope")
+	}
+	if r.err != nil {
+		return stellarEnvelope{}, r.err
+	}
+
+	return stellarEnvelope{
+		Tx:         tx,
+		Signatures: signatures,
+		txBytes:    txBytes,
+	}, nil
+}
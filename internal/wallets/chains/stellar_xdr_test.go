@@ -0,0 +1,82 @@
+package chains
+
+import (
+	"crypto/ed25519"
+	"encoding/binary"
+	"testing"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/stretchr/testify/require"
+)
+
+func testStellarTx() stellarTx {
+	return stellarTx{
+		Source:  make(ed25519.PublicKey, ed25519.PublicKeySize),
+		Fee:     100,
+		SeqNum:  1,
+		MaxTime: 300,
+		Operations: []stellarManageDataOp{
+			{Name: "wallets.test auth", Value: []byte("nonce")},
+		},
+	}
+}
+
+func TestDecodeStellarEnvelope_RoundTrip(t *testing.T) {
+	txBytes := encodeStellarTx(testStellarTx())
+	sig := stellarSignature{Hint: [4]byte{1, 2, 3, 4}, Signature: make([]byte, ed25519.SignatureSize)}
+
+	envelope, err := decodeStellarEnvelope(encodeStellarEnvelope(txBytes, []stellarSignature{sig}))
+	require.NoError(t, err)
+	require.Equal(t, txBytes, envelope.txBytes)
+	require.Equal(t, []stellarSignature{sig}, envelope.Signatures)
+	require.Equal(t, "wallets.test auth", envelope.Tx.Operations[0].Name)
+}
+
+func TestDecodeStellarEnvelope_Malformed(t *testing.T) {
+	txBytes := encodeStellarTx(testStellarTx())
+	valid := encodeStellarEnvelope(txBytes, nil)
+
+	// Offsets inside the envelope: type (4), source account (4+32), fee (4), seq (8), preconditions (4+8+8), memo (4).
+	const opsCountOffset = 4 + 36 + 4 + 8 + 20 + 4
+	const opNameLenOffset = opsCountOffset + 4 + 4 + 4
+
+	withUint32 := func(data []byte, offset int, v uint32) []byte {
+		out := append([]byte(nil), data...)
+		binary.BigEndian.PutUint32(out[offset:], v)
+		return out
+	}
+
+	tests := map[string][]byte{
+		"empty":                    nil,
+		"truncated":                valid[:len(valid)-3],
+		"trailing bytes":           append(append([]byte(nil), valid...), 0, 0, 0, 0),
+		"wrong envelope type":      withUint32(valid, 0, 0),
+		"oversized signatures":     withUint32(valid, len(valid)-4, 0xFFFFFFFF),
+		"too many signatures":      withUint32(valid, len(valid)-4, xdrMaxSignatures+1),
+		"missing signatures":       withUint32(valid, len(valid)-4, 2),
+		"oversized operations":     withUint32(valid, opsCountOffset, 0xFFFFFFFF),
+		"too many operations":      withUint32(valid, opsCountOffset, xdrMaxOperations+1),
+		"oversized opaque length":  withUint32(valid, opNameLenOffset, 0xFFFFFFFF),
+		"opaque length over limit": withUint32(valid, opNameLenOffset, xdrMaxDataName+1),
+		"opaque past end":          withUint32(valid, opNameLenOffset, xdrMaxDataName),
+	}
+
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := decodeStellarEnvelope(data)
+			require.ErrorIs(t, err, svcerrs.ErrInvalidData)
+		})
+	}
+}
+
+func TestDecodeStellarEnvelope_OversizedSignatureOpaque(t *testing.T) {
+	txBytes := encodeStellarTx(testStellarTx())
+	sig := stellarSignature{Signature: make([]byte, ed25519.SignatureSize)}
+	data := encodeStellarEnvelope(txBytes, []stellarSignature{sig})
+
+	// The signature length follows the 4-byte hint of the only signature.
+	binary.BigEndian.PutUint32(data[len(data)-ed25519.SignatureSize-4:], 0xFFFFFFFF)
+
+	_, err := decodeStellarEnvelope(data)
+	require.ErrorIs(t, err, svcerrs.ErrInvalidData)
+}
@@ -0,0 +1,111 @@
+package chains
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"golang.org/x/crypto/blake2b"
+)
+
+const (
+	suiFlagEd25519 byte = 0x00
+
+	// suiSerializedSignatureLen is flag || ed25519 signature || ed25519 public key.
+	suiSerializedSignatureLen = 1 + ed25519.SignatureSize + ed25519.PublicKeySize
+)
+
+// suiPersonalMessageIntent is IntentScope::PersonalMessage, IntentVersion::V0, AppId::Sui.
+var suiPersonalMessageIntent = []byte{3, 0, 0}
+
+// sui verifies messages signed with the Sui wallet standard signPersonalMessage feature.
+type sui struct{}
+
+func newSui() *sui {
+	return &sui{}
+}
+
+// ValidatePubkey checks that pubkey is a canonical Sui account address.
+func (s *sui) ValidatePubkey(pubkey string) error {
+	if _, err := decodeMoveAddress(pubkey); err != nil {
+		return fmt.Errorf("decodeMoveAddress: %w", err)
+	}
+	return nil
+}
+
+// NormalizePubkey expands the address to its canonical long form.
+func (s *sui) NormalizePubkey(pubkey string) (string, error) {
+	normalized, err := normalizeMoveAddress(pubkey)
+	if err != nil {
+		return "", fmt.Errorf("normalizeMoveAddress: %w", err)
+	}
+	return normalized, nil
+}
+
+// AddressBytes returns the 32-byte account address.
+func (s *sui) AddressBytes(pubkey string) ([]byte, error) {
+	addrBytes, err := decodeMoveAddress(pubkey)
+	if err != nil {
+		return nil, fmt.Errorf("decodeMoveAddress: %w", err)
+	}
+	return addrBytes, nil
+}
+
+// BuildMessage returns the challenge text the dapp has to pass to signPersonalMessage.
+func (s *sui) BuildMessage(ch Challenge) (string, error) {
+	return challengeText(ch), nil
+}
+
+// VerifySignature checks the base64 serialized signature returned by signPersonalMessage.
+//
+// Only the ed25519 scheme is supported. The embedded public key must derive the claimed address
+// and the signature must cover blake2b-256(intent || bcs(message)).
+func (s *sui) VerifySignature(ch Challenge, signature string) (Verification, error) {
+	if signature == "" {
+		return Verification{}, fmt.Errorf("signature is empty: %w", svcerrs.ErrInvalidData)
+	}
+
+	addrBytes, err := decodeMoveAddress(ch.Pubkey)
+	if err != nil {
+		return Verification{}, fmt.Errorf("decodeMoveAddress: %w", err)
+	}
+
+	serialized, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return Verification{}, fmt.Errorf("base64.StdEncoding.DecodeString: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+	if len(serialized) == 0 || serialized[0] != suiFlagEd25519 {
+		return Verification{}, fmt.Errorf("unsupported signature scheme: %w", svcerrs.ErrInvalidData)
+	}
+	if len(serialized) != suiSerializedSignatureLen {
+		return Verification{}, fmt.Errorf("invalid signature length: got %d, want %d: %w", len(serialized), suiSerializedSignatureLen, svcerrs.ErrInvalidData)
+	}
+
+	sigBytes := serialized[1 : 1+ed25519.SignatureSize]
+	pubKeyBytes := serialized[1+ed25519.SignatureSize:]
+
+	address := blake2b.Sum256(append([]byte{suiFlagEd25519}, pubKeyBytes...))
+	if !slices.Equal(address[:], addrBytes) {
+		return Verification{}, fmt.Errorf("pubkey does not match address: %w", svcerrs.ErrInvalidData)
+	}
+
+	msg := challengeText(ch)
+	intentMsg := suiPersonalMessage([]byte(msg))
+	digest := blake2b.Sum256(intentMsg)
+	if !ed25519.Verify(pubKeyBytes, digest[:], sigBytes) {
+		return Verification{}, fmt.Errorf("sui signature is invalid: %w", svcerrs.ErrInvalidData)
+	}
+
+	return Verification{Encoding: SignatureEncodingBase64, Message: msg, SignedPayload: intentMsg, CheckedAt: ch.checkedAt()}, nil
+}
+
+// suiPersonalMessage returns the intent message wrapping msg as a BCS vector<u8>; wallets sign its blake2b-256.
+func suiPersonalMessage(msg []byte) []byte {
+	intentMsg := slices.Clone(suiPersonalMessageIntent)
+	intentMsg = binary.AppendUvarint(intentMsg, uint64(len(msg)))
+	return append(intentMsg, msg...)
+}
@@ -0,0 +1,215 @@
+package wallets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/knstch/knstch-libs/log"
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/metrics"
+	"wallets-service/internal/wallets/chains"
+	"wallets-service/internal/wallets/utils"
+)
+
+const (
+	// nonceLen matches the 48-byte nonce SEP-10 requires, so the same nonce fits every chain.
+	nonceLen = 48
+
+	challengeExpirationPeriod = time.Minute * 15
+)
+
+// Challenge purposes. A challenge can only be redeemed by the operation it was issued for.
+const (
+	challengePurposeVerify   = ""
+	challengePurposeReclaim  = "reclaim"
+	challengePurposeTransfer = "transfer"
+	challengePurposeUnlink   = "unlink"
+	// challengePurposeOwnershipPrefix namespaces the purposes of ownership challenges requested by other
+	// services, so they never match a built-in purpose.
+	challengePurposeOwnershipPrefix = "ownership:"
+)
+
+// newChallenge validates the pubkey for the provider and builds a challenge for the given purpose.
+// A non-zero referenceID binds the challenge to a record of that purpose, e.g. a wallet transfer.
+//
+// It returns the challenge for the user and the JSON payload to be stored under GetChallengeByIDKey.
+func (s *ServiceImpl) newChallenge(userID uint, pubkey string, provider enum.Provider, purpose string, referenceID uint) (dto.ChallengeForUser, []byte, error) {
+	return s.newStatementChallenge(userID, pubkey, provider, purpose, referenceID, "")
+}
+
+// newStatementChallenge is newChallenge with a custom statement in place of the wallet verification one.
+func (s *ServiceImpl) newStatementChallenge(userID uint, pubkey string, provider enum.Provider, purpose string, referenceID uint, statement string) (dto.ChallengeForUser, []byte, error) {
+	chain, err := s.chains.Get(provider)
+	if err != nil {
+		return dto.ChallengeForUser{}, nil, fmt.Errorf("chains.Get: %w", err)
+	}
+	if err = chain.ValidatePubkey(pubkey); err != nil {
+		return dto.ChallengeForUser{}, nil, fmt.Errorf("chain.ValidatePubkey: %w", err)
+	}
+
+	format, err := s.chains.MessageFormat(provider)
+	if err != nil {
+		return dto.ChallengeForUser{}, nil, fmt.Errorf("chains.MessageFormat: %w", err)
+	}
+
+	challengeID := uuid.New()
+	expiresAt := time.Now().Add(challengeExpirationPeriod)
+	nonce, err := utils.RandomString(nonceLen)
+	if err != nil {
+		return dto.ChallengeForUser{}, nil, fmt.Errorf("utils.RandomString: %w", err)
+	}
+
+	msg, err := chain.BuildMessage(chains.Challenge{
+		ID:        challengeID.String(),
+		Pubkey:    pubkey,
+		Nonce:     nonce,
+		ExpiresAt: expiresAt.Unix(),
+		Format:    format,
+		Statement: statement,
+	})
+	if err != nil {
+		return dto.ChallengeForUser{}, nil, fmt.Errorf("chain.BuildMessage: %w", err)
+	}
+
+	challenge := &Challenge{
+		UserID:      userID,
+		PubKey:      pubkey,
+		Provider:    provider.String(),
+		Nonce:       nonce,
+		ExpiresAt:   expiresAt.Unix(),
+		Format:      format.String(),
+		Purpose:     purpose,
+		ReferenceID: referenceID,
+		Statement:   statement,
+		Message:     msg,
+	}
+
+	jsonChallenge, err := json.Marshal(challenge)
+	if err != nil {
+		return dto.ChallengeForUser{}, nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	return dto.ChallengeForUser{
+		ChallengeID:   challengeID.String(),
+		MessageToSign: msg,
+		MessageFormat: format,
+	}, jsonChallenge, nil
+}
+
+// storeChallenge saves the challenge payload and indexes it by pubkey, so it can be invalidated
+// when the wallet changes hands.
+func (s *ServiceImpl) storeChallenge(ctx context.Context, challengeID, pubkey string, jsonChallenge []byte) error {
+	if _, err := s.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, GetChallengeByIDKey(challengeID), jsonChallenge, challengeExpirationPeriod)
+		pipe.SAdd(ctx, GetChallengesByPubkeyKey(pubkey), challengeID)
+		pipe.Expire(ctx, GetChallengesByPubkeyKey(pubkey), challengeExpirationPeriod)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("redis.TxPipelined: %w", err)
+	}
+	return nil
+}
+
+// invalidateChallenges deletes every outstanding challenge issued for the pubkey, whatever its purpose.
+func (s *ServiceImpl) invalidateChallenges(ctx context.Context, pubkey string) error {
+	challengeIDs, err := s.redis.SMembers(ctx, GetChallengesByPubkeyKey(pubkey)).Result()
+	if err != nil {
+		return fmt.Errorf("redis.SMembers: %w", err)
+	}
+
+	keys := make([]string, 0, len(challengeIDs)+1)
+	for _, challengeID := range challengeIDs {
+		keys = append(keys, GetChallengeByIDKey(challengeID))
+	}
+	keys = append(keys, GetChallengesByPubkeyKey(pubkey))
+
+	if err = s.redis.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("redis.Del: %w", err)
+	}
+	return nil
+}
+
+// loadChallenge reads a challenge issued to the user for the given purpose and checks that it has not expired.
+//
+// Challenges of other users or purposes are reported as svcerrs.ErrDataNotFound.
+func (s *ServiceImpl) loadChallenge(ctx context.Context, userID uint, challengeID, purpose string) (Challenge, error) {
+	challengeFromDB, err := s.redis.Get(ctx, GetChallengeByIDKey(challengeID)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return Challenge{}, fmt.Errorf("challenge not found: %w", svcerrs.ErrDataNotFound)
+		}
+		return Challenge{}, fmt.Errorf("redis.Get: %w", err)
+	}
+
+	var challenge Challenge
+	if err = json.Unmarshal([]byte(challengeFromDB), &challenge); err != nil {
+		return Challenge{}, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	if challenge.UserID != userID || challenge.Purpose != purpose {
+		return Challenge{}, fmt.Errorf("challenge not found: %w", svcerrs.ErrDataNotFound)
+	}
+	if challenge.ExpiresAt != 0 && time.Now().Unix() > challenge.ExpiresAt {
+		return Challenge{}, fmt.Errorf("challenge expired: %w", svcerrs.ErrDataNotFound)
+	}
+
+	return challenge, nil
+}
+
+// verifyChallenge checks the signature against the challenge with the chain of its provider.
+//
+// It returns the provider of the challenge and the accepted verification, whose signature encoding
+// is also recorded on the current span and in metrics.
+func (s *ServiceImpl) verifyChallenge(ctx context.Context, challengeID string, challenge Challenge, signature string) (enum.Provider, chains.Verification, error) {
+	provider, err := enum.GetProvider(challenge.Provider)
+	if err != nil {
+		return "", chains.Verification{}, fmt.Errorf("enum.GetProvider: %w", err)
+	}
+
+	chain, err := s.chains.Get(provider)
+	if err != nil {
+		return "", chains.Verification{}, fmt.Errorf("chains.Get: %w", err)
+	}
+
+	verification, err := chain.VerifySignature(chains.Challenge{
+		ID:        challengeID,
+		Pubkey:    challenge.PubKey,
+		Nonce:     challenge.Nonce,
+		ExpiresAt: challenge.ExpiresAt,
+		Format:    enum.MessageFormat(challenge.Format),
+		Statement: challenge.Statement,
+	}, signature)
+	if err != nil {
+		return "", chains.Verification{}, fmt.Errorf("chain.VerifySignature: %w", err)
+	}
+
+	trace.SpanFromContext(ctx).SetAttributes(
+		attribute.String("wallets.provider", provider.String()),
+		attribute.String("wallets.signature_encoding", verification.Encoding.String()),
+	)
+	metrics.IncSignatureEncoding(provider.String(), verification.Encoding.String())
+
+	return provider, verification, nil
+}
+
+// deleteChallenge removes a redeemed challenge.
+//
+// We don't want to fail the operation after the DB update if Redis deletion fails,
+// but we also don't want to silently ignore the error.
+func (s *ServiceImpl) deleteChallenge(ctx context.Context, challengeID string) {
+	if err := s.redis.Del(ctx, GetChallengeByIDKey(challengeID)).Err(); err != nil && !errors.Is(err, redis.Nil) {
+		s.lg.Error("redis.Del(challenge) failed", err,
+			log.AddMessage("challenge_id", challengeID),
+		)
+	}
+}
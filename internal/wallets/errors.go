@@ -0,0 +1,34 @@
+package wallets
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+)
+
+// Errors with their own code in the message, so clients can tell them apart from other svcerrs.ErrForbidden errors.
+var (
+	// ErrRelinkCooldown is returned when a recently unlinked pubkey is added by an account other than its previous owner.
+	ErrRelinkCooldown = fmt.Errorf("relink_cooldown: %w", svcerrs.ErrForbidden)
+	// ErrLinkChurnLimit is returned when a user linked and unlinked wallets too often within the churn window.
+	ErrLinkChurnLimit = fmt.Errorf("link_churn_limit_exceeded: %w", svcerrs.ErrForbidden)
+)
+
+// Machine-readable codes of the errors above, returned to clients next to the error message.
+const (
+	ErrorCodeRelinkCooldown = "RELINK_COOLDOWN"
+	ErrorCodeLinkChurnLimit = "LINK_CHURN_LIMIT"
+)
+
+// ErrorCode returns the machine-readable code of err, or an empty string if it has none.
+func ErrorCode(err error) string {
+	switch {
+	case errors.Is(err, ErrRelinkCooldown):
+		return ErrorCodeRelinkCooldown
+	case errors.Is(err, ErrLinkChurnLimit):
+		return ErrorCodeLinkChurnLimit
+	default:
+		return ""
+	}
+}
@@ -1,6 +1,8 @@
 package filters
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 
 	"wallets-service/internal/domain/enum"
@@ -13,12 +15,18 @@ import (
 // - nil: don't filter by verification status
 // - true: only verified wallets
 // - false: only unverified wallets
+//
+// UserIDs and Pubkeys match wallets of any of the given users or pubkeys. AfterID matches wallets with a greater ID,
+// for paging in ID order.
 type WalletsFilter struct {
 	ID         uint
 	UserID     uint
+	UserIDs    []uint
 	Pubkey     string
+	Pubkeys    []string
 	Provider   enum.Provider
 	IsVerified *bool
+	AfterID    uint
 }
 
 // BoolPtr is a tiny helper to get a *bool for filters.
@@ -37,6 +45,10 @@ func (w *WalletsFilter) ToScope() func(*gorm.DB) *gorm.DB {
 			tx = tx.Where("user_id = ?", w.UserID)
 		}
 
+		if len(w.UserIDs) != 0 {
+			tx = tx.Where("user_id IN ?", w.UserIDs)
+		}
+
 		if w.Provider != "" {
 			tx = tx.Where("provider = ?", w.Provider.String())
 		}
@@ -45,6 +57,10 @@ func (w *WalletsFilter) ToScope() func(*gorm.DB) *gorm.DB {
 			tx = tx.Where("pubkey = ?", w.Pubkey)
 		}
 
+		if len(w.Pubkeys) != 0 {
+			tx = tx.Where("pubkey IN ?", w.Pubkeys)
+		}
+
 		if w.IsVerified != nil {
 			if *w.IsVerified {
 				tx = tx.Where("verified_at IS NOT NULL")
@@ -53,6 +69,236 @@ func (w *WalletsFilter) ToScope() func(*gorm.DB) *gorm.DB {
 			}
 		}
 
+		if w.AfterID != 0 {
+			tx = tx.Where("id > ?", w.AfterID)
+		}
+
+		return tx
+	}
+}
+
+// ReclaimsFilter defines query parameters for selecting wallet reclaims.
+//
+// Zero values mean "no filter". ParticipantID matches reclaims where the user is either the
+// current owner or the claimant.
+type ReclaimsFilter struct {
+	ID            uint
+	Pubkey        string
+	FromUserID    uint
+	ToUserID      uint
+	ParticipantID uint
+	Status        enum.ReclaimStatus
+}
+
+// ToScope converts the filter to a GORM scope.
+func (r *ReclaimsFilter) ToScope() func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Model(&models.WalletReclaims{})
+
+		if r.ID != 0 {
+			tx = tx.Where("id = ?", r.ID)
+		}
+
+		if r.Pubkey != "" {
+			tx = tx.Where("pubkey = ?", r.Pubkey)
+		}
+
+		if r.FromUserID != 0 {
+			tx = tx.Where("from_user_id = ?", r.FromUserID)
+		}
+
+		if r.ToUserID != 0 {
+			tx = tx.Where("to_user_id = ?", r.ToUserID)
+		}
+
+		if r.ParticipantID != 0 {
+			tx = tx.Where("from_user_id = ? OR to_user_id = ?", r.ParticipantID, r.ParticipantID)
+		}
+
+		if r.Status != "" {
+			tx = tx.Where("status = ?", r.Status.String())
+		}
+
+		return tx
+	}
+}
+
+// TransfersFilter defines query parameters for selecting wallet transfers.
+//
+// Zero values mean "no filter". ParticipantID matches transfers where the user is either side,
+// and Statuses matches any of the listed statuses.
+type TransfersFilter struct {
+	ID            uint
+	WalletID      uint
+	FromUserID    uint
+	ToUserID      uint
+	ParticipantID uint
+	Statuses      []enum.TransferStatus
+}
+
+// ToScope converts the filter to a GORM scope.
+func (t *TransfersFilter) ToScope() func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Model(&models.WalletTransfers{})
+
+		if t.ID != 0 {
+			tx = tx.Where("id = ?", t.ID)
+		}
+
+		if t.WalletID != 0 {
+			tx = tx.Where("wallet_id = ?", t.WalletID)
+		}
+
+		if t.FromUserID != 0 {
+			tx = tx.Where("from_user_id = ?", t.FromUserID)
+		}
+
+		if t.ToUserID != 0 {
+			tx = tx.Where("to_user_id = ?", t.ToUserID)
+		}
+
+		if t.ParticipantID != 0 {
+			tx = tx.Where("from_user_id = ? OR to_user_id = ?", t.ParticipantID, t.ParticipantID)
+		}
+
+		if len(t.Statuses) != 0 {
+			statuses := make([]string, 0, len(t.Statuses))
+			for _, status := range t.Statuses {
+				statuses = append(statuses, status.String())
+			}
+			tx = tx.Where("status IN ?", statuses)
+		}
+
+		return tx
+	}
+}
+
+// LinkEventsFilter defines query parameters for selecting wallet link events.
+//
+// Zero values mean "no filter". Since matches events created at or after the given time.
+type LinkEventsFilter struct {
+	UserID uint
+	Pubkey string
+	Action enum.LinkAction
+	Since  time.Time
+}
+
+// ToScope converts the filter to a GORM scope.
+func (l *LinkEventsFilter) ToScope() func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Model(&models.WalletLinkEvents{})
+
+		if l.UserID != 0 {
+			tx = tx.Where("user_id = ?", l.UserID)
+		}
+
+		if l.Pubkey != "" {
+			tx = tx.Where("pubkey = ?", l.Pubkey)
+		}
+
+		if l.Action != "" {
+			tx = tx.Where("action = ?", l.Action.String())
+		}
+
+		if !l.Since.IsZero() {
+			tx = tx.Where("created_at >= ?", l.Since)
+		}
+
+		return tx
+	}
+}
+
+// WalletEventsFilter defines query parameters for selecting wallet events.
+//
+// Zero values mean "no filter". BeforeID matches events with a smaller ID, for paging newest first.
+type WalletEventsFilter struct {
+	UserID   uint
+	WalletID uint
+	Pubkey   string
+	BeforeID uint
+}
+
+// ToScope converts the filter to a GORM scope.
+func (w *WalletEventsFilter) ToScope() func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Model(&models.WalletEvents{})
+
+		if w.UserID != 0 {
+			tx = tx.Where("user_id = ?", w.UserID)
+		}
+
+		if w.WalletID != 0 {
+			tx = tx.Where("wallet_id = ?", w.WalletID)
+		}
+
+		if w.Pubkey != "" {
+			tx = tx.Where("pubkey = ?", w.Pubkey)
+		}
+
+		if w.BeforeID != 0 {
+			tx = tx.Where("id < ?", w.BeforeID)
+		}
+
+		return tx
+	}
+}
+
+// WebhookDeliveriesFilter defines query parameters for selecting webhook deliveries.
+//
+// Zero values mean "no filter".
+type WebhookDeliveriesFilter struct {
+	SubscriptionID uint
+	Status         enum.WebhookDeliveryStatus
+}
+
+// ToScope converts the filter to a GORM scope.
+func (w *WebhookDeliveriesFilter) ToScope() func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Model(&models.WebhookDeliveries{})
+
+		if w.SubscriptionID != 0 {
+			tx = tx.Where("subscription_id = ?", w.SubscriptionID)
+		}
+
+		if w.Status != "" {
+			tx = tx.Where("status = ?", w.Status.String())
+		}
+
+		return tx
+	}
+}
+
+// VerificationProofsFilter defines query parameters for selecting wallet verification proofs.
+//
+// Zero values mean "no filter". AfterID matches proofs with a greater ID, for paging in ID order.
+type VerificationProofsFilter struct {
+	WalletID uint
+	UserID   uint
+	Pubkey   string
+	AfterID  uint
+}
+
+// ToScope converts the filter to a GORM scope.
+func (v *VerificationProofsFilter) ToScope() func(*gorm.DB) *gorm.DB {
+	return func(tx *gorm.DB) *gorm.DB {
+		tx = tx.Model(&models.WalletVerificationProofs{})
+
+		if v.WalletID != 0 {
+			tx = tx.Where("wallet_id = ?", v.WalletID)
+		}
+
+		if v.UserID != 0 {
+			tx = tx.Where("user_id = ?", v.UserID)
+		}
+
+		if v.Pubkey != "" {
+			tx = tx.Where("pubkey = ?", v.Pubkey)
+		}
+
+		if v.AfterID != 0 {
+			tx = tx.Where("id > ?", v.AfterID)
+		}
+
 		return tx
 	}
 }
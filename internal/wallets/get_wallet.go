@@ -4,14 +4,21 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/knstch/knstch-libs/svcerrs"
 	"github.com/knstch/knstch-libs/tracing"
 
 	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
 	"wallets-service/internal/wallets/filters"
 )
 
+// maxWalletsBatchSize caps the number of users or pubkeys looked up in a single batch call.
+const maxWalletsBatchSize = 1000
+
 // GetWallet returns the wallet associated with the given user ID.
 //
+// The verification status of the wallet is derived from its verification time and the configured validity.
+//
 // If the wallet doesn't exist, GetWallet returns an error wrapping svcerrs.ErrDataNotFound.
 func (s *ServiceImpl) GetWallet(ctx context.Context, userID uint) (dto.Wallet, error) {
 	ctx, span := tracing.StartSpan(ctx, "wallets: GetWallet")
@@ -22,5 +29,143 @@ func (s *ServiceImpl) GetWallet(ctx context.Context, userID uint) (dto.Wallet, e
 		return dto.Wallet{}, fmt.Errorf("repo.GetWallet: %w", err)
 	}
 
-	return wallet, nil
+	return s.withVerificationStatus(wallet), nil
+}
+
+// GetWalletsByUserIDs returns the wallets of the given users in one query, one result per distinct user ID
+// in request order.
+//
+// Users without a wallet get a result with Found unset instead of failing the call. If verifiedOnly is set,
+// so do users whose wallet is unverified or whose verification expired. More than 1000 user IDs return
+// an error wrapping svcerrs.ErrInvalidData.
+func (s *ServiceImpl) GetWalletsByUserIDs(ctx context.Context, userIDs []uint, verifiedOnly bool) ([]dto.UserWalletLookup, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: GetWalletsByUserIDs")
+	defer span.End()
+
+	if len(userIDs) > maxWalletsBatchSize {
+		return nil, fmt.Errorf("at most %d user IDs are allowed per call: %w", maxWalletsBatchSize, svcerrs.ErrInvalidData)
+	}
+
+	unique := make([]uint, 0, len(userIDs))
+	seen := make(map[uint]struct{}, len(userIDs))
+	for _, userID := range userIDs {
+		if userID == 0 {
+			return nil, fmt.Errorf("user ID is required: %w", svcerrs.ErrInvalidData)
+		}
+		if _, ok := seen[userID]; ok {
+			continue
+		}
+		seen[userID] = struct{}{}
+		unique = append(unique, userID)
+	}
+	if len(unique) == 0 {
+		return []dto.UserWalletLookup{}, nil
+	}
+
+	filter := filters.WalletsFilter{UserIDs: unique}
+	if verifiedOnly {
+		filter.IsVerified = filters.BoolPtr(true)
+	}
+	wallets, err := s.repo.ListWallets(ctx, filter, 0)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListWallets: %w", err)
+	}
+
+	byUserID := make(map[uint]dto.Wallet, len(wallets))
+	for _, wallet := range wallets {
+		wallet = s.withVerificationStatus(wallet)
+		if verifiedOnly && wallet.VerificationStatus == enum.VerificationStatusExpired {
+			continue
+		}
+		// Wallets come in ID order; keep the oldest one, as GetWallet does.
+		if _, ok := byUserID[wallet.UserID]; !ok {
+			byUserID[wallet.UserID] = wallet
+		}
+	}
+
+	out := make([]dto.UserWalletLookup, 0, len(unique))
+	for _, userID := range unique {
+		wallet, ok := byUserID[userID]
+		out = append(out, dto.UserWalletLookup{
+			UserID: userID,
+			Found:  ok,
+			Wallet: wallet,
+		})
+	}
+
+	return out, nil
+}
+
+// GetWalletByPubkey returns the wallet linked to the address, normalized per chain before the lookup.
+//
+// If pubkey is not an address of a supported chain, GetWalletByPubkey returns an error wrapping
+// svcerrs.ErrInvalidData; if it is not linked to any user, an error wrapping svcerrs.ErrDataNotFound.
+func (s *ServiceImpl) GetWalletByPubkey(ctx context.Context, pubkey string) (dto.Wallet, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: GetWalletByPubkey")
+	defer span.End()
+
+	normalized := s.chains.NormalizePubkey(pubkey)
+	if len(normalized) == 0 {
+		return dto.Wallet{}, fmt.Errorf("pubkey is not an address of a supported chain: %w", svcerrs.ErrInvalidData)
+	}
+
+	wallet, err := s.repo.GetWallet(ctx, filters.WalletsFilter{Pubkeys: normalized})
+	if err != nil {
+		return dto.Wallet{}, fmt.Errorf("repo.GetWallet: %w", err)
+	}
+
+	return s.withVerificationStatus(wallet), nil
+}
+
+// GetWalletsByPubkeys returns the wallets linked to the given addresses in one query, one result per distinct
+// requested address in request order.
+//
+// Addresses are normalized per chain before the lookup. Addresses that are not linked to any user, or are not
+// addresses of a supported chain, get a result with Found unset instead of failing the call. More than 1000
+// addresses return an error wrapping svcerrs.ErrInvalidData.
+func (s *ServiceImpl) GetWalletsByPubkeys(ctx context.Context, pubkeys []string) ([]dto.PubkeyWalletLookup, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: GetWalletsByPubkeys")
+	defer span.End()
+
+	if len(pubkeys) > maxWalletsBatchSize {
+		return nil, fmt.Errorf("at most %d pubkeys are allowed per call: %w", maxWalletsBatchSize, svcerrs.ErrInvalidData)
+	}
+
+	unique := make([]string, 0, len(pubkeys))
+	normalizedByPubkey := make(map[string][]string, len(pubkeys))
+	var normalized []string
+	for _, pubkey := range pubkeys {
+		if _, ok := normalizedByPubkey[pubkey]; ok {
+			continue
+		}
+		unique = append(unique, pubkey)
+		normalizedByPubkey[pubkey] = s.chains.NormalizePubkey(pubkey)
+		normalized = append(normalized, normalizedByPubkey[pubkey]...)
+	}
+
+	byPubkey := make(map[string]dto.Wallet, len(normalized))
+	if len(normalized) != 0 {
+		wallets, err := s.repo.ListWallets(ctx, filters.WalletsFilter{Pubkeys: normalized}, 0)
+		if err != nil {
+			return nil, fmt.Errorf("repo.ListWallets: %w", err)
+		}
+		for _, wallet := range wallets {
+			byPubkey[wallet.Pubkey] = s.withVerificationStatus(wallet)
+		}
+	}
+
+	out := make([]dto.PubkeyWalletLookup, 0, len(unique))
+	for _, pubkey := range unique {
+		lookup := dto.PubkeyWalletLookup{Pubkey: pubkey}
+		for _, candidate := range normalizedByPubkey[pubkey] {
+			if wallet, ok := byPubkey[candidate]; ok {
+				lookup.Found = true
+				lookup.Wallet = wallet
+				break
+			}
+		}
+		out = append(out, lookup)
+	}
+
+	return out, nil
 }
@@ -4,3 +4,8 @@ package wallets
 func GetChallengeByIDKey(id string) string {
 	return "challenge:" + id
 }
+
+// GetChallengesByPubkeyKey builds the Redis key of the set indexing outstanding challenge IDs for a pubkey.
+func GetChallengesByPubkeyKey(pubkey string) string {
+	return "challenges:pubkey:" + pubkey
+}
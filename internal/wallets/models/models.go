@@ -16,3 +16,224 @@ type UserWallets struct {
 func (UserWallets) TableName() string {
 	return "user_wallets"
 }
+
+type WalletReclaims struct {
+	ID          uint
+	WalletID    uint
+	Pubkey      string
+	Provider    string
+	FromUserID  uint
+	ToUserID    uint
+	Status      string
+	AvailableAt time.Time
+	ResolvedAt  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// TableName specifies the database table name used by GORM.
+func (WalletReclaims) TableName() string {
+	return "wallet_reclaims"
+}
+
+type WalletTransfers struct {
+	ID         uint
+	WalletID   uint
+	Pubkey     string
+	Provider   string
+	FromUserID uint
+	ToUserID   uint
+	Status     string
+	ExpiresAt  time.Time
+	AcceptedAt *time.Time
+	ResolvedAt *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// TableName specifies the database table name used by GORM.
+func (WalletTransfers) TableName() string {
+	return "wallet_transfers"
+}
+
+type WalletLinkEvents struct {
+	ID        uint
+	UserID    uint
+	Pubkey    string
+	Action    string
+	CreatedAt time.Time
+}
+
+// TableName specifies the database table name used by GORM.
+func (WalletLinkEvents) TableName() string {
+	return "wallet_link_events"
+}
+
+type WalletEvents struct {
+	ID        uint
+	Type      string
+	UserID    uint
+	WalletID  uint
+	Pubkey    string
+	Provider  string
+	Actor     string
+	RequestID string
+	Metadata  string
+	CreatedAt time.Time
+}
+
+// TableName specifies the database table name used by GORM.
+func (WalletEvents) TableName() string {
+	return "wallet_events"
+}
+
+type WalletOutbox struct {
+	ID            uint
+	EventID       string
+	Type          string
+	UserID        uint
+	Payload       string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	PublishedAt   *time.Time
+	CreatedAt     time.Time
+}
+
+// TableName specifies the database table name used by GORM.
+func (WalletOutbox) TableName() string {
+	return "wallet_outbox"
+}
+
+type WebhookSubscriptions struct {
+	ID         uint
+	URL        string
+	EventTypes string
+	Secret     string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// TableName specifies the database table name used by GORM.
+func (WebhookSubscriptions) TableName() string {
+	return "webhook_subscriptions"
+}
+
+type WebhookDeliveries struct {
+	ID             uint
+	SubscriptionID uint
+	EventID        string
+	EventType      string
+	Payload        string
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastError      string
+	LastStatusCode int
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// TableName specifies the database table name used by GORM.
+func (WebhookDeliveries) TableName() string {
+	return "webhook_deliveries"
+}
+
+type WalletVerificationProofs struct {
+	ID              uint
+	WalletID        uint
+	UserID          uint
+	Pubkey          string
+	Provider        string
+	ChallengeID     string
+	Nonce           string
+	ExpiresAt       int64
+	Format          string
+	Statement       string
+	Message         []byte
+	SignedPayload   []byte
+	Signature       string
+	Encoding        string
+	VerifierVersion string
+	IPHash          string
+	UserAgentHash   string
+	VerifiedAt      *time.Time
+	CreatedAt       time.Time
+}
+
+// TableName specifies the database table name used by GORM.
+func (WalletVerificationProofs) TableName() string {
+	return "wallet_verification_proofs"
+}
+
+type WalletSnapshots struct {
+	ID          uint
+	Label       string
+	TakenAt     time.Time
+	WalletCount int
+	ContentHash string
+	CreatedAt   time.Time
+}
+
+// TableName specifies the database table name used by GORM.
+func (WalletSnapshots) TableName() string {
+	return "wallet_snapshots"
+}
+
+type WalletSnapshotEntries struct {
+	SnapshotID uint
+	UserID     uint
+	WalletID   uint
+	Pubkey     string
+	Provider   string
+	VerifiedAt time.Time
+}
+
+// TableName specifies the database table name used by GORM.
+func (WalletSnapshotEntries) TableName() string {
+	return "wallet_snapshot_entries"
+}
+
+type Allowlists struct {
+	ID           uint
+	SnapshotID   uint
+	Label        string
+	HashFunction string
+	LeafEncoding string
+	Root         string
+	LeafCount    int
+	CreatedAt    time.Time
+}
+
+// TableName specifies the database table name used by GORM.
+func (Allowlists) TableName() string {
+	return "allowlists"
+}
+
+type AllowlistLeaves struct {
+	AllowlistID uint
+	Position    int
+	UserID      uint
+	WalletID    uint
+	Pubkey      string
+	Provider    string
+	Amount      string
+}
+
+// TableName specifies the database table name used by GORM.
+func (AllowlistLeaves) TableName() string {
+	return "allowlist_leaves"
+}
+
+type AllowlistNodes struct {
+	AllowlistID uint
+	Level       int
+	Position    int
+	Hash        []byte
+}
+
+// TableName specifies the database table name used by GORM.
+func (AllowlistNodes) TableName() string {
+	return "allowlist_nodes"
+}
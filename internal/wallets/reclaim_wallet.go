@@ -0,0 +1,267 @@
+package wallets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/knstch/knstch-libs/log"
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/metrics"
+	"wallets-service/internal/wallets/filters"
+	"wallets-service/internal/wallets/repo"
+)
+
+// RequestReclaim returns a challenge proving possession of a wallet that is bound to another account.
+//
+// It is the first step of the reclaim flow for users who lost access to the account holding their wallet:
+//   - RequestReclaim issues the challenge;
+//   - ConfirmReclaim checks the signature and starts the waiting period, during which the current owner can
+//     ContestReclaim;
+//   - CompleteReclaim moves the wallet to the claimant once the waiting period is over.
+//
+// If the wallet does not exist, RequestReclaim returns an error wrapping svcerrs.ErrDataNotFound
+// (AddWallet should be used instead). If the wallet already belongs to the user or a reclaim for it
+// is already pending, RequestReclaim returns an error wrapping svcerrs.ErrConflict.
+func (s *ServiceImpl) RequestReclaim(ctx context.Context, userID uint, pubkey string, provider enum.Provider) (dto.ChallengeForUser, error) {
+	defer metrics.IncReclaimWallet("request")
+
+	ctx, span := tracing.StartSpan(ctx, "wallets: RequestReclaim")
+	defer span.End()
+
+	challenge, jsonChallenge, err := s.newChallenge(userID, pubkey, provider, challengePurposeReclaim, 0)
+	if err != nil {
+		return dto.ChallengeForUser{}, fmt.Errorf("newChallenge: %w", err)
+	}
+
+	if _, err = s.getReclaimableWallet(ctx, userID, pubkey); err != nil {
+		return dto.ChallengeForUser{}, fmt.Errorf("getReclaimableWallet: %w", err)
+	}
+
+	if _, err = s.repo.GetReclaim(ctx, filters.ReclaimsFilter{
+		Pubkey: pubkey,
+		Status: enum.ReclaimStatusPending,
+	}); err == nil {
+		return dto.ChallengeForUser{}, fmt.Errorf("reclaim is already pending: %w", svcerrs.ErrConflict)
+	} else if !errors.Is(err, svcerrs.ErrDataNotFound) {
+		return dto.ChallengeForUser{}, fmt.Errorf("repo.GetReclaim: %w", err)
+	}
+
+	if err = s.storeChallenge(ctx, challenge.ChallengeID, pubkey, jsonChallenge); err != nil {
+		return dto.ChallengeForUser{}, fmt.Errorf("storeChallenge: %w", err)
+	}
+
+	return challenge, nil
+}
+
+// ConfirmReclaim validates the signature of a reclaim challenge and starts the waiting period.
+//
+// The current owner is notified and can contest the reclaim until AvailableAt.
+// If another reclaim for the wallet is already pending, ConfirmReclaim returns an error wrapping svcerrs.ErrConflict.
+func (s *ServiceImpl) ConfirmReclaim(ctx context.Context, userID uint, challengeID, signature string) (dto.WalletReclaim, error) {
+	defer metrics.IncReclaimWallet("confirm")
+
+	ctx, span := tracing.StartSpan(ctx, "wallets: ConfirmReclaim")
+	defer span.End()
+
+	challenge, err := s.loadChallenge(ctx, userID, challengeID, challengePurposeReclaim)
+	if err != nil {
+		return dto.WalletReclaim{}, fmt.Errorf("loadChallenge: %w", err)
+	}
+
+	provider, verification, err := s.verifyChallenge(ctx, challengeID, challenge, signature)
+	if err != nil {
+		return dto.WalletReclaim{}, fmt.Errorf("verifyChallenge: %w", err)
+	}
+
+	wallet, err := s.getReclaimableWallet(ctx, userID, challenge.PubKey)
+	if err != nil {
+		return dto.WalletReclaim{}, fmt.Errorf("getReclaimableWallet: %w", err)
+	}
+
+	var reclaim dto.WalletReclaim
+	if err = s.repo.Transaction(func(st repo.Repository) error {
+		reclaim, err = st.CreateReclaim(ctx, dto.WalletReclaim{
+			WalletID:    wallet.ID,
+			Pubkey:      wallet.Pubkey,
+			Provider:    provider,
+			FromUserID:  wallet.UserID,
+			ToUserID:    userID,
+			Status:      enum.ReclaimStatusPending,
+			AvailableAt: time.Now().Add(s.cfg.ReclaimConfig.WaitingPeriod),
+		})
+		if err != nil {
+			return fmt.Errorf("st.CreateReclaim: %w", err)
+		}
+
+		// The proof backs the verification the claimant gets once the reclaim completes.
+		if err = st.CreateVerificationProof(ctx, s.newVerificationProof(ctx, wallet.ID, userID, challengeID, challenge, provider, verification, signature)); err != nil {
+			return fmt.Errorf("st.CreateVerificationProof: %w", err)
+		}
+
+		if err = notifyReclaimRequested(ctx, st, reclaim); err != nil {
+			return fmt.Errorf("notifyReclaimRequested: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return dto.WalletReclaim{}, fmt.Errorf("repo.Transaction: %w", err)
+	}
+
+	s.deleteChallenge(ctx, challengeID)
+
+	return reclaim, nil
+}
+
+// ContestReclaim lets the current owner stop a pending reclaim of their wallet.
+//
+// If the reclaim does not exist or targets another user's wallet, ContestReclaim returns an error wrapping
+// svcerrs.ErrDataNotFound. If it is no longer pending, ContestReclaim returns an error wrapping svcerrs.ErrConflict.
+func (s *ServiceImpl) ContestReclaim(ctx context.Context, userID, reclaimID uint) error {
+	defer metrics.IncReclaimWallet("contest")
+
+	ctx, span := tracing.StartSpan(ctx, "wallets: ContestReclaim")
+	defer span.End()
+
+	if _, err := s.repo.GetReclaim(ctx, filters.ReclaimsFilter{
+		ID:         reclaimID,
+		FromUserID: userID,
+	}); err != nil {
+		return fmt.Errorf("repo.GetReclaim: %w", err)
+	}
+
+	if err := s.repo.ResolveReclaim(ctx, reclaimID, enum.ReclaimStatusContested); err != nil {
+		return fmt.Errorf("repo.ResolveReclaim: %w", err)
+	}
+
+	return nil
+}
+
+// CompleteReclaim moves the wallet to the claimant once the waiting period is over.
+//
+// The reclaim is resolved and the wallet is moved in a single transaction; every outstanding challenge of
+// the wallet is invalidated once it commits. Errors:
+//   - svcerrs.ErrDataNotFound if the reclaim does not exist or was requested by another user;
+//   - svcerrs.ErrConflict if the reclaim is no longer pending;
+//   - svcerrs.ErrForbidden if the waiting period is not over yet;
+//   - svcerrs.ErrGone if the wallet left the previous owner in the meantime; the reclaim is cancelled;
+//   - ErrRelinkCooldown or ErrLinkChurnLimit if the claimant may not link the wallet yet.
+func (s *ServiceImpl) CompleteReclaim(ctx context.Context, userID, reclaimID uint) error {
+	defer metrics.IncReclaimWallet("complete")
+
+	ctx, span := tracing.StartSpan(ctx, "wallets: CompleteReclaim")
+	defer span.End()
+
+	reclaim, err := s.repo.GetReclaim(ctx, filters.ReclaimsFilter{
+		ID:       reclaimID,
+		ToUserID: userID,
+	})
+	if err != nil {
+		return fmt.Errorf("repo.GetReclaim: %w", err)
+	}
+	if reclaim.Status != enum.ReclaimStatusPending {
+		return fmt.Errorf("reclaim is %s: %w", reclaim.Status, svcerrs.ErrConflict)
+	}
+	if time.Now().Before(reclaim.AvailableAt) {
+		return fmt.Errorf("waiting period ends at %s: %w", reclaim.AvailableAt.Format(time.RFC3339), svcerrs.ErrForbidden)
+	}
+
+	if err = s.repo.Transaction(func(st repo.Repository) error {
+		if err := st.ResolveReclaim(ctx, reclaim.ID, enum.ReclaimStatusCompleted); err != nil {
+			return fmt.Errorf("st.ResolveReclaim: %w", err)
+		}
+
+		if err := s.moveWallet(ctx, st, dto.Wallet{
+			ID:       reclaim.WalletID,
+			UserID:   reclaim.FromUserID,
+			Pubkey:   reclaim.Pubkey,
+			Provider: reclaim.Provider,
+		}, reclaim.ToUserID, moveReasonReclaim); err != nil {
+			return fmt.Errorf("moveWallet: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		if !errors.Is(err, svcerrs.ErrDataNotFound) {
+			return fmt.Errorf("repo.Transaction: %w", err)
+		}
+
+		if err := s.repo.ResolveReclaim(ctx, reclaim.ID, enum.ReclaimStatusCancelled); err != nil {
+			return fmt.Errorf("repo.ResolveReclaim: %w", err)
+		}
+		return fmt.Errorf("wallet is no longer owned by the previous account: %w", svcerrs.ErrGone)
+	}
+
+	// Challenges issued before the move must not be redeemable by either account afterwards.
+	// They are dropped once the move is committed, so a rolled back move keeps them.
+	if err = s.invalidateChallenges(ctx, reclaim.Pubkey); err != nil {
+		s.lg.Error("failed to invalidate wallet challenges", err,
+			log.AddMessage("reclaim_id", reclaim.ID),
+		)
+	}
+
+	return nil
+}
+
+// ListReclaims returns reclaims where the user is either the current owner or the claimant, newest first.
+func (s *ServiceImpl) ListReclaims(ctx context.Context, userID uint) ([]dto.WalletReclaim, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: ListReclaims")
+	defer span.End()
+
+	reclaims, err := s.repo.ListReclaims(ctx, filters.ReclaimsFilter{
+		ParticipantID: userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListReclaims: %w", err)
+	}
+
+	return reclaims, nil
+}
+
+// getReclaimableWallet returns the wallet with the pubkey if it is bound to an account other than userID.
+func (s *ServiceImpl) getReclaimableWallet(ctx context.Context, userID uint, pubkey string) (dto.Wallet, error) {
+	wallet, err := s.repo.GetWallet(ctx, filters.WalletsFilter{
+		Pubkey: pubkey,
+	})
+	if err != nil {
+		return dto.Wallet{}, fmt.Errorf("repo.GetWallet: %w", err)
+	}
+	if wallet.UserID == userID {
+		return dto.Wallet{}, fmt.Errorf("wallet already belongs to the user: %w", svcerrs.ErrConflict)
+	}
+
+	return wallet, nil
+}
+
+// notifyReclaimRequested tells the current owner that their wallet is being reclaimed by publishing
+// a reclaim requested domain event to the outbox and webhook subscribers, within the transaction of st.
+//
+// The owner also sees the reclaim in ListReclaims and can contest it until AvailableAt.
+func notifyReclaimRequested(ctx context.Context, st repo.Repository, reclaim dto.WalletReclaim) error {
+	if err := publishDomainEvent(ctx, st, dto.WalletDomainEvent{
+		EventID:    uuid.NewString(),
+		Type:       domainEventReclaimRequested,
+		UserID:     reclaim.FromUserID,
+		WalletID:   reclaim.WalletID,
+		Pubkey:     reclaim.Pubkey,
+		Provider:   reclaim.Provider.String(),
+		RequestID:  requestMetaFromContext(ctx).RequestID,
+		OccurredAt: time.Now().UTC(),
+		Metadata: map[string]string{
+			"reclaim_id":       strconv.FormatUint(uint64(reclaim.ID), 10),
+			"claimant_user_id": strconv.FormatUint(uint64(reclaim.ToUserID), 10),
+			"available_at":     reclaim.AvailableAt.UTC().Format(time.RFC3339),
+		},
+	}); err != nil {
+		return fmt.Errorf("publishDomainEvent: %w", err)
+	}
+
+	return nil
+}
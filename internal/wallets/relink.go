@@ -0,0 +1,145 @@
+package wallets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/filters"
+	"wallets-service/internal/wallets/repo"
+)
+
+// checkRelinkAllowed enforces the relink cooldown of the pubkey and the churn limit of the user
+// before a wallet is linked to the user.
+//
+// It returns an error wrapping ErrRelinkCooldown if the pubkey was unlinked by another account within
+// the cooldown, or ErrLinkChurnLimit if the user has reached the churn limit.
+func (s *ServiceImpl) checkRelinkAllowed(ctx context.Context, st repo.Repository, userID uint, pubkey string) error {
+	now := time.Now()
+
+	if cooldown := s.cfg.RelinkConfig.Cooldown; cooldown > 0 {
+		event, err := st.GetLastLinkEvent(ctx, filters.LinkEventsFilter{
+			Pubkey: pubkey,
+			Action: enum.LinkActionUnlink,
+			Since:  now.Add(-cooldown),
+		})
+		switch {
+		case err == nil:
+			if event.UserID != userID {
+				return fmt.Errorf("pubkey can only be added by its previous owner until %s: %w",
+					event.CreatedAt.Add(cooldown).Format(time.RFC3339), ErrRelinkCooldown)
+			}
+		case !errors.Is(err, svcerrs.ErrDataNotFound):
+			return fmt.Errorf("st.GetLastLinkEvent: %w", err)
+		}
+	}
+
+	if limit := s.cfg.RelinkConfig.ChurnLimit; limit > 0 {
+		count, err := st.CountLinkEvents(ctx, filters.LinkEventsFilter{
+			UserID: userID,
+			Since:  now.Add(-s.cfg.RelinkConfig.ChurnWindow),
+		})
+		if err != nil {
+			return fmt.Errorf("st.CountLinkEvents: %w", err)
+		}
+		if count >= int64(limit) {
+			return fmt.Errorf("%d link and unlink events within %s: %w", count, s.cfg.RelinkConfig.ChurnWindow, ErrLinkChurnLimit)
+		}
+	}
+
+	return nil
+}
+
+// removeWallet deletes the wallet, records the unlink event and records the unlinked wallet event
+// in the audit log and the outbox, all in one transaction.
+//
+// The wallet must carry its ID, UserID, Pubkey and Provider; confirmation describes how the unlink was
+// confirmed and is recorded with the wallet event.
+func (s *ServiceImpl) removeWallet(ctx context.Context, wallet dto.Wallet, confirmation string) error {
+	if err := s.repo.Transaction(func(st repo.Repository) error {
+		if err := st.DeleteWallet(ctx, filters.WalletsFilter{
+			ID:     wallet.ID,
+			UserID: wallet.UserID,
+			Pubkey: wallet.Pubkey,
+		}); err != nil {
+			return fmt.Errorf("st.DeleteWallet: %w", err)
+		}
+
+		if err := st.CreateLinkEvent(ctx, wallet.UserID, wallet.Pubkey, enum.LinkActionUnlink); err != nil {
+			return fmt.Errorf("st.CreateLinkEvent: %w", err)
+		}
+
+		if err := recordWalletEvents(ctx, st, newWalletEvent(ctx, enum.WalletEventUnlinked, wallet, map[string]string{
+			"confirmation": confirmation,
+		})); err != nil {
+			return fmt.Errorf("recordWalletEvents: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("repo.Transaction: %w", err)
+	}
+
+	return nil
+}
+
+const (
+	moveReasonTransfer = "transfer"
+	moveReasonReclaim  = "reclaim"
+)
+
+// moveWallet moves the wallet to the account toUserID within the transaction of st, enforcing the relink
+// cooldown and churn limit for that account and recording the unlink and link events of the move.
+//
+// The move is recorded in the audit log and the outbox as an unlinked wallet event for the previous owner
+// followed by added and verified wallet events for the new one, so it should be the last database write
+// of the transaction.
+//
+// The wallet must carry its ID, UserID (the current owner), Pubkey and the Provider it is moved with;
+// reason describes what moved the wallet and is recorded with the wallet events.
+func (s *ServiceImpl) moveWallet(ctx context.Context, st repo.Repository, wallet dto.Wallet, toUserID uint, reason string) error {
+	if err := st.MoveWallet(ctx, filters.WalletsFilter{
+		ID:     wallet.ID,
+		UserID: wallet.UserID,
+		Pubkey: wallet.Pubkey,
+	}, toUserID, wallet.Provider); err != nil {
+		return fmt.Errorf("st.MoveWallet: %w", err)
+	}
+
+	if err := s.checkRelinkAllowed(ctx, st, toUserID, wallet.Pubkey); err != nil {
+		return fmt.Errorf("checkRelinkAllowed: %w", err)
+	}
+
+	if err := st.CreateLinkEvent(ctx, wallet.UserID, wallet.Pubkey, enum.LinkActionUnlink); err != nil {
+		return fmt.Errorf("st.CreateLinkEvent: %w", err)
+	}
+	if err := st.CreateLinkEvent(ctx, toUserID, wallet.Pubkey, enum.LinkActionLink); err != nil {
+		return fmt.Errorf("st.CreateLinkEvent: %w", err)
+	}
+
+	moved := wallet
+	moved.UserID = toUserID
+	if err := recordWalletEvents(ctx, st,
+		newWalletEvent(ctx, enum.WalletEventUnlinked, wallet, map[string]string{
+			"move":             reason,
+			"moved_to_user_id": strconv.FormatUint(uint64(toUserID), 10),
+		}),
+		newWalletEvent(ctx, enum.WalletEventAdded, moved, map[string]string{
+			"move":               reason,
+			"moved_from_user_id": strconv.FormatUint(uint64(wallet.UserID), 10),
+		}),
+		newWalletEvent(ctx, enum.WalletEventVerified, moved, map[string]string{
+			"move": reason,
+		}),
+	); err != nil {
+		return fmt.Errorf("recordWalletEvents: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package repo
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+	"gorm.io/gorm"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/models"
+)
+
+// allowlistRowsBatchSize is the number of leaves or nodes inserted per statement.
+const allowlistRowsBatchSize = 1000
+
+// CreateAllowlist stores an allowlist with its leaves and every level of its tree, leaves first,
+// and returns it with its ID.
+func (r *DBRepo) CreateAllowlist(ctx context.Context, allowlist dto.Allowlist, leaves []dto.AllowlistLeaf, levels [][][]byte) (dto.Allowlist, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: CreateAllowlist")
+	defer span.End()
+
+	model := models.Allowlists{
+		SnapshotID:   allowlist.SnapshotID,
+		Label:        allowlist.Label,
+		HashFunction: allowlist.Hash.String(),
+		LeafEncoding: allowlist.Encoding.String(),
+		Root:         allowlist.Root,
+		LeafCount:    allowlist.LeafCount,
+	}
+	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		return dto.Allowlist{}, fmt.Errorf("db.Create: %w", err)
+	}
+
+	leafRows := make([]models.AllowlistLeaves, 0, len(leaves))
+	for _, leaf := range leaves {
+		leafRows = append(leafRows, models.AllowlistLeaves{
+			AllowlistID: model.ID,
+			Position:    leaf.Position,
+			UserID:      leaf.UserID,
+			WalletID:    leaf.WalletID,
+			Pubkey:      leaf.Pubkey,
+			Provider:    leaf.Provider.String(),
+			Amount:      leaf.Amount,
+		})
+	}
+	if len(leafRows) != 0 {
+		if err := r.db.WithContext(ctx).CreateInBatches(leafRows, allowlistRowsBatchSize).Error; err != nil {
+			return dto.Allowlist{}, fmt.Errorf("db.CreateInBatches: %w", err)
+		}
+	}
+
+	var nodeRows []models.AllowlistNodes
+	for level, nodes := range levels {
+		for position, hash := range nodes {
+			nodeRows = append(nodeRows, models.AllowlistNodes{
+				AllowlistID: model.ID,
+				Level:       level,
+				Position:    position,
+				Hash:        hash,
+			})
+		}
+	}
+	if len(nodeRows) != 0 {
+		if err := r.db.WithContext(ctx).CreateInBatches(nodeRows, allowlistRowsBatchSize).Error; err != nil {
+			return dto.Allowlist{}, fmt.Errorf("db.CreateInBatches: %w", err)
+		}
+	}
+
+	return allowlistToDTO(model)
+}
+
+// GetAllowlist returns the allowlist with the given ID.
+//
+// If it does not exist, GetAllowlist returns an error wrapping svcerrs.ErrDataNotFound.
+func (r *DBRepo) GetAllowlist(ctx context.Context, id uint) (dto.Allowlist, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: GetAllowlist")
+	defer span.End()
+
+	var allowlist models.Allowlists
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&allowlist).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dto.Allowlist{}, fmt.Errorf("allowlist not found: %w", svcerrs.ErrDataNotFound)
+		}
+		return dto.Allowlist{}, fmt.Errorf("db.First: %w", err)
+	}
+
+	return allowlistToDTO(allowlist)
+}
+
+// GetAllowlistLeaf returns the leaf of a pubkey in an allowlist with its hash.
+//
+// If the pubkey is not in the allowlist, GetAllowlistLeaf returns an error wrapping svcerrs.ErrDataNotFound.
+func (r *DBRepo) GetAllowlistLeaf(ctx context.Context, allowlistID uint, pubkey string) (dto.AllowlistLeaf, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: GetAllowlistLeaf")
+	defer span.End()
+
+	var leaf models.AllowlistLeaves
+	if err := r.db.WithContext(ctx).
+		Where("allowlist_id = ? AND pubkey = ?", allowlistID, pubkey).
+		First(&leaf).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dto.AllowlistLeaf{}, fmt.Errorf("allowlist leaf not found: %w", svcerrs.ErrDataNotFound)
+		}
+		return dto.AllowlistLeaf{}, fmt.Errorf("db.First: %w", err)
+	}
+
+	var node models.AllowlistNodes
+	if err := r.db.WithContext(ctx).
+		Where("allowlist_id = ? AND level = 0 AND position = ?", allowlistID, leaf.Position).
+		First(&node).Error; err != nil {
+		return dto.AllowlistLeaf{}, fmt.Errorf("db.First: %w", err)
+	}
+
+	provider, err := enum.GetProvider(leaf.Provider)
+	if err != nil {
+		return dto.AllowlistLeaf{}, fmt.Errorf("enum.GetProvider: %w", err)
+	}
+
+	return dto.AllowlistLeaf{
+		Position: leaf.Position,
+		UserID:   leaf.UserID,
+		WalletID: leaf.WalletID,
+		Pubkey:   leaf.Pubkey,
+		Provider: provider,
+		Amount:   leaf.Amount,
+		Hash:     "0x" + hex.EncodeToString(node.Hash),
+	}, nil
+}
+
+// ListAllowlistNodes returns the hashes of the nodes of an allowlist tree at the given level and position
+// pairs, in the order of positions.
+func (r *DBRepo) ListAllowlistNodes(ctx context.Context, allowlistID uint, positions [][2]int) ([][]byte, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: ListAllowlistNodes")
+	defer span.End()
+
+	if len(positions) == 0 {
+		return [][]byte{}, nil
+	}
+
+	pairs := make([][]interface{}, 0, len(positions))
+	for _, p := range positions {
+		pairs = append(pairs, []interface{}{p[0], p[1]})
+	}
+
+	var nodes []models.AllowlistNodes
+	if err := r.db.WithContext(ctx).
+		Where("allowlist_id = ? AND (level, position) IN ?", allowlistID, pairs).
+		Find(&nodes).Error; err != nil {
+		return nil, fmt.Errorf("db.Find: %w", err)
+	}
+
+	byPosition := make(map[[2]int][]byte, len(nodes))
+	for _, node := range nodes {
+		byPosition[[2]int{node.Level, node.Position}] = node.Hash
+	}
+
+	out := make([][]byte, 0, len(positions))
+	for _, p := range positions {
+		hash, ok := byPosition[p]
+		if !ok {
+			return nil, fmt.Errorf("allowlist node %d/%d is missing", p[0], p[1])
+		}
+		out = append(out, hash)
+	}
+
+	return out, nil
+}
+
+func allowlistToDTO(allowlist models.Allowlists) (dto.Allowlist, error) {
+	hash, err := enum.GetMerkleHash(allowlist.HashFunction)
+	if err != nil {
+		return dto.Allowlist{}, fmt.Errorf("enum.GetMerkleHash: %w", err)
+	}
+	encoding, err := enum.GetLeafEncoding(allowlist.LeafEncoding)
+	if err != nil {
+		return dto.Allowlist{}, fmt.Errorf("enum.GetLeafEncoding: %w", err)
+	}
+
+	return dto.Allowlist{
+		ID:         allowlist.ID,
+		SnapshotID: allowlist.SnapshotID,
+		Label:      allowlist.Label,
+		Hash:       hash,
+		Encoding:   encoding,
+		Root:       allowlist.Root,
+		LeafCount:  allowlist.LeafCount,
+		CreatedAt:  allowlist.CreatedAt,
+	}, nil
+}
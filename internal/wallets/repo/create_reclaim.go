@@ -0,0 +1,43 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/wallets/models"
+)
+
+// CreateReclaim creates a new wallet reclaim row and returns it with the generated ID.
+//
+// If a reclaim for the same pubkey is already pending, CreateReclaim returns an error wrapping svcerrs.ErrConflict.
+func (r *DBRepo) CreateReclaim(ctx context.Context, reclaim dto.WalletReclaim) (dto.WalletReclaim, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: CreateReclaim")
+	defer span.End()
+
+	model := models.WalletReclaims{
+		WalletID:    reclaim.WalletID,
+		Pubkey:      reclaim.Pubkey,
+		Provider:    reclaim.Provider.String(),
+		FromUserID:  reclaim.FromUserID,
+		ToUserID:    reclaim.ToUserID,
+		Status:      reclaim.Status.String(),
+		AvailableAt: reclaim.AvailableAt,
+	}
+	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		if isUniqueViolation(err) {
+			return dto.WalletReclaim{}, fmt.Errorf("db.Create: %w", svcerrs.ErrConflict)
+		}
+		return dto.WalletReclaim{}, fmt.Errorf("db.Create: %w", err)
+	}
+
+	created, err := reclaimToDTO(model)
+	if err != nil {
+		return dto.WalletReclaim{}, fmt.Errorf("reclaimToDTO: %w", err)
+	}
+
+	return created, nil
+}
@@ -0,0 +1,43 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/wallets/models"
+)
+
+// CreateTransfer creates a new wallet transfer row and returns it with the generated ID.
+//
+// If a transfer for the same wallet is already in progress, CreateTransfer returns an error wrapping svcerrs.ErrConflict.
+func (r *DBRepo) CreateTransfer(ctx context.Context, transfer dto.WalletTransfer) (dto.WalletTransfer, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: CreateTransfer")
+	defer span.End()
+
+	model := models.WalletTransfers{
+		WalletID:   transfer.WalletID,
+		Pubkey:     transfer.Pubkey,
+		Provider:   transfer.Provider.String(),
+		FromUserID: transfer.FromUserID,
+		ToUserID:   transfer.ToUserID,
+		Status:     transfer.Status.String(),
+		ExpiresAt:  transfer.ExpiresAt,
+	}
+	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		if isUniqueViolation(err) {
+			return dto.WalletTransfer{}, fmt.Errorf("db.Create: %w", svcerrs.ErrConflict)
+		}
+		return dto.WalletTransfer{}, fmt.Errorf("db.Create: %w", err)
+	}
+
+	created, err := transferToDTO(model)
+	if err != nil {
+		return dto.WalletTransfer{}, fmt.Errorf("transferToDTO: %w", err)
+	}
+
+	return created, nil
+}
@@ -11,23 +11,24 @@ import (
 	"wallets-service/internal/wallets/models"
 )
 
-// CreateWallet creates a new wallet row.
+// CreateWallet creates a new wallet row and returns its ID.
 //
 // If a uniqueness constraint is violated, CreateWallet returns an error wrapping svcerrs.ErrConflict.
-func (r *DBRepo) CreateWallet(ctx context.Context, userID uint, pubkey string, provider enum.Provider) error {
+func (r *DBRepo) CreateWallet(ctx context.Context, userID uint, pubkey string, provider enum.Provider) (uint, error) {
 	ctx, span := tracing.StartSpan(ctx, "repo: CreateWallet")
 	defer span.End()
 
-	if err := r.db.WithContext(ctx).Create(&models.UserWallets{
+	wallet := models.UserWallets{
 		UserID:   userID,
 		Pubkey:   pubkey,
 		Provider: provider.String(),
-	}).Error; err != nil {
+	}
+	if err := r.db.WithContext(ctx).Create(&wallet).Error; err != nil {
 		if isUniqueViolation(err) {
-			return fmt.Errorf("db.Create: %w", svcerrs.ErrConflict)
+			return 0, fmt.Errorf("db.Create: %w", svcerrs.ErrConflict)
 		}
-		return fmt.Errorf("db.Create: %w", err)
+		return 0, fmt.Errorf("db.Create: %w", err)
 	}
 
-	return nil
+	return wallet.ID, nil
 }
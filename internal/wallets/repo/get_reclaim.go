@@ -0,0 +1,80 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+	"gorm.io/gorm"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/filters"
+	"wallets-service/internal/wallets/models"
+)
+
+// GetReclaim returns a wallet reclaim matching the provided filters.
+//
+// If no reclaim matches, GetReclaim returns an error wrapping svcerrs.ErrDataNotFound.
+func (r *DBRepo) GetReclaim(ctx context.Context, filters filters.ReclaimsFilter) (dto.WalletReclaim, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: GetReclaim")
+	defer span.End()
+
+	var reclaim models.WalletReclaims
+	if err := r.db.WithContext(ctx).Scopes(filters.ToScope()).First(&reclaim).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dto.WalletReclaim{}, fmt.Errorf("reclaim not found: %w", svcerrs.ErrDataNotFound)
+		}
+		return dto.WalletReclaim{}, fmt.Errorf("db.First: %w", err)
+	}
+
+	return reclaimToDTO(reclaim)
+}
+
+// ListReclaims returns wallet reclaims matching the provided filters, newest first.
+func (r *DBRepo) ListReclaims(ctx context.Context, filters filters.ReclaimsFilter) ([]dto.WalletReclaim, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: ListReclaims")
+	defer span.End()
+
+	var reclaims []models.WalletReclaims
+	if err := r.db.WithContext(ctx).Scopes(filters.ToScope()).Order("id DESC").Find(&reclaims).Error; err != nil {
+		return nil, fmt.Errorf("db.Find: %w", err)
+	}
+
+	out := make([]dto.WalletReclaim, 0, len(reclaims))
+	for _, reclaim := range reclaims {
+		converted, err := reclaimToDTO(reclaim)
+		if err != nil {
+			return nil, fmt.Errorf("reclaimToDTO: %w", err)
+		}
+		out = append(out, converted)
+	}
+
+	return out, nil
+}
+
+func reclaimToDTO(reclaim models.WalletReclaims) (dto.WalletReclaim, error) {
+	provider, err := enum.GetProvider(reclaim.Provider)
+	if err != nil {
+		return dto.WalletReclaim{}, fmt.Errorf("enum.GetProvider: %w", err)
+	}
+	status, err := enum.GetReclaimStatus(reclaim.Status)
+	if err != nil {
+		return dto.WalletReclaim{}, fmt.Errorf("enum.GetReclaimStatus: %w", err)
+	}
+
+	return dto.WalletReclaim{
+		ID:          reclaim.ID,
+		WalletID:    reclaim.WalletID,
+		Pubkey:      reclaim.Pubkey,
+		Provider:    provider,
+		FromUserID:  reclaim.FromUserID,
+		ToUserID:    reclaim.ToUserID,
+		Status:      status,
+		AvailableAt: reclaim.AvailableAt,
+		ResolvedAt:  reclaim.ResolvedAt,
+		CreatedAt:   reclaim.CreatedAt,
+	}, nil
+}
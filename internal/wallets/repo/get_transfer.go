@@ -0,0 +1,81 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+	"gorm.io/gorm"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/filters"
+	"wallets-service/internal/wallets/models"
+)
+
+// GetTransfer returns a wallet transfer matching the provided filters.
+//
+// If no transfer matches, GetTransfer returns an error wrapping svcerrs.ErrDataNotFound.
+func (r *DBRepo) GetTransfer(ctx context.Context, filters filters.TransfersFilter) (dto.WalletTransfer, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: GetTransfer")
+	defer span.End()
+
+	var transfer models.WalletTransfers
+	if err := r.db.WithContext(ctx).Scopes(filters.ToScope()).First(&transfer).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dto.WalletTransfer{}, fmt.Errorf("transfer not found: %w", svcerrs.ErrDataNotFound)
+		}
+		return dto.WalletTransfer{}, fmt.Errorf("db.First: %w", err)
+	}
+
+	return transferToDTO(transfer)
+}
+
+// ListTransfers returns wallet transfers matching the provided filters, newest first.
+func (r *DBRepo) ListTransfers(ctx context.Context, filters filters.TransfersFilter) ([]dto.WalletTransfer, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: ListTransfers")
+	defer span.End()
+
+	var transfers []models.WalletTransfers
+	if err := r.db.WithContext(ctx).Scopes(filters.ToScope()).Order("id DESC").Find(&transfers).Error; err != nil {
+		return nil, fmt.Errorf("db.Find: %w", err)
+	}
+
+	out := make([]dto.WalletTransfer, 0, len(transfers))
+	for _, transfer := range transfers {
+		converted, err := transferToDTO(transfer)
+		if err != nil {
+			return nil, fmt.Errorf("transferToDTO: %w", err)
+		}
+		out = append(out, converted)
+	}
+
+	return out, nil
+}
+
+func transferToDTO(transfer models.WalletTransfers) (dto.WalletTransfer, error) {
+	provider, err := enum.GetProvider(transfer.Provider)
+	if err != nil {
+		return dto.WalletTransfer{}, fmt.Errorf("enum.GetProvider: %w", err)
+	}
+	status, err := enum.GetTransferStatus(transfer.Status)
+	if err != nil {
+		return dto.WalletTransfer{}, fmt.Errorf("enum.GetTransferStatus: %w", err)
+	}
+
+	return dto.WalletTransfer{
+		ID:         transfer.ID,
+		WalletID:   transfer.WalletID,
+		Pubkey:     transfer.Pubkey,
+		Provider:   provider,
+		FromUserID: transfer.FromUserID,
+		ToUserID:   transfer.ToUserID,
+		Status:     status,
+		ExpiresAt:  transfer.ExpiresAt,
+		AcceptedAt: transfer.AcceptedAt,
+		ResolvedAt: transfer.ResolvedAt,
+		CreatedAt:  transfer.CreatedAt,
+	}, nil
+}
@@ -41,5 +41,43 @@ func (r *DBRepo) GetWallet(ctx context.Context, filters filters.WalletsFilter) (
 		Pubkey:     wallet.Pubkey,
 		Provider:   provider,
 		VerifiedAt: wallet.VerifiedAt,
+		CreatedAt:  wallet.CreatedAt,
 	}, nil
 }
+
+// ListWallets returns wallets matching the provided filters in ID order.
+//
+// A positive limit caps the number of returned wallets.
+func (r *DBRepo) ListWallets(ctx context.Context, filters filters.WalletsFilter, limit int) ([]dto.Wallet, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: ListWallets")
+	defer span.End()
+
+	query := r.db.WithContext(ctx).Scopes(filters.ToScope()).Order("id")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var wallets []models.UserWallets
+	if err := query.Find(&wallets).Error; err != nil {
+		return nil, fmt.Errorf("db.Find: %w", err)
+	}
+
+	out := make([]dto.Wallet, 0, len(wallets))
+	for _, wallet := range wallets {
+		provider, err := enum.GetProvider(wallet.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("enum.GetProvider: %w", err)
+		}
+
+		out = append(out, dto.Wallet{
+			ID:         wallet.ID,
+			UserID:     wallet.UserID,
+			Pubkey:     wallet.Pubkey,
+			Provider:   provider,
+			VerifiedAt: wallet.VerifiedAt,
+			CreatedAt:  wallet.CreatedAt,
+		})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,74 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+	"gorm.io/gorm"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/filters"
+	"wallets-service/internal/wallets/models"
+)
+
+// CreateLinkEvent records that a wallet was linked to or unlinked from an account.
+func (r *DBRepo) CreateLinkEvent(ctx context.Context, userID uint, pubkey string, action enum.LinkAction) error {
+	ctx, span := tracing.StartSpan(ctx, "repo: CreateLinkEvent")
+	defer span.End()
+
+	if err := r.db.WithContext(ctx).Create(&models.WalletLinkEvents{
+		UserID: userID,
+		Pubkey: pubkey,
+		Action: action.String(),
+	}).Error; err != nil {
+		return fmt.Errorf("db.Create: %w", err)
+	}
+
+	return nil
+}
+
+// GetLastLinkEvent returns the most recent link event matching the provided filters.
+//
+// If no event matches, GetLastLinkEvent returns an error wrapping svcerrs.ErrDataNotFound.
+func (r *DBRepo) GetLastLinkEvent(ctx context.Context, filters filters.LinkEventsFilter) (dto.LinkEvent, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: GetLastLinkEvent")
+	defer span.End()
+
+	var event models.WalletLinkEvents
+	if err := r.db.WithContext(ctx).Scopes(filters.ToScope()).Order("created_at DESC, id DESC").First(&event).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dto.LinkEvent{}, fmt.Errorf("link event not found: %w", svcerrs.ErrDataNotFound)
+		}
+		return dto.LinkEvent{}, fmt.Errorf("db.First: %w", err)
+	}
+
+	action, err := enum.GetLinkAction(event.Action)
+	if err != nil {
+		return dto.LinkEvent{}, fmt.Errorf("enum.GetLinkAction: %w", err)
+	}
+
+	return dto.LinkEvent{
+		ID:        event.ID,
+		UserID:    event.UserID,
+		Pubkey:    event.Pubkey,
+		Action:    action,
+		CreatedAt: event.CreatedAt,
+	}, nil
+}
+
+// CountLinkEvents returns the number of link events matching the provided filters.
+func (r *DBRepo) CountLinkEvents(ctx context.Context, filters filters.LinkEventsFilter) (int64, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: CountLinkEvents")
+	defer span.End()
+
+	var count int64
+	if err := r.db.WithContext(ctx).Scopes(filters.ToScope()).Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("db.Count: %w", err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,46 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+	"gorm.io/gorm"
+
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/filters"
+	"wallets-service/internal/wallets/models"
+)
+
+// MoveWallet reassigns a wallet matching the filter to another user and marks it verified by them.
+//
+// If no wallet matches the filter, MoveWallet returns an error wrapping svcerrs.ErrDataNotFound.
+func (r *DBRepo) MoveWallet(ctx context.Context, filter filters.WalletsFilter, toUserID uint, provider enum.Provider) error {
+	ctx, span := tracing.StartSpan(ctx, "repo: MoveWallet")
+	defer span.End()
+
+	var walletFromDB models.UserWallets
+	if err := r.db.WithContext(ctx).Scopes(filter.ToScope()).First(&walletFromDB).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fmt.Errorf("wallet to move is not found: %w", svcerrs.ErrDataNotFound)
+		}
+		return fmt.Errorf("db.First: %w", err)
+	}
+
+	now := time.Now()
+	walletFromDB.UserID = toUserID
+	walletFromDB.Provider = provider.String()
+	walletFromDB.VerifiedAt = &now
+
+	if err := r.db.WithContext(ctx).Save(&walletFromDB).Error; err != nil {
+		if isUniqueViolation(err) {
+			return fmt.Errorf("db.Save: %w", svcerrs.ErrConflict)
+		}
+		return fmt.Errorf("db.Save: %w", err)
+	}
+
+	return nil
+}
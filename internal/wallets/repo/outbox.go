@@ -0,0 +1,131 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/knstch/knstch-libs/tracing"
+	"gorm.io/gorm"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/wallets/models"
+)
+
+// CreateOutboxMessage puts a domain event into the transactional outbox.
+func (r *DBRepo) CreateOutboxMessage(ctx context.Context, msg dto.OutboxMessage) error {
+	ctx, span := tracing.StartSpan(ctx, "repo: CreateOutboxMessage")
+	defer span.End()
+
+	if err := r.db.WithContext(ctx).Create(&models.WalletOutbox{
+		EventID:       msg.EventID,
+		Type:          msg.Type,
+		UserID:        msg.UserID,
+		Payload:       string(msg.Payload),
+		NextAttemptAt: time.Now(),
+	}).Error; err != nil {
+		return fmt.Errorf("db.Create: %w", err)
+	}
+
+	return nil
+}
+
+// ListDueOutboxMessages returns unpublished messages that are due for a publish attempt, oldest first.
+//
+// Only the oldest unpublished message of each user is considered, so a message is never returned
+// while an earlier message of the same user is still waiting for a retry.
+func (r *DBRepo) ListDueOutboxMessages(ctx context.Context, limit int) ([]dto.OutboxMessage, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: ListDueOutboxMessages")
+	defer span.End()
+
+	var messages []models.WalletOutbox
+	if err := r.db.WithContext(ctx).Raw(`
+		SELECT * FROM (
+		  SELECT DISTINCT ON (user_id) * FROM wallet_outbox
+		  WHERE published_at IS NULL
+		  ORDER BY user_id, id
+		) heads
+		WHERE next_attempt_at <= now()
+		ORDER BY id
+		LIMIT ?`, limit).Scan(&messages).Error; err != nil {
+		return nil, fmt.Errorf("db.Raw: %w", err)
+	}
+
+	out := make([]dto.OutboxMessage, 0, len(messages))
+	for _, msg := range messages {
+		out = append(out, dto.OutboxMessage{
+			ID:            msg.ID,
+			EventID:       msg.EventID,
+			Type:          msg.Type,
+			UserID:        msg.UserID,
+			Payload:       []byte(msg.Payload),
+			Attempts:      msg.Attempts,
+			NextAttemptAt: msg.NextAttemptAt,
+			LastError:     msg.LastError,
+			PublishedAt:   msg.PublishedAt,
+			CreatedAt:     msg.CreatedAt,
+		})
+	}
+
+	return out, nil
+}
+
+// MarkOutboxMessagePublished records that the message was delivered to the broker.
+func (r *DBRepo) MarkOutboxMessagePublished(ctx context.Context, id uint) error {
+	ctx, span := tracing.StartSpan(ctx, "repo: MarkOutboxMessagePublished")
+	defer span.End()
+
+	if err := r.db.WithContext(ctx).Model(&models.WalletOutbox{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"published_at": time.Now(),
+			"attempts":     gorm.Expr("attempts + 1"),
+		}).Error; err != nil {
+		return fmt.Errorf("db.Updates: %w", err)
+	}
+
+	return nil
+}
+
+// MarkOutboxMessageFailed records a failed publish attempt and schedules the next one.
+func (r *DBRepo) MarkOutboxMessageFailed(ctx context.Context, id uint, nextAttemptAt time.Time, lastError string) error {
+	ctx, span := tracing.StartSpan(ctx, "repo: MarkOutboxMessageFailed")
+	defer span.End()
+
+	if err := r.db.WithContext(ctx).Model(&models.WalletOutbox{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"attempts":        gorm.Expr("attempts + 1"),
+			"next_attempt_at": nextAttemptAt,
+			"last_error":      lastError,
+		}).Error; err != nil {
+		return fmt.Errorf("db.Updates: %w", err)
+	}
+
+	return nil
+}
+
+// TryAdvisoryLock takes a transaction-level Postgres advisory lock without waiting.
+func (r *DBRepo) TryAdvisoryLock(ctx context.Context, key int64) (bool, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: TryAdvisoryLock")
+	defer span.End()
+
+	var locked bool
+	if err := r.db.WithContext(ctx).Raw("SELECT pg_try_advisory_xact_lock(?)", key).Scan(&locked).Error; err != nil {
+		return false, fmt.Errorf("db.Raw: %w", err)
+	}
+
+	return locked, nil
+}
+
+// AdvisoryLock takes a transaction-level Postgres advisory lock, waiting until it is available.
+func (r *DBRepo) AdvisoryLock(ctx context.Context, key int64) error {
+	ctx, span := tracing.StartSpan(ctx, "repo: AdvisoryLock")
+	defer span.End()
+
+	if err := r.db.WithContext(ctx).Exec("SELECT pg_advisory_xact_lock(?)", key).Error; err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/knstch/knstch-libs/log"
@@ -24,10 +25,66 @@ type DBRepo struct {
 type Repository interface {
 	// Transaction runs fn inside a database transaction.
 	Transaction(fn func(st Repository) error) error
-	CreateWallet(ctx context.Context, userID uint, pubkey string, provider enum.Provider) error
+	CreateWallet(ctx context.Context, userID uint, pubkey string, provider enum.Provider) (uint, error)
 	GetWallet(ctx context.Context, filters filters.WalletsFilter) (dto.Wallet, error)
-	VerifyWallet(ctx context.Context, filter filters.WalletsFilter) error
+	ListWallets(ctx context.Context, filters filters.WalletsFilter, limit int) ([]dto.Wallet, error)
+	VerifyWallet(ctx context.Context, filter filters.WalletsFilter) (uint, error)
 	DeleteWallet(ctx context.Context, filters filters.WalletsFilter) error
+	MoveWallet(ctx context.Context, filter filters.WalletsFilter, toUserID uint, provider enum.Provider) error
+
+	CreateLinkEvent(ctx context.Context, userID uint, pubkey string, action enum.LinkAction) error
+	GetLastLinkEvent(ctx context.Context, filters filters.LinkEventsFilter) (dto.LinkEvent, error)
+	CountLinkEvents(ctx context.Context, filters filters.LinkEventsFilter) (int64, error)
+
+	CreateWalletEvent(ctx context.Context, event dto.WalletEvent) error
+	ListWalletEvents(ctx context.Context, filters filters.WalletEventsFilter, limit int) ([]dto.WalletEvent, error)
+	ListWalletEventsAfter(ctx context.Context, afterID uint, provider enum.Provider, limit int) ([]dto.WalletEvent, error)
+	GetLastWalletEventID(ctx context.Context) (uint, error)
+
+	CreateOutboxMessage(ctx context.Context, msg dto.OutboxMessage) error
+	ListDueOutboxMessages(ctx context.Context, limit int) ([]dto.OutboxMessage, error)
+	MarkOutboxMessagePublished(ctx context.Context, id uint) error
+	MarkOutboxMessageFailed(ctx context.Context, id uint, nextAttemptAt time.Time, lastError string) error
+	// TryAdvisoryLock takes a transaction-level advisory lock, reporting false if another session holds it.
+	// It must be called inside Transaction.
+	TryAdvisoryLock(ctx context.Context, key int64) (bool, error)
+	// AdvisoryLock takes a transaction-level advisory lock, waiting for other sessions to release it.
+	// It must be called inside Transaction.
+	AdvisoryLock(ctx context.Context, key int64) error
+
+	CreateWalletSnapshot(ctx context.Context, snapshot dto.WalletSnapshot, entries []dto.WalletSnapshotEntry) (dto.WalletSnapshot, error)
+	GetWalletSnapshot(ctx context.Context, id uint) (dto.WalletSnapshot, error)
+	ListWalletSnapshots(ctx context.Context) ([]dto.WalletSnapshot, error)
+	ListWalletSnapshotEntries(ctx context.Context, snapshotID uint, afterPubkey string, limit int) ([]dto.WalletSnapshotEntry, error)
+
+	CreateAllowlist(ctx context.Context, allowlist dto.Allowlist, leaves []dto.AllowlistLeaf, levels [][][]byte) (dto.Allowlist, error)
+	GetAllowlist(ctx context.Context, id uint) (dto.Allowlist, error)
+	GetAllowlistLeaf(ctx context.Context, allowlistID uint, pubkey string) (dto.AllowlistLeaf, error)
+	ListAllowlistNodes(ctx context.Context, allowlistID uint, positions [][2]int) ([][]byte, error)
+
+	CreateWebhookSubscription(ctx context.Context, subscription dto.WebhookSubscription) (dto.WebhookSubscription, error)
+	GetWebhookSubscription(ctx context.Context, id uint) (dto.WebhookSubscription, error)
+	ListWebhookSubscriptions(ctx context.Context) ([]dto.WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, id uint) error
+	CreateWebhookDeliveries(ctx context.Context, eventID, eventType string, payload []byte) error
+	ReplayWebhookDeliveries(ctx context.Context, subscriptionID uint, since time.Time) (int64, error)
+	ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]dto.WebhookDelivery, error)
+	ListWebhookDeliveries(ctx context.Context, filters filters.WebhookDeliveriesFilter, limit int) ([]dto.WebhookDelivery, error)
+	MarkWebhookDeliveryDelivered(ctx context.Context, id uint, statusCode int) error
+	MarkWebhookDeliveryFailed(ctx context.Context, id uint, statusCode int, lastError string, nextAttemptAt time.Time, dead bool) error
+
+	CreateVerificationProof(ctx context.Context, proof dto.VerificationProof) error
+	ListVerificationProofs(ctx context.Context, filters filters.VerificationProofsFilter, limit int) ([]dto.VerificationProof, error)
+
+	CreateReclaim(ctx context.Context, reclaim dto.WalletReclaim) (dto.WalletReclaim, error)
+	GetReclaim(ctx context.Context, filters filters.ReclaimsFilter) (dto.WalletReclaim, error)
+	ListReclaims(ctx context.Context, filters filters.ReclaimsFilter) ([]dto.WalletReclaim, error)
+	ResolveReclaim(ctx context.Context, reclaimID uint, status enum.ReclaimStatus) error
+
+	CreateTransfer(ctx context.Context, transfer dto.WalletTransfer) (dto.WalletTransfer, error)
+	GetTransfer(ctx context.Context, filters filters.TransfersFilter) (dto.WalletTransfer, error)
+	ListTransfers(ctx context.Context, filters filters.TransfersFilter) ([]dto.WalletTransfer, error)
+	TransitionTransfer(ctx context.Context, transferID uint, from []enum.TransferStatus, to enum.TransferStatus) error
 }
 
 // NewDBRepo returns a repository bound to the provided gorm.DB session.
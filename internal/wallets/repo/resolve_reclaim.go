@@ -0,0 +1,40 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/models"
+)
+
+// ResolveReclaim moves a pending reclaim to a final status and records when it happened.
+//
+// The update only applies while the reclaim is still pending, so concurrent contest and completion
+// cannot both succeed. If the reclaim is not pending, ResolveReclaim returns an error wrapping svcerrs.ErrConflict.
+func (r *DBRepo) ResolveReclaim(ctx context.Context, reclaimID uint, status enum.ReclaimStatus) error {
+	ctx, span := tracing.StartSpan(ctx, "repo: ResolveReclaim")
+	defer span.End()
+
+	now := time.Now()
+	res := r.db.WithContext(ctx).
+		Model(&models.WalletReclaims{}).
+		Where("id = ? AND status = ?", reclaimID, enum.ReclaimStatusPending.String()).
+		Updates(map[string]any{
+			"status":      status.String(),
+			"resolved_at": now,
+			"updated_at":  now,
+		})
+	if res.Error != nil {
+		return fmt.Errorf("db.Updates: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("reclaim is not pending: %w", svcerrs.ErrConflict)
+	}
+
+	return nil
+}
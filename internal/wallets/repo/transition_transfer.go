@@ -0,0 +1,52 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/models"
+)
+
+// TransitionTransfer moves a transfer to the status to, provided it is currently in one of the from statuses.
+//
+// Accepting records accepted_at, any other transition records resolved_at. The status check is part of the
+// update, so concurrent transitions cannot both succeed. If the transfer is in none of the from statuses,
+// TransitionTransfer returns an error wrapping svcerrs.ErrConflict.
+func (r *DBRepo) TransitionTransfer(ctx context.Context, transferID uint, from []enum.TransferStatus, to enum.TransferStatus) error {
+	ctx, span := tracing.StartSpan(ctx, "repo: TransitionTransfer")
+	defer span.End()
+
+	statuses := make([]string, 0, len(from))
+	for _, status := range from {
+		statuses = append(statuses, status.String())
+	}
+
+	now := time.Now()
+	updates := map[string]any{
+		"status":     to.String(),
+		"updated_at": now,
+	}
+	if to == enum.TransferStatusAccepted {
+		updates["accepted_at"] = now
+	} else {
+		updates["resolved_at"] = now
+	}
+
+	res := r.db.WithContext(ctx).
+		Model(&models.WalletTransfers{}).
+		Where("id = ? AND status IN ?", transferID, statuses).
+		Updates(updates)
+	if res.Error != nil {
+		return fmt.Errorf("db.Updates: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return fmt.Errorf("transfer is not %v: %w", statuses, svcerrs.ErrConflict)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/knstch/knstch-libs/tracing"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/filters"
+	"wallets-service/internal/wallets/models"
+)
+
+// CreateVerificationProof stores the evidence of a wallet verification.
+func (r *DBRepo) CreateVerificationProof(ctx context.Context, proof dto.VerificationProof) error {
+	ctx, span := tracing.StartSpan(ctx, "repo: CreateVerificationProof")
+	defer span.End()
+
+	var verifiedAt *time.Time
+	if !proof.VerifiedAt.IsZero() {
+		verifiedAt = &proof.VerifiedAt
+	}
+
+	if err := r.db.WithContext(ctx).Create(&models.WalletVerificationProofs{
+		WalletID:        proof.WalletID,
+		UserID:          proof.UserID,
+		Pubkey:          proof.Pubkey,
+		Provider:        proof.Provider.String(),
+		ChallengeID:     proof.ChallengeID,
+		Nonce:           proof.Nonce,
+		ExpiresAt:       proof.ExpiresAt,
+		Format:          proof.Format.String(),
+		Statement:       proof.Statement,
+		Message:         proof.Message,
+		SignedPayload:   proof.SignedPayload,
+		Signature:       proof.Signature,
+		Encoding:        proof.Encoding,
+		VerifierVersion: proof.VerifierVersion,
+		IPHash:          proof.IPHash,
+		UserAgentHash:   proof.UserAgentHash,
+		VerifiedAt:      verifiedAt,
+	}).Error; err != nil {
+		return fmt.Errorf("db.Create: %w", err)
+	}
+
+	return nil
+}
+
+// ListVerificationProofs returns verification proofs matching the provided filters in ID order.
+//
+// A positive limit caps the number of returned proofs.
+func (r *DBRepo) ListVerificationProofs(ctx context.Context, filters filters.VerificationProofsFilter, limit int) ([]dto.VerificationProof, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: ListVerificationProofs")
+	defer span.End()
+
+	query := r.db.WithContext(ctx).Scopes(filters.ToScope()).Order("id")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var proofs []models.WalletVerificationProofs
+	if err := query.Find(&proofs).Error; err != nil {
+		return nil, fmt.Errorf("db.Find: %w", err)
+	}
+
+	out := make([]dto.VerificationProof, 0, len(proofs))
+	for _, proof := range proofs {
+		provider, err := enum.GetProvider(proof.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("enum.GetProvider: %w", err)
+		}
+
+		var verifiedAt time.Time
+		if proof.VerifiedAt != nil {
+			verifiedAt = *proof.VerifiedAt
+		}
+
+		out = append(out, dto.VerificationProof{
+			ID:              proof.ID,
+			WalletID:        proof.WalletID,
+			UserID:          proof.UserID,
+			Pubkey:          proof.Pubkey,
+			Provider:        provider,
+			ChallengeID:     proof.ChallengeID,
+			Nonce:           proof.Nonce,
+			ExpiresAt:       proof.ExpiresAt,
+			Format:          enum.MessageFormat(proof.Format),
+			Statement:       proof.Statement,
+			Message:         proof.Message,
+			SignedPayload:   proof.SignedPayload,
+			Signature:       proof.Signature,
+			Encoding:        proof.Encoding,
+			VerifierVersion: proof.VerifierVersion,
+			IPHash:          proof.IPHash,
+			UserAgentHash:   proof.UserAgentHash,
+			VerifiedAt:      verifiedAt,
+			CreatedAt:       proof.CreatedAt,
+		})
+	}
+
+	return out, nil
+}
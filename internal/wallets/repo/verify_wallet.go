@@ -14,27 +14,27 @@ import (
 	"wallets-service/internal/wallets/models"
 )
 
-// VerifyWallet marks a wallet as verified by setting VerifiedAt to the current time.
+// VerifyWallet marks a wallet as verified by setting VerifiedAt to the current time and returns its ID.
 //
 // If no wallet matches the filter, VerifyWallet returns an error wrapping svcerrs.ErrDataNotFound.
-func (r *DBRepo) VerifyWallet(ctx context.Context, filter filters.WalletsFilter) error {
+func (r *DBRepo) VerifyWallet(ctx context.Context, filter filters.WalletsFilter) (uint, error) {
 	ctx, span := tracing.StartSpan(ctx, "repo: VerifyWallet")
 	defer span.End()
 
 	var walletFromDB models.UserWallets
 	if err := r.db.WithContext(ctx).Scopes(filter.ToScope()).First(&walletFromDB).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return fmt.Errorf("wallet to update is not found: %w", svcerrs.ErrDataNotFound)
+			return 0, fmt.Errorf("wallet to update is not found: %w", svcerrs.ErrDataNotFound)
 		}
-		return fmt.Errorf("db.First: %w", err)
+		return 0, fmt.Errorf("db.First: %w", err)
 	}
 
 	now := time.Now()
 	walletFromDB.VerifiedAt = &now
 
 	if err := r.db.Save(&walletFromDB).Error; err != nil {
-		return fmt.Errorf("db.Save: %w", err)
+		return 0, fmt.Errorf("db.Save: %w", err)
 	}
 
-	return nil
+	return walletFromDB.ID, nil
 }
@@ -0,0 +1,136 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/tracing"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/filters"
+	"wallets-service/internal/wallets/models"
+)
+
+// CreateWalletEvent appends an event to the wallet audit log.
+func (r *DBRepo) CreateWalletEvent(ctx context.Context, event dto.WalletEvent) error {
+	ctx, span := tracing.StartSpan(ctx, "repo: CreateWalletEvent")
+	defer span.End()
+
+	metadata := event.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	rawMetadata, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	if err = r.db.WithContext(ctx).Create(&models.WalletEvents{
+		Type:      event.Type.String(),
+		UserID:    event.UserID,
+		WalletID:  event.WalletID,
+		Pubkey:    event.Pubkey,
+		Provider:  event.Provider.String(),
+		Actor:     event.Actor.String(),
+		RequestID: event.RequestID,
+		Metadata:  string(rawMetadata),
+	}).Error; err != nil {
+		return fmt.Errorf("db.Create: %w", err)
+	}
+
+	return nil
+}
+
+// ListWalletEvents returns wallet events matching the provided filters, newest first.
+//
+// A positive limit caps the number of returned events.
+func (r *DBRepo) ListWalletEvents(ctx context.Context, filters filters.WalletEventsFilter, limit int) ([]dto.WalletEvent, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: ListWalletEvents")
+	defer span.End()
+
+	query := r.db.WithContext(ctx).Scopes(filters.ToScope()).Order("id DESC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var events []models.WalletEvents
+	if err := query.Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("db.Find: %w", err)
+	}
+
+	return walletEventsToDTO(events)
+}
+
+// ListWalletEventsAfter returns wallet events with an ID greater than afterID, oldest first, optionally
+// narrowed to a provider.
+//
+// A positive limit caps the number of returned events.
+func (r *DBRepo) ListWalletEventsAfter(ctx context.Context, afterID uint, provider enum.Provider, limit int) ([]dto.WalletEvent, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: ListWalletEventsAfter")
+	defer span.End()
+
+	query := r.db.WithContext(ctx).Model(&models.WalletEvents{}).Where("id > ?", afterID).Order("id")
+	if provider != "" {
+		query = query.Where("provider = ?", provider.String())
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var events []models.WalletEvents
+	if err := query.Find(&events).Error; err != nil {
+		return nil, fmt.Errorf("db.Find: %w", err)
+	}
+
+	return walletEventsToDTO(events)
+}
+
+// GetLastWalletEventID returns the ID of the latest wallet event, or zero if there are none.
+func (r *DBRepo) GetLastWalletEventID(ctx context.Context) (uint, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: GetLastWalletEventID")
+	defer span.End()
+
+	var id uint
+	if err := r.db.WithContext(ctx).Model(&models.WalletEvents{}).Select("COALESCE(MAX(id), 0)").Scan(&id).Error; err != nil {
+		return 0, fmt.Errorf("db.Scan: %w", err)
+	}
+
+	return id, nil
+}
+
+func walletEventsToDTO(events []models.WalletEvents) ([]dto.WalletEvent, error) {
+	out := make([]dto.WalletEvent, 0, len(events))
+	for _, event := range events {
+		eventType, err := enum.GetWalletEventType(event.Type)
+		if err != nil {
+			return nil, fmt.Errorf("enum.GetWalletEventType: %w", err)
+		}
+
+		provider, err := enum.GetProvider(event.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("enum.GetProvider: %w", err)
+		}
+
+		var metadata map[string]string
+		if err = json.Unmarshal([]byte(event.Metadata), &metadata); err != nil {
+			return nil, fmt.Errorf("json.Unmarshal: %w", err)
+		}
+
+		out = append(out, dto.WalletEvent{
+			ID:        event.ID,
+			Type:      eventType,
+			UserID:    event.UserID,
+			WalletID:  event.WalletID,
+			Pubkey:    event.Pubkey,
+			Provider:  provider,
+			Actor:     enum.WalletEventActor(event.Actor),
+			RequestID: event.RequestID,
+			Metadata:  metadata,
+			CreatedAt: event.CreatedAt,
+		})
+	}
+
+	return out, nil
+}
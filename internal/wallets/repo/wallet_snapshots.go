@@ -0,0 +1,139 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+	"gorm.io/gorm"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/models"
+)
+
+// walletSnapshotEntriesBatchSize is the number of entries inserted per statement.
+const walletSnapshotEntriesBatchSize = 1000
+
+// CreateWalletSnapshot stores a snapshot with its entries and returns it with its ID.
+func (r *DBRepo) CreateWalletSnapshot(ctx context.Context, snapshot dto.WalletSnapshot, entries []dto.WalletSnapshotEntry) (dto.WalletSnapshot, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: CreateWalletSnapshot")
+	defer span.End()
+
+	model := models.WalletSnapshots{
+		Label:       snapshot.Label,
+		TakenAt:     snapshot.TakenAt,
+		WalletCount: snapshot.WalletCount,
+		ContentHash: snapshot.ContentHash,
+	}
+	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		return dto.WalletSnapshot{}, fmt.Errorf("db.Create: %w", err)
+	}
+
+	if len(entries) != 0 {
+		rows := make([]models.WalletSnapshotEntries, 0, len(entries))
+		for _, entry := range entries {
+			rows = append(rows, models.WalletSnapshotEntries{
+				SnapshotID: model.ID,
+				UserID:     entry.UserID,
+				WalletID:   entry.WalletID,
+				Pubkey:     entry.Pubkey,
+				Provider:   entry.Provider.String(),
+				VerifiedAt: entry.VerifiedAt,
+			})
+		}
+		if err := r.db.WithContext(ctx).CreateInBatches(rows, walletSnapshotEntriesBatchSize).Error; err != nil {
+			return dto.WalletSnapshot{}, fmt.Errorf("db.CreateInBatches: %w", err)
+		}
+	}
+
+	return walletSnapshotToDTO(model), nil
+}
+
+// GetWalletSnapshot returns the snapshot with the given ID.
+//
+// If it does not exist, GetWalletSnapshot returns an error wrapping svcerrs.ErrDataNotFound.
+func (r *DBRepo) GetWalletSnapshot(ctx context.Context, id uint) (dto.WalletSnapshot, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: GetWalletSnapshot")
+	defer span.End()
+
+	var snapshot models.WalletSnapshots
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&snapshot).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dto.WalletSnapshot{}, fmt.Errorf("wallet snapshot not found: %w", svcerrs.ErrDataNotFound)
+		}
+		return dto.WalletSnapshot{}, fmt.Errorf("db.First: %w", err)
+	}
+
+	return walletSnapshotToDTO(snapshot), nil
+}
+
+// ListWalletSnapshots returns every snapshot, newest first.
+func (r *DBRepo) ListWalletSnapshots(ctx context.Context) ([]dto.WalletSnapshot, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: ListWalletSnapshots")
+	defer span.End()
+
+	var snapshots []models.WalletSnapshots
+	if err := r.db.WithContext(ctx).Order("id DESC").Find(&snapshots).Error; err != nil {
+		return nil, fmt.Errorf("db.Find: %w", err)
+	}
+
+	out := make([]dto.WalletSnapshot, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		out = append(out, walletSnapshotToDTO(snapshot))
+	}
+
+	return out, nil
+}
+
+// ListWalletSnapshotEntries returns entries of a snapshot with a pubkey greater than afterPubkey,
+// in byte order of their pubkeys.
+//
+// A positive limit caps the number of returned entries.
+func (r *DBRepo) ListWalletSnapshotEntries(ctx context.Context, snapshotID uint, afterPubkey string, limit int) ([]dto.WalletSnapshotEntry, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: ListWalletSnapshotEntries")
+	defer span.End()
+
+	query := r.db.WithContext(ctx).
+		Where("snapshot_id = ? AND pubkey COLLATE \"C\" > ?", snapshotID, afterPubkey).
+		Order("pubkey COLLATE \"C\"")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var entries []models.WalletSnapshotEntries
+	if err := query.Find(&entries).Error; err != nil {
+		return nil, fmt.Errorf("db.Find: %w", err)
+	}
+
+	out := make([]dto.WalletSnapshotEntry, 0, len(entries))
+	for _, entry := range entries {
+		provider, err := enum.GetProvider(entry.Provider)
+		if err != nil {
+			return nil, fmt.Errorf("enum.GetProvider: %w", err)
+		}
+
+		out = append(out, dto.WalletSnapshotEntry{
+			UserID:     entry.UserID,
+			WalletID:   entry.WalletID,
+			Pubkey:     entry.Pubkey,
+			Provider:   provider,
+			VerifiedAt: entry.VerifiedAt,
+		})
+	}
+
+	return out, nil
+}
+
+func walletSnapshotToDTO(snapshot models.WalletSnapshots) dto.WalletSnapshot {
+	return dto.WalletSnapshot{
+		ID:          snapshot.ID,
+		Label:       snapshot.Label,
+		TakenAt:     snapshot.TakenAt,
+		WalletCount: snapshot.WalletCount,
+		ContentHash: snapshot.ContentHash,
+		CreatedAt:   snapshot.CreatedAt,
+	}
+}
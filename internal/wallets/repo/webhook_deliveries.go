@@ -0,0 +1,169 @@
+package repo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/knstch/knstch-libs/tracing"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/filters"
+	"wallets-service/internal/wallets/models"
+)
+
+// CreateWebhookDeliveries schedules the event for every webhook subscription that subscribed to its type.
+func (r *DBRepo) CreateWebhookDeliveries(ctx context.Context, eventID, eventType string, payload []byte) error {
+	ctx, span := tracing.StartSpan(ctx, "repo: CreateWebhookDeliveries")
+	defer span.End()
+
+	if err := r.db.WithContext(ctx).Exec(`
+		INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload)
+		SELECT id, ?, ?, ? FROM webhook_subscriptions
+		WHERE event_types @> jsonb_build_array(?::text)`,
+		eventID, eventType, string(payload), eventType).Error; err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// ReplayWebhookDeliveries schedules every outbox event created at or after since for the subscription again,
+// including events that were delivered or dead. It returns the number of scheduled deliveries.
+func (r *DBRepo) ReplayWebhookDeliveries(ctx context.Context, subscriptionID uint, since time.Time) (int64, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: ReplayWebhookDeliveries")
+	defer span.End()
+
+	result := r.db.WithContext(ctx).Exec(`
+		INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload)
+		SELECT s.id, o.event_id, o.type, o.payload
+		FROM wallet_outbox o
+		JOIN webhook_subscriptions s ON s.id = ?
+		WHERE o.created_at >= ? AND s.event_types @> jsonb_build_array(o.type)
+		ORDER BY o.id
+		ON CONFLICT (subscription_id, event_id) DO UPDATE SET
+		  status = 'pending',
+		  attempts = 0,
+		  next_attempt_at = now(),
+		  last_error = '',
+		  last_status_code = 0,
+		  delivered_at = NULL,
+		  updated_at = now()`,
+		subscriptionID, since)
+	if result.Error != nil {
+		return 0, fmt.Errorf("db.Exec: %w", result.Error)
+	}
+
+	return result.RowsAffected, nil
+}
+
+// ClaimWebhookDeliveries returns pending deliveries that are due, oldest first, and postpones their next
+// attempt by lease, so other dispatchers skip them while they are being sent.
+func (r *DBRepo) ClaimWebhookDeliveries(ctx context.Context, limit int, lease time.Duration) ([]dto.WebhookDelivery, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: ClaimWebhookDeliveries")
+	defer span.End()
+
+	var deliveries []models.WebhookDeliveries
+	if err := r.db.WithContext(ctx).Raw(`
+		UPDATE webhook_deliveries SET next_attempt_at = ?, updated_at = now()
+		WHERE id IN (
+		  SELECT id FROM webhook_deliveries
+		  WHERE status = 'pending' AND next_attempt_at <= now()
+		  ORDER BY id
+		  LIMIT ?
+		  FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`, time.Now().Add(lease), limit).Scan(&deliveries).Error; err != nil {
+		return nil, fmt.Errorf("db.Raw: %w", err)
+	}
+
+	return webhookDeliveriesToDTO(deliveries)
+}
+
+// ListWebhookDeliveries returns webhook deliveries matching the provided filters, newest first.
+//
+// A positive limit caps the number of returned deliveries.
+func (r *DBRepo) ListWebhookDeliveries(ctx context.Context, filters filters.WebhookDeliveriesFilter, limit int) ([]dto.WebhookDelivery, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: ListWebhookDeliveries")
+	defer span.End()
+
+	query := r.db.WithContext(ctx).Scopes(filters.ToScope()).Order("id DESC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	var deliveries []models.WebhookDeliveries
+	if err := query.Find(&deliveries).Error; err != nil {
+		return nil, fmt.Errorf("db.Find: %w", err)
+	}
+
+	return webhookDeliveriesToDTO(deliveries)
+}
+
+// MarkWebhookDeliveryDelivered records that the subscriber acknowledged the delivery.
+func (r *DBRepo) MarkWebhookDeliveryDelivered(ctx context.Context, id uint, statusCode int) error {
+	ctx, span := tracing.StartSpan(ctx, "repo: MarkWebhookDeliveryDelivered")
+	defer span.End()
+
+	if err := r.db.WithContext(ctx).Exec(`
+		UPDATE webhook_deliveries SET
+		  status = ?, attempts = attempts + 1, last_status_code = ?, last_error = '',
+		  delivered_at = now(), updated_at = now()
+		WHERE id = ?`,
+		enum.WebhookDeliveryStatusDelivered.String(), statusCode, id).Error; err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+// MarkWebhookDeliveryFailed records a failed attempt and either schedules the next one or, if dead is set,
+// moves the delivery to the dead-letter state.
+func (r *DBRepo) MarkWebhookDeliveryFailed(ctx context.Context, id uint, statusCode int, lastError string, nextAttemptAt time.Time, dead bool) error {
+	ctx, span := tracing.StartSpan(ctx, "repo: MarkWebhookDeliveryFailed")
+	defer span.End()
+
+	status := enum.WebhookDeliveryStatusPending
+	if dead {
+		status = enum.WebhookDeliveryStatusDead
+	}
+
+	if err := r.db.WithContext(ctx).Exec(`
+		UPDATE webhook_deliveries SET
+		  status = ?, attempts = attempts + 1, last_status_code = ?, last_error = ?,
+		  next_attempt_at = ?, updated_at = now()
+		WHERE id = ?`,
+		status.String(), statusCode, lastError, nextAttemptAt, id).Error; err != nil {
+		return fmt.Errorf("db.Exec: %w", err)
+	}
+
+	return nil
+}
+
+func webhookDeliveriesToDTO(deliveries []models.WebhookDeliveries) ([]dto.WebhookDelivery, error) {
+	out := make([]dto.WebhookDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		status, err := enum.GetWebhookDeliveryStatus(delivery.Status)
+		if err != nil {
+			return nil, fmt.Errorf("enum.GetWebhookDeliveryStatus: %w", err)
+		}
+
+		out = append(out, dto.WebhookDelivery{
+			ID:             delivery.ID,
+			SubscriptionID: delivery.SubscriptionID,
+			EventID:        delivery.EventID,
+			EventType:      delivery.EventType,
+			Payload:        []byte(delivery.Payload),
+			Status:         status,
+			Attempts:       delivery.Attempts,
+			NextAttemptAt:  delivery.NextAttemptAt,
+			LastError:      delivery.LastError,
+			LastStatusCode: delivery.LastStatusCode,
+			DeliveredAt:    delivery.DeliveredAt,
+			CreatedAt:      delivery.CreatedAt,
+		})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,110 @@
+package repo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+	"gorm.io/gorm"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/wallets/models"
+)
+
+// CreateWebhookSubscription stores a webhook subscription and returns it with its ID.
+func (r *DBRepo) CreateWebhookSubscription(ctx context.Context, subscription dto.WebhookSubscription) (dto.WebhookSubscription, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: CreateWebhookSubscription")
+	defer span.End()
+
+	eventTypes, err := json.Marshal(subscription.EventTypes)
+	if err != nil {
+		return dto.WebhookSubscription{}, fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	model := models.WebhookSubscriptions{
+		URL:        subscription.URL,
+		EventTypes: string(eventTypes),
+		Secret:     subscription.Secret,
+	}
+	if err = r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		return dto.WebhookSubscription{}, fmt.Errorf("db.Create: %w", err)
+	}
+
+	return webhookSubscriptionToDTO(model)
+}
+
+// GetWebhookSubscription returns the webhook subscription with the given ID.
+//
+// If it does not exist, GetWebhookSubscription returns an error wrapping svcerrs.ErrDataNotFound.
+func (r *DBRepo) GetWebhookSubscription(ctx context.Context, id uint) (dto.WebhookSubscription, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: GetWebhookSubscription")
+	defer span.End()
+
+	var subscription models.WebhookSubscriptions
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&subscription).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return dto.WebhookSubscription{}, fmt.Errorf("webhook subscription not found: %w", svcerrs.ErrDataNotFound)
+		}
+		return dto.WebhookSubscription{}, fmt.Errorf("db.First: %w", err)
+	}
+
+	return webhookSubscriptionToDTO(subscription)
+}
+
+// ListWebhookSubscriptions returns every webhook subscription in ID order.
+func (r *DBRepo) ListWebhookSubscriptions(ctx context.Context) ([]dto.WebhookSubscription, error) {
+	ctx, span := tracing.StartSpan(ctx, "repo: ListWebhookSubscriptions")
+	defer span.End()
+
+	var subscriptions []models.WebhookSubscriptions
+	if err := r.db.WithContext(ctx).Order("id").Find(&subscriptions).Error; err != nil {
+		return nil, fmt.Errorf("db.Find: %w", err)
+	}
+
+	out := make([]dto.WebhookSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		converted, err := webhookSubscriptionToDTO(subscription)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, converted)
+	}
+
+	return out, nil
+}
+
+// DeleteWebhookSubscription deletes a webhook subscription together with its deliveries.
+//
+// If it does not exist, DeleteWebhookSubscription returns an error wrapping svcerrs.ErrDataNotFound.
+func (r *DBRepo) DeleteWebhookSubscription(ctx context.Context, id uint) error {
+	ctx, span := tracing.StartSpan(ctx, "repo: DeleteWebhookSubscription")
+	defer span.End()
+
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&models.WebhookSubscriptions{})
+	if result.Error != nil {
+		return fmt.Errorf("db.Delete: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("webhook subscription not found: %w", svcerrs.ErrDataNotFound)
+	}
+
+	return nil
+}
+
+func webhookSubscriptionToDTO(subscription models.WebhookSubscriptions) (dto.WebhookSubscription, error) {
+	var eventTypes []string
+	if err := json.Unmarshal([]byte(subscription.EventTypes), &eventTypes); err != nil {
+		return dto.WebhookSubscription{}, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	return dto.WebhookSubscription{
+		ID:         subscription.ID,
+		URL:        subscription.URL,
+		EventTypes: eventTypes,
+		Secret:     subscription.Secret,
+		CreatedAt:  subscription.CreatedAt,
+	}, nil
+}
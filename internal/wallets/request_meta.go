@@ -0,0 +1,36 @@
+package wallets
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"wallets-service/internal/domain/dto"
+)
+
+type requestMetaKey struct{}
+
+// WithRequestMeta returns a copy of ctx carrying the client request metadata recorded by the service.
+func WithRequestMeta(ctx context.Context, meta dto.RequestMeta) context.Context {
+	return context.WithValue(ctx, requestMetaKey{}, meta)
+}
+
+// requestMetaFromContext returns the request metadata stored by WithRequestMeta, or an empty one.
+func requestMetaFromContext(ctx context.Context) dto.RequestMeta {
+	meta, _ := ctx.Value(requestMetaKey{}).(dto.RequestMeta)
+	return meta
+}
+
+// hashClientValue returns a hex HMAC-SHA256 of a client identifier such as an IP address,
+// so it can be matched later without being stored. Empty values stay empty, and so does every value
+// when no hash key is configured, since an unkeyed hash could be reversed by brute force.
+func (s *ServiceImpl) hashClientValue(value string) string {
+	if value == "" || s.cfg.ProofsConfig.ClientHashKey == "" {
+		return ""
+	}
+
+	mac := hmac.New(sha256.New, []byte(s.cfg.ProofsConfig.ClientHashKey))
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
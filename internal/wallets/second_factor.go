@@ -0,0 +1,15 @@
+package wallets
+
+import "context"
+
+// SecondFactorVerifier confirms sensitive operations with a second factor of the user, e.g. a TOTP code.
+//
+// VerifySecondFactor should return an error wrapping svcerrs.ErrForbidden if the code is wrong.
+type SecondFactorVerifier interface {
+	VerifySecondFactor(ctx context.Context, userID uint, code string) error
+}
+
+// SetSecondFactorVerifier lets step-up unlinks be confirmed with a second factor instead of a wallet signature.
+func (s *ServiceImpl) SetSecondFactorVerifier(verifier SecondFactorVerifier) {
+	s.secondFactor = verifier
+}
@@ -2,13 +2,17 @@ package wallets
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/knstch/knstch-libs/log"
 	"github.com/redis/go-redis/v9"
 
 	"wallets-service/config"
+	"wallets-service/internal/attestations"
 	"wallets-service/internal/domain/dto"
 	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/chains"
 	"wallets-service/internal/wallets/repo"
 )
 
@@ -16,8 +20,12 @@ import (
 type ServiceImpl struct {
 	lg *log.Logger
 
-	repo  repo.Repository
-	redis *redis.Client
+	repo   repo.Repository
+	redis  *redis.Client
+	chains *chains.Registry
+
+	secondFactor SecondFactorVerifier
+	attestations *attestations.Signer
 
 	cfg config.Config
 }
@@ -31,10 +39,84 @@ type Service interface {
 	AddWallet(ctx context.Context, userID uint, pubkey string, provider enum.Provider) (dto.ChallengeForUser, error)
 	// VerifyWallet verifies a previously issued challenge signature and marks the wallet as verified.
 	VerifyWallet(ctx context.Context, userID uint, challengeID, signature, pubkey string) error
-	// UnlinkWallet removes a wallet record belonging to the user.
-	UnlinkWallet(ctx context.Context, walletID, userID uint) error
+	// UnlinkWallet removes a wallet record belonging to the user, or returns an unlink challenge
+	// for verified wallets when step-up unlinking is enabled.
+	UnlinkWallet(ctx context.Context, walletID, userID uint) (dto.UnlinkResult, error)
+	// ConfirmUnlink deletes a verified wallet once its unlink challenge is signed or confirmed with a second factor.
+	ConfirmUnlink(ctx context.Context, userID uint, challengeID, signature, secondFactorCode string) error
+	// IssueWalletAttestation returns a signed attestation that the user owns their verified wallet.
+	IssueWalletAttestation(ctx context.Context, userID uint) (dto.WalletAttestation, error)
+	// AttestationKeySet returns the JWKS attestations are verified with.
+	AttestationKeySet(ctx context.Context) attestations.KeySet
+	// AttestationsEnabled reports whether wallet attestations can be issued.
+	AttestationsEnabled(ctx context.Context) bool
 	// GetWallet returns the wallet for the given user.
 	GetWallet(ctx context.Context, userID uint) (dto.Wallet, error)
+	// GetWalletsByUserIDs returns the wallets of a batch of users, marking users without one as not found.
+	GetWalletsByUserIDs(ctx context.Context, userIDs []uint, verifiedOnly bool) ([]dto.UserWalletLookup, error)
+	// GetWalletByPubkey returns the wallet linked to an address, normalized per chain.
+	GetWalletByPubkey(ctx context.Context, pubkey string) (dto.Wallet, error)
+	// GetWalletsByPubkeys returns the wallets linked to a batch of addresses, marking unknown ones as not found.
+	GetWalletsByPubkeys(ctx context.Context, pubkeys []string) ([]dto.PubkeyWalletLookup, error)
+	// WatchWalletChanges streams a snapshot of the wallets followed by live changes, or resumes after a sequence number.
+	WatchWalletChanges(ctx context.Context, provider enum.Provider, verifiedOnly bool, fromSequence uint, send func(dto.WalletChange) error) error
+	// CreateOwnershipChallenge returns a challenge for the verified wallet of the user to sign a statement for another service.
+	CreateOwnershipChallenge(ctx context.Context, userID uint, statement, purpose string) (dto.ChallengeForUser, error)
+	// VerifyOwnership checks the signature of an ownership challenge issued for the same purpose.
+	VerifyOwnership(ctx context.Context, userID uint, challengeID, purpose, signature string) (dto.OwnershipProof, error)
+	// ListVerificationProofs returns the stored verification proofs of a wallet, a user or a pubkey.
+	ListVerificationProofs(ctx context.Context, walletID, userID uint, pubkey string) ([]dto.VerificationProof, error)
+	// ListWalletEvents returns the audit log of a user, a wallet or a pubkey, newest first.
+	ListWalletEvents(ctx context.Context, userID, walletID uint, pubkey string, beforeID uint, limit int) ([]dto.WalletEvent, error)
+
+	// RequestReclaim returns a challenge proving possession of a wallet bound to another account.
+	RequestReclaim(ctx context.Context, userID uint, pubkey string, provider enum.Provider) (dto.ChallengeForUser, error)
+	// ConfirmReclaim verifies a reclaim challenge signature and starts the waiting period.
+	ConfirmReclaim(ctx context.Context, userID uint, challengeID, signature string) (dto.WalletReclaim, error)
+	// ContestReclaim lets the current owner stop a pending reclaim of their wallet.
+	ContestReclaim(ctx context.Context, userID, reclaimID uint) error
+	// CompleteReclaim moves the wallet to the claimant once the waiting period is over.
+	CompleteReclaim(ctx context.Context, userID, reclaimID uint) error
+	// ListReclaims returns reclaims where the user is the current owner or the claimant.
+	ListReclaims(ctx context.Context, userID uint) ([]dto.WalletReclaim, error)
+
+	// InitiateTransfer starts moving a verified wallet of the owner to another account.
+	InitiateTransfer(ctx context.Context, userID, walletID, toUserID uint) (dto.WalletTransfer, error)
+	// AcceptTransfer records the consent of the target account and returns a challenge for the wallet to sign.
+	AcceptTransfer(ctx context.Context, userID, transferID uint) (dto.ChallengeForUser, error)
+	// CompleteTransfer verifies the transfer challenge signature and moves the wallet to the target account.
+	CompleteTransfer(ctx context.Context, userID uint, challengeID, signature string) (dto.WalletTransfer, error)
+	// CancelTransfer lets either party stop a transfer that is still in progress.
+	CancelTransfer(ctx context.Context, userID, transferID uint) error
+	// ListTransfers returns transfers where the user is the owner or the target account.
+	ListTransfers(ctx context.Context, userID uint) ([]dto.WalletTransfer, error)
+
+	// CreateWalletSnapshot captures every wallet whose verification is valid now into a frozen snapshot.
+	CreateWalletSnapshot(ctx context.Context, label string) (dto.WalletSnapshot, error)
+	// GetWalletSnapshot returns a wallet snapshot.
+	GetWalletSnapshot(ctx context.Context, snapshotID uint) (dto.WalletSnapshot, error)
+	// ListWalletSnapshots returns every wallet snapshot, newest first.
+	ListWalletSnapshots(ctx context.Context) ([]dto.WalletSnapshot, error)
+	// ExportWalletSnapshot writes the entries of a wallet snapshot to w in the given format.
+	ExportWalletSnapshot(ctx context.Context, snapshotID uint, format enum.SnapshotFormat, w io.Writer) error
+
+	// CreateAllowlist builds a Merkle allowlist over the wallets of a snapshot.
+	CreateAllowlist(ctx context.Context, spec dto.AllowlistSpec) (dto.Allowlist, error)
+	// GetAllowlist returns an allowlist with its root.
+	GetAllowlist(ctx context.Context, allowlistID uint) (dto.Allowlist, error)
+	// GetAllowlistProof returns the inclusion proof of the verified wallet of the user in an allowlist.
+	GetAllowlistProof(ctx context.Context, userID, allowlistID uint) (dto.AllowlistProof, error)
+
+	// CreateWebhookSubscription subscribes an HTTP(S) endpoint to wallet domain events.
+	CreateWebhookSubscription(ctx context.Context, url string, eventTypes []string, secret string) (dto.WebhookSubscription, error)
+	// ListWebhookSubscriptions returns every webhook subscription without its secret.
+	ListWebhookSubscriptions(ctx context.Context) ([]dto.WebhookSubscription, error)
+	// DeleteWebhookSubscription removes a webhook subscription and drops its pending deliveries.
+	DeleteWebhookSubscription(ctx context.Context, subscriptionID uint) error
+	// ListWebhookDeliveries returns the deliveries of a subscription, newest first.
+	ListWebhookDeliveries(ctx context.Context, subscriptionID uint, status enum.WebhookDeliveryStatus, limit int) ([]dto.WebhookDelivery, error)
+	// ReplayWebhookSubscription schedules the events of a subscription since the given time for delivery again.
+	ReplayWebhookSubscription(ctx context.Context, subscriptionID uint, since time.Time) (int64, error)
 }
 
 // NewService constructs a wallets service instance.
@@ -45,9 +127,10 @@ func NewService(
 	redis *redis.Client,
 ) *ServiceImpl {
 	return &ServiceImpl{
-		lg:    lg,
-		repo:  repo,
-		cfg:   cfg,
-		redis: redis,
+		lg:     lg,
+		repo:   repo,
+		cfg:    cfg,
+		redis:  redis,
+		chains: chains.NewRegistry(cfg),
 	}
 }
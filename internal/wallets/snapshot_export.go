@@ -0,0 +1,96 @@
+package wallets
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+)
+
+// walletSnapshotCSVHeader is the header row of CSV exports. The columns, their order and the formatting
+// of the values are part of the content hash; changing them changes the hash of every snapshot.
+var walletSnapshotCSVHeader = []string{"user_id", "pubkey", "provider", "verified_at"}
+
+// walletSnapshotLine is a JSON Lines export row.
+type walletSnapshotLine struct {
+	UserID     uint   `json:"user_id"`
+	Pubkey     string `json:"pubkey"`
+	Provider   string `json:"provider"`
+	VerifiedAt string `json:"verified_at"`
+}
+
+// walletSnapshotWriter encodes snapshot entries in an export format.
+type walletSnapshotWriter interface {
+	Write(entry dto.WalletSnapshotEntry) error
+	Flush() error
+}
+
+// newWalletSnapshotWriter returns a writer encoding entries to w in the given format.
+func newWalletSnapshotWriter(w io.Writer, format enum.SnapshotFormat) (walletSnapshotWriter, error) {
+	switch format {
+	case enum.SnapshotFormatCSV:
+		csvWriter := csv.NewWriter(w)
+		if err := csvWriter.Write(walletSnapshotCSVHeader); err != nil {
+			return nil, fmt.Errorf("csvWriter.Write: %w", err)
+		}
+		return &walletSnapshotCSVWriter{w: csvWriter}, nil
+	case enum.SnapshotFormatJSONLines:
+		return &walletSnapshotJSONLinesWriter{enc: json.NewEncoder(w)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported snapshot format: %s", format)
+	}
+}
+
+type walletSnapshotCSVWriter struct {
+	w *csv.Writer
+}
+
+func (c *walletSnapshotCSVWriter) Write(entry dto.WalletSnapshotEntry) error {
+	if err := c.w.Write([]string{
+		strconv.FormatUint(uint64(entry.UserID), 10),
+		entry.Pubkey,
+		entry.Provider.String(),
+		formatSnapshotTime(entry.VerifiedAt),
+	}); err != nil {
+		return fmt.Errorf("csvWriter.Write: %w", err)
+	}
+	return nil
+}
+
+func (c *walletSnapshotCSVWriter) Flush() error {
+	c.w.Flush()
+	if err := c.w.Error(); err != nil {
+		return fmt.Errorf("csvWriter.Flush: %w", err)
+	}
+	return nil
+}
+
+type walletSnapshotJSONLinesWriter struct {
+	enc *json.Encoder
+}
+
+func (j *walletSnapshotJSONLinesWriter) Write(entry dto.WalletSnapshotEntry) error {
+	if err := j.enc.Encode(walletSnapshotLine{
+		UserID:     entry.UserID,
+		Pubkey:     entry.Pubkey,
+		Provider:   entry.Provider.String(),
+		VerifiedAt: formatSnapshotTime(entry.VerifiedAt),
+	}); err != nil {
+		return fmt.Errorf("enc.Encode: %w", err)
+	}
+	return nil
+}
+
+func (j *walletSnapshotJSONLinesWriter) Flush() error {
+	return nil
+}
+
+// formatSnapshotTime formats t as RFC 3339 in UTC with second precision.
+func formatSnapshotTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
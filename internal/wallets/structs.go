@@ -3,7 +3,7 @@ package wallets
 type Challenge struct {
 	// UserID is the owner of the wallet being verified.
 	UserID    uint   `json:"user_id"`
-	// PubKey is the wallet public key (base58 string for Solana).
+	// PubKey is the wallet address (base58 string for Solana, G-address for Stellar, bech32 for Cosmos, 0x-prefixed hex for Aptos and Sui).
 	PubKey    string `json:"pubkey"`
 	// Provider identifies the wallet provider (e.g. "phantom").
 	Provider  string `json:"provider"`
@@ -11,4 +11,14 @@ type Challenge struct {
 	Nonce     string `json:"nonce"`
 	// ExpiresAt is a unix timestamp (seconds) after which the challenge is invalid.
 	ExpiresAt int64  `json:"expires_at"`
+	// Format is the message format chosen for the provider; empty for chains with a single format.
+	Format    string `json:"format,omitempty"`
+	// Purpose is the operation the challenge was issued for; empty for wallet verification.
+	Purpose   string `json:"purpose,omitempty"`
+	// ReferenceID is the ID of the record the challenge is bound to, e.g. a wallet transfer.
+	ReferenceID uint `json:"reference_id,omitempty"`
+	// Statement is the custom text the challenge asks the owner to agree to; empty for the built-in purposes.
+	Statement string `json:"statement,omitempty"`
+	// Message is the exact payload returned to the user to sign, kept as evidence once the challenge is redeemed.
+	Message string `json:"message,omitempty"`
 }
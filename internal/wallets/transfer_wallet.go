@@ -0,0 +1,318 @@
+package wallets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/knstch/knstch-libs/log"
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/metrics"
+	"wallets-service/internal/wallets/filters"
+	"wallets-service/internal/wallets/repo"
+)
+
+// activeTransferStatuses are the statuses of a transfer that is still in progress.
+var activeTransferStatuses = []enum.TransferStatus{enum.TransferStatusPending, enum.TransferStatusAccepted}
+
+// InitiateTransfer starts moving a verified wallet of the owner to another account.
+//
+// It is the first step of a transfer that needs consent of both accounts and of the wallet itself:
+//   - the owner calls InitiateTransfer, which notifies the target account;
+//   - the target account calls AcceptTransfer and receives a challenge;
+//   - the target account calls CompleteTransfer with a fresh signature of the challenge, which moves the wallet.
+//
+// Either party can CancelTransfer until it is completed. Errors:
+//   - svcerrs.ErrInvalidData if the target account is the owner;
+//   - svcerrs.ErrDataNotFound if the wallet does not exist or belongs to another user;
+//   - svcerrs.ErrForbidden if the wallet is not verified;
+//   - svcerrs.ErrConflict if another transfer of the wallet is in progress.
+func (s *ServiceImpl) InitiateTransfer(ctx context.Context, userID, walletID, toUserID uint) (dto.WalletTransfer, error) {
+	defer metrics.IncTransferWallet("initiate")
+
+	ctx, span := tracing.StartSpan(ctx, "wallets: InitiateTransfer")
+	defer span.End()
+
+	if toUserID == 0 || toUserID == userID {
+		return dto.WalletTransfer{}, fmt.Errorf("wallet cannot be transferred to its owner: %w", svcerrs.ErrInvalidData)
+	}
+
+	wallet, err := s.repo.GetWallet(ctx, filters.WalletsFilter{
+		ID:     walletID,
+		UserID: userID,
+	})
+	if err != nil {
+		return dto.WalletTransfer{}, fmt.Errorf("repo.GetWallet: %w", err)
+	}
+	if wallet.VerifiedAt == nil {
+		return dto.WalletTransfer{}, fmt.Errorf("wallet is not verified: %w", svcerrs.ErrForbidden)
+	}
+
+	active, err := s.repo.GetTransfer(ctx, filters.TransfersFilter{
+		WalletID: wallet.ID,
+		Statuses: activeTransferStatuses,
+	})
+	switch {
+	case err == nil:
+		if !s.expireTransfer(ctx, active) {
+			return dto.WalletTransfer{}, fmt.Errorf("transfer is already in progress: %w", svcerrs.ErrConflict)
+		}
+	case !errors.Is(err, svcerrs.ErrDataNotFound):
+		return dto.WalletTransfer{}, fmt.Errorf("repo.GetTransfer: %w", err)
+	}
+
+	var transfer dto.WalletTransfer
+	if err = s.repo.Transaction(func(st repo.Repository) error {
+		var err error
+		if transfer, err = st.CreateTransfer(ctx, dto.WalletTransfer{
+			WalletID:   wallet.ID,
+			Pubkey:     wallet.Pubkey,
+			Provider:   wallet.Provider,
+			FromUserID: userID,
+			ToUserID:   toUserID,
+			Status:     enum.TransferStatusPending,
+			ExpiresAt:  time.Now().Add(s.cfg.TransferConfig.TTL),
+		}); err != nil {
+			return fmt.Errorf("st.CreateTransfer: %w", err)
+		}
+
+		if err = notifyTransferInitiated(ctx, st, transfer); err != nil {
+			return fmt.Errorf("notifyTransferInitiated: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return dto.WalletTransfer{}, fmt.Errorf("repo.Transaction: %w", err)
+	}
+
+	return transfer, nil
+}
+
+// AcceptTransfer records the consent of the target account and returns a challenge for the wallet to sign.
+//
+// Calling it again for an accepted transfer issues a new challenge. Errors:
+//   - svcerrs.ErrDataNotFound if the transfer does not exist or targets another user;
+//   - svcerrs.ErrConflict if the transfer is completed or cancelled;
+//   - svcerrs.ErrGone if the transfer has expired.
+func (s *ServiceImpl) AcceptTransfer(ctx context.Context, userID, transferID uint) (dto.ChallengeForUser, error) {
+	defer metrics.IncTransferWallet("accept")
+
+	ctx, span := tracing.StartSpan(ctx, "wallets: AcceptTransfer")
+	defer span.End()
+
+	transfer, err := s.getActiveTransfer(ctx, filters.TransfersFilter{
+		ID:       transferID,
+		ToUserID: userID,
+	})
+	if err != nil {
+		return dto.ChallengeForUser{}, fmt.Errorf("getActiveTransfer: %w", err)
+	}
+
+	challenge, jsonChallenge, err := s.newChallenge(userID, transfer.Pubkey, transfer.Provider, challengePurposeTransfer, transfer.ID)
+	if err != nil {
+		return dto.ChallengeForUser{}, fmt.Errorf("newChallenge: %w", err)
+	}
+
+	if transfer.Status == enum.TransferStatusPending {
+		if err = s.repo.TransitionTransfer(ctx, transfer.ID, []enum.TransferStatus{enum.TransferStatusPending}, enum.TransferStatusAccepted); err != nil {
+			return dto.ChallengeForUser{}, fmt.Errorf("repo.TransitionTransfer: %w", err)
+		}
+	}
+
+	if err = s.storeChallenge(ctx, challenge.ChallengeID, transfer.Pubkey, jsonChallenge); err != nil {
+		return dto.ChallengeForUser{}, fmt.Errorf("storeChallenge: %w", err)
+	}
+
+	return challenge, nil
+}
+
+// CompleteTransfer validates the wallet signature of a transfer challenge and moves the wallet to the target account.
+//
+// The transfer is completed and the wallet is moved in a single transaction; every outstanding challenge of
+// the wallet is invalidated once it commits. The schema has no primary wallet flag, so there is nothing else
+// to reset. Errors:
+//   - svcerrs.ErrDataNotFound if the challenge or the transfer does not exist or belongs to another user;
+//   - svcerrs.ErrConflict if the transfer is not accepted or the target account already has the wallet;
+//   - svcerrs.ErrGone if the transfer has expired or the wallet left the owner in the meantime;
+//   - ErrRelinkCooldown or ErrLinkChurnLimit if the target account may not link the wallet yet.
+func (s *ServiceImpl) CompleteTransfer(ctx context.Context, userID uint, challengeID, signature string) (dto.WalletTransfer, error) {
+	defer metrics.IncTransferWallet("complete")
+
+	ctx, span := tracing.StartSpan(ctx, "wallets: CompleteTransfer")
+	defer span.End()
+
+	challenge, err := s.loadChallenge(ctx, userID, challengeID, challengePurposeTransfer)
+	if err != nil {
+		return dto.WalletTransfer{}, fmt.Errorf("loadChallenge: %w", err)
+	}
+
+	provider, verification, err := s.verifyChallenge(ctx, challengeID, challenge, signature)
+	if err != nil {
+		return dto.WalletTransfer{}, fmt.Errorf("verifyChallenge: %w", err)
+	}
+
+	transfer, err := s.getActiveTransfer(ctx, filters.TransfersFilter{
+		ID:       challenge.ReferenceID,
+		ToUserID: userID,
+	})
+	if err != nil {
+		return dto.WalletTransfer{}, fmt.Errorf("getActiveTransfer: %w", err)
+	}
+	if transfer.Status != enum.TransferStatusAccepted || transfer.Pubkey != challenge.PubKey {
+		return dto.WalletTransfer{}, fmt.Errorf("transfer is %s: %w", transfer.Status, svcerrs.ErrConflict)
+	}
+
+	if err = s.repo.Transaction(func(st repo.Repository) error {
+		if err := st.TransitionTransfer(ctx, transfer.ID, []enum.TransferStatus{enum.TransferStatusAccepted}, enum.TransferStatusCompleted); err != nil {
+			return fmt.Errorf("st.TransitionTransfer: %w", err)
+		}
+
+		if err := st.CreateVerificationProof(ctx, s.newVerificationProof(ctx, transfer.WalletID, userID, challengeID, challenge, provider, verification, signature)); err != nil {
+			return fmt.Errorf("st.CreateVerificationProof: %w", err)
+		}
+
+		if err := s.moveWallet(ctx, st, dto.Wallet{
+			ID:       transfer.WalletID,
+			UserID:   transfer.FromUserID,
+			Pubkey:   transfer.Pubkey,
+			Provider: transfer.Provider,
+		}, transfer.ToUserID, moveReasonTransfer); err != nil {
+			return fmt.Errorf("moveWallet: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		if !errors.Is(err, svcerrs.ErrDataNotFound) {
+			return dto.WalletTransfer{}, fmt.Errorf("repo.Transaction: %w", err)
+		}
+
+		if err := s.repo.TransitionTransfer(ctx, transfer.ID, activeTransferStatuses, enum.TransferStatusCancelled); err != nil {
+			return dto.WalletTransfer{}, fmt.Errorf("repo.TransitionTransfer: %w", err)
+		}
+		return dto.WalletTransfer{}, fmt.Errorf("wallet is no longer owned by the initiating account: %w", svcerrs.ErrGone)
+	}
+
+	// Challenges issued before the move must not be redeemable by either account afterwards.
+	// They are dropped once the move is committed, so a rolled back move keeps them.
+	if err = s.invalidateChallenges(ctx, transfer.Pubkey); err != nil {
+		s.lg.Error("failed to invalidate wallet challenges", err,
+			log.AddMessage("transfer_id", transfer.ID),
+		)
+	}
+
+	completed, err := s.repo.GetTransfer(ctx, filters.TransfersFilter{
+		ID: transfer.ID,
+	})
+	if err != nil {
+		return dto.WalletTransfer{}, fmt.Errorf("repo.GetTransfer: %w", err)
+	}
+
+	return completed, nil
+}
+
+// CancelTransfer lets either party stop a transfer that is still in progress.
+//
+// If the transfer does not exist or the user is not a party to it, CancelTransfer returns an error wrapping
+// svcerrs.ErrDataNotFound. If it is no longer in progress, CancelTransfer returns an error wrapping svcerrs.ErrConflict.
+func (s *ServiceImpl) CancelTransfer(ctx context.Context, userID, transferID uint) error {
+	defer metrics.IncTransferWallet("cancel")
+
+	ctx, span := tracing.StartSpan(ctx, "wallets: CancelTransfer")
+	defer span.End()
+
+	if _, err := s.repo.GetTransfer(ctx, filters.TransfersFilter{
+		ID:            transferID,
+		ParticipantID: userID,
+	}); err != nil {
+		return fmt.Errorf("repo.GetTransfer: %w", err)
+	}
+
+	if err := s.repo.TransitionTransfer(ctx, transferID, activeTransferStatuses, enum.TransferStatusCancelled); err != nil {
+		return fmt.Errorf("repo.TransitionTransfer: %w", err)
+	}
+
+	return nil
+}
+
+// ListTransfers returns transfers where the user is either the owner or the target account, newest first.
+func (s *ServiceImpl) ListTransfers(ctx context.Context, userID uint) ([]dto.WalletTransfer, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: ListTransfers")
+	defer span.End()
+
+	transfers, err := s.repo.ListTransfers(ctx, filters.TransfersFilter{
+		ParticipantID: userID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListTransfers: %w", err)
+	}
+
+	return transfers, nil
+}
+
+// getActiveTransfer returns the transfer matching the filter if it is still in progress and has not expired.
+//
+// An expired transfer is marked as such and reported as svcerrs.ErrGone.
+func (s *ServiceImpl) getActiveTransfer(ctx context.Context, filter filters.TransfersFilter) (dto.WalletTransfer, error) {
+	transfer, err := s.repo.GetTransfer(ctx, filter)
+	if err != nil {
+		return dto.WalletTransfer{}, fmt.Errorf("repo.GetTransfer: %w", err)
+	}
+	if transfer.Status != enum.TransferStatusPending && transfer.Status != enum.TransferStatusAccepted {
+		return dto.WalletTransfer{}, fmt.Errorf("transfer is %s: %w", transfer.Status, svcerrs.ErrConflict)
+	}
+	if s.expireTransfer(ctx, transfer) {
+		return dto.WalletTransfer{}, fmt.Errorf("transfer expired at %s: %w", transfer.ExpiresAt.Format(time.RFC3339), svcerrs.ErrGone)
+	}
+
+	return transfer, nil
+}
+
+// expireTransfer marks an in-progress transfer past its ExpiresAt as expired and reports whether it did.
+//
+// A failure to update the status is logged, since the transfer is treated as expired either way.
+func (s *ServiceImpl) expireTransfer(ctx context.Context, transfer dto.WalletTransfer) bool {
+	if time.Now().Before(transfer.ExpiresAt) {
+		return false
+	}
+
+	if err := s.repo.TransitionTransfer(ctx, transfer.ID, activeTransferStatuses, enum.TransferStatusExpired); err != nil {
+		s.lg.Error("failed to expire wallet transfer", err,
+			log.AddMessage("transfer_id", transfer.ID),
+		)
+	}
+
+	return true
+}
+
+// notifyTransferInitiated tells the target account that a wallet is being transferred to it by publishing
+// a transfer initiated domain event to the outbox and webhook subscribers, within the transaction of st.
+//
+// The target account also sees the transfer in ListTransfers and can accept it until ExpiresAt.
+func notifyTransferInitiated(ctx context.Context, st repo.Repository, transfer dto.WalletTransfer) error {
+	if err := publishDomainEvent(ctx, st, dto.WalletDomainEvent{
+		EventID:    uuid.NewString(),
+		Type:       domainEventTransferInitiated,
+		UserID:     transfer.ToUserID,
+		WalletID:   transfer.WalletID,
+		Pubkey:     transfer.Pubkey,
+		Provider:   transfer.Provider.String(),
+		RequestID:  requestMetaFromContext(ctx).RequestID,
+		OccurredAt: time.Now().UTC(),
+		Metadata: map[string]string{
+			"transfer_id":   strconv.FormatUint(uint64(transfer.ID), 10),
+			"owner_user_id": strconv.FormatUint(uint64(transfer.FromUserID), 10),
+			"expires_at":    transfer.ExpiresAt.UTC().Format(time.RFC3339),
+		},
+	}); err != nil {
+		return fmt.Errorf("publishDomainEvent: %w", err)
+	}
+
+	return nil
+}
@@ -4,27 +4,111 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/knstch/knstch-libs/svcerrs"
 	"github.com/knstch/knstch-libs/tracing"
 
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
 	"wallets-service/internal/metrics"
 	"wallets-service/internal/wallets/filters"
 )
 
+// Unlink confirmations recorded with unlinked wallet events.
+const (
+	unlinkConfirmationNone         = "none"
+	unlinkConfirmationSignature    = "signature"
+	unlinkConfirmationSecondFactor = "second_factor"
+)
+
 // UnlinkWallet deletes a wallet by ID for the given user.
 //
+// When step-up unlinking is enabled, verified wallets are not deleted right away: UnlinkWallet returns
+// an unlink challenge instead, and the wallet is deleted by ConfirmUnlink once the challenge is signed by
+// the wallet or confirmed with a second factor. Unverified wallets are always deleted directly.
+//
+// Every deletion is recorded as an unlink event, which starts the relink cooldown of the pubkey.
+//
 // If the wallet does not exist or does not belong to the user, UnlinkWallet returns an error wrapping svcerrs.ErrDataNotFound.
-func (s *ServiceImpl) UnlinkWallet(ctx context.Context, walletID, userID uint) error {
+func (s *ServiceImpl) UnlinkWallet(ctx context.Context, walletID, userID uint) (dto.UnlinkResult, error) {
 	defer metrics.IncUnlinkWallet()
 
 	ctx, span := tracing.StartSpan(ctx, "wallets: UnlinkWallet")
 	defer span.End()
 
-	if err := s.repo.DeleteWallet(ctx, filters.WalletsFilter{
+	wallet, err := s.repo.GetWallet(ctx, filters.WalletsFilter{
 		ID:     walletID,
 		UserID: userID,
-	}); err != nil {
-		return fmt.Errorf("repo.DeleteWallet: %w", err)
+	})
+	if err != nil {
+		return dto.UnlinkResult{}, fmt.Errorf("repo.GetWallet: %w", err)
+	}
+
+	if s.cfg.UnlinkConfig.StepUp && wallet.VerifiedAt != nil {
+		challenge, jsonChallenge, err := s.newChallenge(userID, wallet.Pubkey, wallet.Provider, challengePurposeUnlink, wallet.ID)
+		if err != nil {
+			return dto.UnlinkResult{}, fmt.Errorf("newChallenge: %w", err)
+		}
+		if err = s.storeChallenge(ctx, challenge.ChallengeID, wallet.Pubkey, jsonChallenge); err != nil {
+			return dto.UnlinkResult{}, fmt.Errorf("storeChallenge: %w", err)
+		}
+
+		return dto.UnlinkResult{
+			Challenge: challenge,
+		}, nil
+	}
+
+	if err = s.removeWallet(ctx, wallet, unlinkConfirmationNone); err != nil {
+		return dto.UnlinkResult{}, fmt.Errorf("removeWallet: %w", err)
 	}
 
+	return dto.UnlinkResult{
+		Unlinked: true,
+	}, nil
+}
+
+// ConfirmUnlink deletes a verified wallet once its unlink challenge is confirmed.
+//
+// The challenge is confirmed either by the wallet signature or, if secondFactorCode is set, by the
+// SecondFactorVerifier of the service. Errors:
+//   - svcerrs.ErrDataNotFound if the challenge or the wallet does not exist or belongs to another user;
+//   - svcerrs.ErrInvalidData if a second factor code is passed but no SecondFactorVerifier is set.
+func (s *ServiceImpl) ConfirmUnlink(ctx context.Context, userID uint, challengeID, signature, secondFactorCode string) error {
+	ctx, span := tracing.StartSpan(ctx, "wallets: ConfirmUnlink")
+	defer span.End()
+
+	challenge, err := s.loadChallenge(ctx, userID, challengeID, challengePurposeUnlink)
+	if err != nil {
+		return fmt.Errorf("loadChallenge: %w", err)
+	}
+
+	provider, err := enum.GetProvider(challenge.Provider)
+	if err != nil {
+		return fmt.Errorf("enum.GetProvider: %w", err)
+	}
+
+	confirmation := unlinkConfirmationSignature
+	if secondFactorCode != "" {
+		if s.secondFactor == nil {
+			return fmt.Errorf("second factor confirmation is not configured: %w", svcerrs.ErrInvalidData)
+		}
+		if err = s.secondFactor.VerifySecondFactor(ctx, userID, secondFactorCode); err != nil {
+			return fmt.Errorf("secondFactor.VerifySecondFactor: %w", err)
+		}
+		confirmation = unlinkConfirmationSecondFactor
+	} else if _, _, err = s.verifyChallenge(ctx, challengeID, challenge, signature); err != nil {
+		return fmt.Errorf("verifyChallenge: %w", err)
+	}
+
+	if err = s.removeWallet(ctx, dto.Wallet{
+		ID:       challenge.ReferenceID,
+		UserID:   userID,
+		Pubkey:   challenge.PubKey,
+		Provider: provider,
+	}, confirmation); err != nil {
+		return fmt.Errorf("removeWallet: %w", err)
+	}
+
+	s.deleteChallenge(ctx, challengeID)
+
 	return nil
 }
@@ -0,0 +1,38 @@
+package wallets
+
+import (
+	"time"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+)
+
+// withVerificationStatus derives the verification status and expiry of the wallet at the current time.
+func (s *ServiceImpl) withVerificationStatus(wallet dto.Wallet) dto.Wallet {
+	wallet.VerificationStatus, wallet.VerificationExpiresAt = s.verificationStatus(wallet.VerifiedAt, time.Now())
+	return wallet
+}
+
+// verificationStatus returns the status of a verification made at verifiedAt, as seen at now, and when it expires.
+//
+// Verifications never expire if no validity period is configured.
+func (s *ServiceImpl) verificationStatus(verifiedAt *time.Time, now time.Time) (enum.VerificationStatus, *time.Time) {
+	if verifiedAt == nil {
+		return enum.VerificationStatusUnverified, nil
+	}
+
+	validity := s.cfg.VerificationConfig.Validity
+	if validity <= 0 {
+		return enum.VerificationStatusVerified, nil
+	}
+
+	expiresAt := verifiedAt.Add(validity)
+	switch {
+	case !now.Before(expiresAt):
+		return enum.VerificationStatusExpired, &expiresAt
+	case !now.Before(expiresAt.Add(-s.cfg.VerificationConfig.RenewalWindow)):
+		return enum.VerificationStatusExpiringSoon, &expiresAt
+	default:
+		return enum.VerificationStatusVerified, &expiresAt
+	}
+}
@@ -0,0 +1,71 @@
+package wallets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/chains"
+	"wallets-service/internal/wallets/filters"
+)
+
+// newVerificationProof returns the proof of a redeemed challenge that showed userID owns the wallet.
+func (s *ServiceImpl) newVerificationProof(
+	ctx context.Context,
+	walletID, userID uint,
+	challengeID string,
+	challenge Challenge,
+	provider enum.Provider,
+	verification chains.Verification,
+	signature string,
+) dto.VerificationProof {
+	meta := requestMetaFromContext(ctx)
+
+	return dto.VerificationProof{
+		WalletID:        walletID,
+		UserID:          userID,
+		Pubkey:          challenge.PubKey,
+		Provider:        provider,
+		ChallengeID:     challengeID,
+		Nonce:           challenge.Nonce,
+		ExpiresAt:       challenge.ExpiresAt,
+		Format:          enum.MessageFormat(challenge.Format),
+		Statement:       challenge.Statement,
+		Message:         []byte(challenge.Message),
+		SignedPayload:   verification.SignedPayload,
+		Signature:       signature,
+		Encoding:        verification.Encoding.String(),
+		VerifierVersion: chains.VerifierVersion,
+		IPHash:          s.hashClientValue(meta.IP),
+		UserAgentHash:   s.hashClientValue(meta.UserAgent),
+		VerifiedAt:      verification.CheckedAt,
+	}
+}
+
+// ListVerificationProofs returns the stored verification proofs of a wallet, a user or a pubkey, oldest first.
+//
+// At least one of walletID, userID and pubkey must be set, otherwise ListVerificationProofs returns an error
+// wrapping svcerrs.ErrInvalidData.
+func (s *ServiceImpl) ListVerificationProofs(ctx context.Context, walletID, userID uint, pubkey string) ([]dto.VerificationProof, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: ListVerificationProofs")
+	defer span.End()
+
+	if walletID == 0 && userID == 0 && pubkey == "" {
+		return nil, fmt.Errorf("wallet ID, user ID or pubkey is required: %w", svcerrs.ErrInvalidData)
+	}
+
+	proofs, err := s.repo.ListVerificationProofs(ctx, filters.VerificationProofsFilter{
+		WalletID: walletID,
+		UserID:   userID,
+		Pubkey:   pubkey,
+	}, 0)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListVerificationProofs: %w", err)
+	}
+
+	return proofs, nil
+}
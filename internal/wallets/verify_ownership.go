@@ -0,0 +1,121 @@
+package wallets
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/filters"
+)
+
+const (
+	maxOwnershipStatementLen = 2000
+	maxOwnershipPurposeLen   = 100
+)
+
+// CreateOwnershipChallenge returns a challenge asking the verified wallet of the user to sign a statement
+// on behalf of another service, e.g. to accept terms or claim a vesting schedule.
+//
+// The challenge can only be redeemed by VerifyOwnership with the same purpose. Errors:
+//   - svcerrs.ErrInvalidData if the statement or purpose is malformed, or the wallet cannot sign statements;
+//   - svcerrs.ErrDataNotFound if the user has no wallet;
+//   - svcerrs.ErrForbidden if the wallet is unverified or its verification expired.
+func (s *ServiceImpl) CreateOwnershipChallenge(ctx context.Context, userID uint, statement, purpose string) (dto.ChallengeForUser, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: CreateOwnershipChallenge")
+	defer span.End()
+
+	if statement == "" || len(statement) > maxOwnershipStatementLen {
+		return dto.ChallengeForUser{}, fmt.Errorf("statement must have 1 to %d bytes: %w", maxOwnershipStatementLen, svcerrs.ErrInvalidData)
+	}
+	if err := validateOwnershipPurpose(purpose); err != nil {
+		return dto.ChallengeForUser{}, fmt.Errorf("validateOwnershipPurpose: %w", err)
+	}
+
+	wallet, err := s.repo.GetWallet(ctx, filters.WalletsFilter{UserID: userID})
+	if err != nil {
+		return dto.ChallengeForUser{}, fmt.Errorf("repo.GetWallet: %w", err)
+	}
+	wallet = s.withVerificationStatus(wallet)
+	if wallet.VerificationStatus == enum.VerificationStatusUnverified || wallet.VerificationStatus == enum.VerificationStatusExpired {
+		return dto.ChallengeForUser{}, fmt.Errorf("wallet is not verified: %w", svcerrs.ErrForbidden)
+	}
+
+	challenge, jsonChallenge, err := s.newStatementChallenge(userID, wallet.Pubkey, wallet.Provider, challengePurposeOwnershipPrefix+purpose, wallet.ID, statement)
+	if err != nil {
+		return dto.ChallengeForUser{}, fmt.Errorf("newStatementChallenge: %w", err)
+	}
+	if err = s.storeChallenge(ctx, challenge.ChallengeID, wallet.Pubkey, jsonChallenge); err != nil {
+		return dto.ChallengeForUser{}, fmt.Errorf("storeChallenge: %w", err)
+	}
+
+	return challenge, nil
+}
+
+// VerifyOwnership checks the signature of an ownership challenge and returns the proof.
+//
+// The challenge is redeemed on success. Errors:
+//   - svcerrs.ErrDataNotFound if the challenge does not exist, expired, belongs to another user or purpose,
+//     or the wallet was unlinked since;
+//   - svcerrs.ErrInvalidData if the signature is invalid.
+func (s *ServiceImpl) VerifyOwnership(ctx context.Context, userID uint, challengeID, purpose, signature string) (dto.OwnershipProof, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: VerifyOwnership")
+	defer span.End()
+
+	if err := validateOwnershipPurpose(purpose); err != nil {
+		return dto.OwnershipProof{}, fmt.Errorf("validateOwnershipPurpose: %w", err)
+	}
+
+	challenge, err := s.loadChallenge(ctx, userID, challengeID, challengePurposeOwnershipPrefix+purpose)
+	if err != nil {
+		return dto.OwnershipProof{}, fmt.Errorf("loadChallenge: %w", err)
+	}
+
+	if _, err = s.repo.GetWallet(ctx, filters.WalletsFilter{
+		ID:     challenge.ReferenceID,
+		UserID: userID,
+		Pubkey: challenge.PubKey,
+	}); err != nil {
+		return dto.OwnershipProof{}, fmt.Errorf("repo.GetWallet: %w", err)
+	}
+
+	provider, verification, err := s.verifyChallenge(ctx, challengeID, challenge, signature)
+	if err != nil {
+		return dto.OwnershipProof{}, fmt.Errorf("verifyChallenge: %w", err)
+	}
+
+	s.deleteChallenge(ctx, challengeID)
+
+	return dto.OwnershipProof{
+		ChallengeID: challengeID,
+		UserID:      userID,
+		WalletID:    challenge.ReferenceID,
+		Pubkey:      challenge.PubKey,
+		Provider:    provider,
+		Purpose:     purpose,
+		Statement:   challenge.Statement,
+		Message:     challenge.Message,
+		Signature:   signature,
+		Encoding:    verification.Encoding.String(),
+		VerifiedAt:  time.Now().UTC(),
+	}, nil
+}
+
+// validateOwnershipPurpose checks that purpose is a short identifier such as "terms:v3" or "vesting.claim".
+func validateOwnershipPurpose(purpose string) error {
+	if purpose == "" || len(purpose) > maxOwnershipPurposeLen {
+		return fmt.Errorf("purpose must have 1 to %d characters: %w", maxOwnershipPurposeLen, svcerrs.ErrInvalidData)
+	}
+	for _, r := range purpose {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '_', r == '-', r == ':':
+		default:
+			return fmt.Errorf("purpose may only contain letters, digits and . _ - : characters: %w", svcerrs.ErrInvalidData)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package wallets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/filters"
+	"wallets-service/internal/wallets/repo"
+)
+
+const (
+	defaultWalletEventsLimit = 50
+	maxWalletEventsLimit     = 200
+)
+
+const (
+	// domainEventReclaimRequested is published to the current owner of a wallet when a reclaim of it is confirmed.
+	domainEventReclaimRequested = "wallet.reclaim_requested"
+	// domainEventTransferInitiated is published to the target account of a transfer when the owner starts it.
+	domainEventTransferInitiated = "wallet.transfer_initiated"
+)
+
+// walletEventsLockKey is the Postgres advisory lock that serializes transactions writing wallet events.
+// Event IDs then become visible in increasing order, which lets watchers use them as sequence numbers.
+//
+// The lock is global, so transactions writing wallet events commit one at a time. recordWalletEvents takes it
+// right before the last statements of the transaction to keep it held for the event inserts and the commit only.
+// That is cheap at the rate wallets change; a commit-ordered sequence would be needed to lift it.
+const walletEventsLockKey int64 = 0x77616c6c65747365 // "walletse"
+
+// newWalletEvent returns an audit log event for a change the user made to their wallet,
+// tagged with the ID of the request being served.
+func newWalletEvent(ctx context.Context, eventType enum.WalletEventType, wallet dto.Wallet, metadata map[string]string) dto.WalletEvent {
+	return dto.WalletEvent{
+		Type:      eventType,
+		UserID:    wallet.UserID,
+		WalletID:  wallet.ID,
+		Pubkey:    wallet.Pubkey,
+		Provider:  wallet.Provider,
+		Actor:     enum.WalletEventActorUser,
+		RequestID: requestMetaFromContext(ctx).RequestID,
+		Metadata:  metadata,
+	}
+}
+
+// recordWalletEvents appends the events to the wallet audit log in order, puts the matching domain events
+// into the outbox and schedules them for webhook subscribers, within the transaction of st.
+//
+// The audit log is written last under walletEventsLockKey, which is held until st commits, so
+// recordWalletEvents must be the last database write of the transaction.
+func recordWalletEvents(ctx context.Context, st repo.Repository, events ...dto.WalletEvent) error {
+	for _, event := range events {
+		if err := publishDomainEvent(ctx, st, dto.WalletDomainEvent{
+			EventID:    uuid.NewString(),
+			Type:       "wallet." + event.Type.String(),
+			UserID:     event.UserID,
+			WalletID:   event.WalletID,
+			Pubkey:     event.Pubkey,
+			Provider:   event.Provider.String(),
+			RequestID:  event.RequestID,
+			OccurredAt: time.Now().UTC(),
+		}); err != nil {
+			return fmt.Errorf("publishDomainEvent: %w", err)
+		}
+	}
+
+	if err := st.AdvisoryLock(ctx, walletEventsLockKey); err != nil {
+		return fmt.Errorf("st.AdvisoryLock: %w", err)
+	}
+
+	for _, event := range events {
+		if err := st.CreateWalletEvent(ctx, event); err != nil {
+			return fmt.Errorf("st.CreateWalletEvent: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// publishDomainEvent puts the domain event into the outbox and schedules it for webhook subscribers,
+// within the transaction of st.
+func publishDomainEvent(ctx context.Context, st repo.Repository, domainEvent dto.WalletDomainEvent) error {
+	payload, err := json.Marshal(domainEvent)
+	if err != nil {
+		return fmt.Errorf("json.Marshal: %w", err)
+	}
+
+	if err = st.CreateOutboxMessage(ctx, dto.OutboxMessage{
+		EventID: domainEvent.EventID,
+		Type:    domainEvent.Type,
+		UserID:  domainEvent.UserID,
+		Payload: payload,
+	}); err != nil {
+		return fmt.Errorf("st.CreateOutboxMessage: %w", err)
+	}
+
+	if err = st.CreateWebhookDeliveries(ctx, domainEvent.EventID, domainEvent.Type, payload); err != nil {
+		return fmt.Errorf("st.CreateWebhookDeliveries: %w", err)
+	}
+
+	return nil
+}
+
+// ListWalletEvents returns the audit log of a user, a wallet or a pubkey, newest first.
+//
+// At least one of userID, walletID and pubkey must be set, otherwise ListWalletEvents returns an error
+// wrapping svcerrs.ErrInvalidData. beforeID pages through older events; limit defaults to 50 and
+// is capped at 200.
+func (s *ServiceImpl) ListWalletEvents(ctx context.Context, userID, walletID uint, pubkey string, beforeID uint, limit int) ([]dto.WalletEvent, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: ListWalletEvents")
+	defer span.End()
+
+	if userID == 0 && walletID == 0 && pubkey == "" {
+		return nil, fmt.Errorf("user ID, wallet ID or pubkey is required: %w", svcerrs.ErrInvalidData)
+	}
+
+	switch {
+	case limit <= 0:
+		limit = defaultWalletEventsLimit
+	case limit > maxWalletEventsLimit:
+		limit = maxWalletEventsLimit
+	}
+
+	events, err := s.repo.ListWalletEvents(ctx, filters.WalletEventsFilter{
+		UserID:   userID,
+		WalletID: walletID,
+		Pubkey:   pubkey,
+		BeforeID: beforeID,
+	}, limit)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListWalletEvents: %w", err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,171 @@
+package wallets
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/filters"
+	"wallets-service/internal/wallets/repo"
+)
+
+const (
+	maxWalletSnapshotLabelLen = 200
+	// walletSnapshotExportPageSize is the number of entries read from Postgres at a time during an export.
+	walletSnapshotExportPageSize = 1000
+)
+
+// CreateWalletSnapshot captures every wallet whose verification is valid now into a frozen snapshot.
+//
+// Entries are ordered by the bytes of their pubkeys, and the snapshot records the SHA-256 of its CSV export,
+// so a published export can be checked against it.
+func (s *ServiceImpl) CreateWalletSnapshot(ctx context.Context, label string) (dto.WalletSnapshot, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: CreateWalletSnapshot")
+	defer span.End()
+
+	if len(label) > maxWalletSnapshotLabelLen {
+		return dto.WalletSnapshot{}, fmt.Errorf("label must be at most %d bytes: %w", maxWalletSnapshotLabelLen, svcerrs.ErrInvalidData)
+	}
+
+	var snapshot dto.WalletSnapshot
+	if err := s.repo.Transaction(func(st repo.Repository) error {
+		takenAt := time.Now().UTC()
+
+		wallets, err := st.ListWallets(ctx, filters.WalletsFilter{IsVerified: filters.BoolPtr(true)}, 0)
+		if err != nil {
+			return fmt.Errorf("st.ListWallets: %w", err)
+		}
+
+		entries := make([]dto.WalletSnapshotEntry, 0, len(wallets))
+		for _, wallet := range wallets {
+			if status, _ := s.verificationStatus(wallet.VerifiedAt, takenAt); status == enum.VerificationStatusExpired {
+				continue
+			}
+			entries = append(entries, dto.WalletSnapshotEntry{
+				UserID:     wallet.UserID,
+				WalletID:   wallet.ID,
+				Pubkey:     wallet.Pubkey,
+				Provider:   wallet.Provider,
+				VerifiedAt: *wallet.VerifiedAt,
+			})
+		}
+		slices.SortFunc(entries, func(a, b dto.WalletSnapshotEntry) int {
+			return strings.Compare(a.Pubkey, b.Pubkey)
+		})
+
+		hash := sha256.New()
+		writer, err := newWalletSnapshotWriter(hash, enum.SnapshotFormatCSV)
+		if err != nil {
+			return fmt.Errorf("newWalletSnapshotWriter: %w", err)
+		}
+		for _, entry := range entries {
+			if err = writer.Write(entry); err != nil {
+				return fmt.Errorf("writer.Write: %w", err)
+			}
+		}
+		if err = writer.Flush(); err != nil {
+			return fmt.Errorf("writer.Flush: %w", err)
+		}
+
+		if snapshot, err = st.CreateWalletSnapshot(ctx, dto.WalletSnapshot{
+			Label:       label,
+			TakenAt:     takenAt,
+			WalletCount: len(entries),
+			ContentHash: hex.EncodeToString(hash.Sum(nil)),
+		}, entries); err != nil {
+			return fmt.Errorf("st.CreateWalletSnapshot: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return dto.WalletSnapshot{}, fmt.Errorf("repo.Transaction: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// GetWalletSnapshot returns the snapshot with the given ID.
+//
+// If it does not exist, GetWalletSnapshot returns an error wrapping svcerrs.ErrDataNotFound.
+func (s *ServiceImpl) GetWalletSnapshot(ctx context.Context, snapshotID uint) (dto.WalletSnapshot, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: GetWalletSnapshot")
+	defer span.End()
+
+	snapshot, err := s.repo.GetWalletSnapshot(ctx, snapshotID)
+	if err != nil {
+		return dto.WalletSnapshot{}, fmt.Errorf("repo.GetWalletSnapshot: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+// ListWalletSnapshots returns every snapshot, newest first.
+func (s *ServiceImpl) ListWalletSnapshots(ctx context.Context) ([]dto.WalletSnapshot, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: ListWalletSnapshots")
+	defer span.End()
+
+	snapshots, err := s.repo.ListWalletSnapshots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListWalletSnapshots: %w", err)
+	}
+
+	return snapshots, nil
+}
+
+// ExportWalletSnapshot writes the entries of a snapshot to w in the given format, ordered by the bytes
+// of their pubkeys. The SHA-256 of the CSV export equals the content hash of the snapshot.
+//
+// If the snapshot does not exist, ExportWalletSnapshot returns an error wrapping svcerrs.ErrDataNotFound.
+func (s *ServiceImpl) ExportWalletSnapshot(ctx context.Context, snapshotID uint, format enum.SnapshotFormat, w io.Writer) error {
+	ctx, span := tracing.StartSpan(ctx, "wallets: ExportWalletSnapshot")
+	defer span.End()
+
+	if _, err := enum.GetSnapshotFormat(format.String()); err != nil {
+		return fmt.Errorf("enum.GetSnapshotFormat: %w", errors.Join(err, svcerrs.ErrInvalidData))
+	}
+
+	if _, err := s.repo.GetWalletSnapshot(ctx, snapshotID); err != nil {
+		return fmt.Errorf("repo.GetWalletSnapshot: %w", err)
+	}
+
+	writer, err := newWalletSnapshotWriter(w, format)
+	if err != nil {
+		return fmt.Errorf("newWalletSnapshotWriter: %w", err)
+	}
+
+	var afterPubkey string
+	for {
+		entries, err := s.repo.ListWalletSnapshotEntries(ctx, snapshotID, afterPubkey, walletSnapshotExportPageSize)
+		if err != nil {
+			return fmt.Errorf("repo.ListWalletSnapshotEntries: %w", err)
+		}
+
+		for _, entry := range entries {
+			if err = writer.Write(entry); err != nil {
+				return fmt.Errorf("writer.Write: %w", err)
+			}
+		}
+
+		if len(entries) < walletSnapshotExportPageSize {
+			break
+		}
+		afterPubkey = entries[len(entries)-1].Pubkey
+	}
+
+	if err = writer.Flush(); err != nil {
+		return fmt.Errorf("writer.Flush: %w", err)
+	}
+
+	return nil
+}
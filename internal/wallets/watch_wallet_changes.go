@@ -0,0 +1,160 @@
+package wallets
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/knstch/knstch-libs/tracing"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/filters"
+)
+
+// WatchWalletChanges streams wallet changes to send until ctx is cancelled or send fails.
+//
+// If fromSequence is zero, the stream starts with a snapshot of the current wallets followed by a snapshot
+// end marker; otherwise it resumes with the changes after fromSequence. Live changes follow in increasing
+// sequence order. provider narrows the stream to one provider; verifiedOnly leaves out unverified wallets and
+// the added changes. Changes overlapping the snapshot may be sent again, so clients apply them as upserts
+// and deletes by pubkey.
+func (s *ServiceImpl) WatchWalletChanges(
+	ctx context.Context,
+	provider enum.Provider,
+	verifiedOnly bool,
+	fromSequence uint,
+	send func(dto.WalletChange) error,
+) error {
+	ctx, span := tracing.StartSpan(ctx, "wallets: WatchWalletChanges")
+	defer span.End()
+
+	sequence := fromSequence
+	if sequence == 0 {
+		var err error
+		if sequence, err = s.sendWalletsSnapshot(ctx, provider, verifiedOnly, send); err != nil {
+			return fmt.Errorf("sendWalletsSnapshot: %w", err)
+		}
+	}
+
+	ticker := time.NewTicker(s.cfg.WatchConfig.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		events, err := s.repo.ListWalletEventsAfter(ctx, sequence, provider, s.cfg.WatchConfig.BatchSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("repo.ListWalletEventsAfter: %w", err)
+		}
+
+		for _, event := range events {
+			sequence = event.ID
+
+			change, ok := s.walletChangeFromEvent(event, verifiedOnly)
+			if !ok {
+				continue
+			}
+			if err = send(change); err != nil {
+				return fmt.Errorf("send: %w", err)
+			}
+		}
+
+		// A full batch means more events are waiting.
+		if s.cfg.WatchConfig.BatchSize > 0 && len(events) == s.cfg.WatchConfig.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendWalletsSnapshot sends every wallet matching the filters followed by the snapshot end marker,
+// and returns the sequence the snapshot was taken at.
+func (s *ServiceImpl) sendWalletsSnapshot(ctx context.Context, provider enum.Provider, verifiedOnly bool, send func(dto.WalletChange) error) (uint, error) {
+	// Events written while the wallets are read are sent again after the snapshot.
+	sequence, err := s.repo.GetLastWalletEventID(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("repo.GetLastWalletEventID: %w", err)
+	}
+
+	filter := filters.WalletsFilter{Provider: provider}
+	if verifiedOnly {
+		filter.IsVerified = filters.BoolPtr(true)
+	}
+
+	for {
+		wallets, err := s.repo.ListWallets(ctx, filter, s.cfg.WatchConfig.BatchSize)
+		if err != nil {
+			return 0, fmt.Errorf("repo.ListWallets: %w", err)
+		}
+
+		for _, wallet := range wallets {
+			filter.AfterID = wallet.ID
+
+			wallet = s.withVerificationStatus(wallet)
+			if verifiedOnly && wallet.VerificationStatus == enum.VerificationStatusExpired {
+				continue
+			}
+			if err = send(dto.WalletChange{
+				Sequence: sequence,
+				Type:     enum.WalletChangeSnapshot,
+				Wallet:   wallet,
+			}); err != nil {
+				return 0, fmt.Errorf("send: %w", err)
+			}
+		}
+
+		if s.cfg.WatchConfig.BatchSize <= 0 || len(wallets) < s.cfg.WatchConfig.BatchSize {
+			break
+		}
+	}
+
+	if err = send(dto.WalletChange{
+		Sequence: sequence,
+		Type:     enum.WalletChangeSnapshotEnd,
+	}); err != nil {
+		return 0, fmt.Errorf("send: %w", err)
+	}
+
+	return sequence, nil
+}
+
+// walletChangeFromEvent converts a wallet event to a change, reporting false if the stream leaves it out.
+func (s *ServiceImpl) walletChangeFromEvent(event dto.WalletEvent, verifiedOnly bool) (dto.WalletChange, bool) {
+	wallet := dto.Wallet{
+		ID:       event.WalletID,
+		UserID:   event.UserID,
+		Pubkey:   event.Pubkey,
+		Provider: event.Provider,
+	}
+
+	var changeType enum.WalletChangeType
+	switch event.Type {
+	case enum.WalletEventAdded:
+		if verifiedOnly {
+			return dto.WalletChange{}, false
+		}
+		changeType = enum.WalletChangeAdded
+		wallet.CreatedAt = event.CreatedAt
+	case enum.WalletEventVerified:
+		changeType = enum.WalletChangeVerified
+		verifiedAt := event.CreatedAt
+		wallet.VerifiedAt = &verifiedAt
+	case enum.WalletEventUnlinked:
+		changeType = enum.WalletChangeUnlinked
+	default:
+		return dto.WalletChange{}, false
+	}
+
+	return dto.WalletChange{
+		Sequence: event.ID,
+		Type:     changeType,
+		Wallet:   s.withVerificationStatus(wallet),
+	}, true
+}
@@ -0,0 +1,162 @@
+package wallets
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/tracing"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets/filters"
+)
+
+const (
+	webhookSecretBytes = 32
+
+	defaultWebhookDeliveriesLimit = 50
+	maxWebhookDeliveriesLimit     = 200
+)
+
+// webhookEventTypes lists the domain event types a webhook subscription can receive.
+var webhookEventTypes = map[string]struct{}{
+	"wallet." + enum.WalletEventAdded.String():    {},
+	"wallet." + enum.WalletEventVerified.String(): {},
+	"wallet." + enum.WalletEventUnlinked.String(): {},
+	domainEventReclaimRequested:                   {},
+	domainEventTransferInitiated:                  {},
+}
+
+// CreateWebhookSubscription subscribes an HTTP(S) endpoint to the given wallet domain event types.
+//
+// If secret is empty, a random one is generated. The returned subscription is the only place the secret
+// is handed out.
+func (s *ServiceImpl) CreateWebhookSubscription(ctx context.Context, rawURL string, eventTypes []string, secret string) (dto.WebhookSubscription, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: CreateWebhookSubscription")
+	defer span.End()
+
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return dto.WebhookSubscription{}, fmt.Errorf("webhook URL must be an absolute http(s) URL: %w", svcerrs.ErrInvalidData)
+	}
+
+	if len(eventTypes) == 0 {
+		return dto.WebhookSubscription{}, fmt.Errorf("at least one event type is required: %w", svcerrs.ErrInvalidData)
+	}
+	seen := make(map[string]struct{}, len(eventTypes))
+	unique := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if _, ok := webhookEventTypes[eventType]; !ok {
+			return dto.WebhookSubscription{}, fmt.Errorf("unknown event type %q: %w", eventType, svcerrs.ErrInvalidData)
+		}
+		if _, ok := seen[eventType]; ok {
+			continue
+		}
+		seen[eventType] = struct{}{}
+		unique = append(unique, eventType)
+	}
+
+	if secret == "" {
+		b := make([]byte, webhookSecretBytes)
+		if _, err = rand.Read(b); err != nil {
+			return dto.WebhookSubscription{}, fmt.Errorf("rand.Read: %w", err)
+		}
+		secret = hex.EncodeToString(b)
+	}
+
+	subscription, err := s.repo.CreateWebhookSubscription(ctx, dto.WebhookSubscription{
+		URL:        u.String(),
+		EventTypes: unique,
+		Secret:     secret,
+	})
+	if err != nil {
+		return dto.WebhookSubscription{}, fmt.Errorf("repo.CreateWebhookSubscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+// ListWebhookSubscriptions returns every webhook subscription without its secret.
+func (s *ServiceImpl) ListWebhookSubscriptions(ctx context.Context) ([]dto.WebhookSubscription, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: ListWebhookSubscriptions")
+	defer span.End()
+
+	subscriptions, err := s.repo.ListWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListWebhookSubscriptions: %w", err)
+	}
+
+	for i := range subscriptions {
+		subscriptions[i].Secret = ""
+	}
+
+	return subscriptions, nil
+}
+
+// DeleteWebhookSubscription removes a webhook subscription and drops its pending deliveries.
+func (s *ServiceImpl) DeleteWebhookSubscription(ctx context.Context, subscriptionID uint) error {
+	ctx, span := tracing.StartSpan(ctx, "wallets: DeleteWebhookSubscription")
+	defer span.End()
+
+	if err := s.repo.DeleteWebhookSubscription(ctx, subscriptionID); err != nil {
+		return fmt.Errorf("repo.DeleteWebhookSubscription: %w", err)
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveries returns the deliveries of a subscription, newest first, optionally narrowed to a status.
+//
+// limit defaults to 50 and is capped at 200.
+func (s *ServiceImpl) ListWebhookDeliveries(ctx context.Context, subscriptionID uint, status enum.WebhookDeliveryStatus, limit int) ([]dto.WebhookDelivery, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: ListWebhookDeliveries")
+	defer span.End()
+
+	if subscriptionID == 0 {
+		return nil, fmt.Errorf("subscription ID is required: %w", svcerrs.ErrInvalidData)
+	}
+
+	switch {
+	case limit <= 0:
+		limit = defaultWebhookDeliveriesLimit
+	case limit > maxWebhookDeliveriesLimit:
+		limit = maxWebhookDeliveriesLimit
+	}
+
+	deliveries, err := s.repo.ListWebhookDeliveries(ctx, filters.WebhookDeliveriesFilter{
+		SubscriptionID: subscriptionID,
+		Status:         status,
+	}, limit)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListWebhookDeliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// ReplayWebhookSubscription schedules every event the subscription is subscribed to that occurred at or after since
+// for delivery again, including delivered and dead ones. It returns the number of scheduled deliveries.
+func (s *ServiceImpl) ReplayWebhookSubscription(ctx context.Context, subscriptionID uint, since time.Time) (int64, error) {
+	ctx, span := tracing.StartSpan(ctx, "wallets: ReplayWebhookSubscription")
+	defer span.End()
+
+	if since.IsZero() {
+		return 0, fmt.Errorf("replay start is required: %w", svcerrs.ErrInvalidData)
+	}
+
+	if _, err := s.repo.GetWebhookSubscription(ctx, subscriptionID); err != nil {
+		return 0, fmt.Errorf("repo.GetWebhookSubscription: %w", err)
+	}
+
+	scheduled, err := s.repo.ReplayWebhookDeliveries(ctx, subscriptionID, since)
+	if err != nil {
+		return 0, fmt.Errorf("repo.ReplayWebhookDeliveries: %w", err)
+	}
+
+	return scheduled, nil
+}
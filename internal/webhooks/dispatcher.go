@@ -0,0 +1,188 @@
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/knstch/knstch-libs/log"
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/config"
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/metrics"
+	"wallets-service/internal/wallets/repo"
+)
+
+// maxLastErrorLen caps the stored failure reason in bytes, which may include a subscriber response body.
+const maxLastErrorLen = 512
+
+// Dispatcher delivers scheduled webhook deliveries with at-least-once semantics.
+//
+// Every attempt is signed with the subscription secret. A 2xx response marks the delivery delivered;
+// any other outcome is retried with exponential backoff until MaxAttempts, after which the delivery
+// is moved to the dead-letter state and only a replay sends it again.
+type Dispatcher struct {
+	lg     *log.Logger
+	repo   repo.Repository
+	client *http.Client
+	cfg    config.WebhooksConfig
+}
+
+// NewDispatcher constructs a Dispatcher. If client is nil, a client with cfg.Timeout is used.
+func NewDispatcher(lg *log.Logger, repo repo.Repository, client *http.Client, cfg config.WebhooksConfig) *Dispatcher {
+	if client == nil {
+		client = &http.Client{Timeout: cfg.Timeout}
+	}
+
+	return &Dispatcher{
+		lg:     lg,
+		repo:   repo,
+		client: client,
+		cfg:    cfg,
+	}
+}
+
+// Run delivers due webhooks every PollInterval until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		for {
+			sent, err := d.DeliverDue(ctx)
+			if err != nil {
+				d.lg.Error("webhook dispatch failed", err)
+				break
+			}
+			if sent < d.cfg.BatchSize {
+				break
+			}
+		}
+	}
+}
+
+// DeliverDue makes one attempt for every due delivery and returns how many attempts were made.
+//
+// An outcome that can't be recorded is logged and leaves the delivery claimed until the claim runs out,
+// after which it is attempted again; the rest of the batch is still delivered.
+func (d *Dispatcher) DeliverDue(ctx context.Context) (int, error) {
+	// The claim postpones the deliveries for as long as the attempts may take, so a concurrent
+	// dispatcher does not pick them up meanwhile.
+	deliveries, err := d.repo.ClaimWebhookDeliveries(ctx, d.cfg.BatchSize, d.cfg.Timeout*time.Duration(d.cfg.BatchSize+1))
+	if err != nil {
+		return 0, fmt.Errorf("repo.ClaimWebhookDeliveries: %w", err)
+	}
+
+	subscriptions := make(map[uint]dto.WebhookSubscription)
+	for _, delivery := range deliveries {
+		subscription, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			if subscription, err = d.repo.GetWebhookSubscription(ctx, delivery.SubscriptionID); err != nil {
+				// The subscription was deleted after the claim, together with its deliveries.
+				if errors.Is(err, svcerrs.ErrDataNotFound) {
+					continue
+				}
+				return 0, fmt.Errorf("repo.GetWebhookSubscription: %w", err)
+			}
+			subscriptions[delivery.SubscriptionID] = subscription
+		}
+
+		statusCode, err := d.send(ctx, subscription, delivery)
+		if err == nil {
+			metrics.IncWebhookDelivery("delivered")
+			if err = d.repo.MarkWebhookDeliveryDelivered(ctx, delivery.ID, statusCode); err != nil {
+				d.lg.Error("failed to mark webhook delivery delivered", err,
+					log.AddMessage("delivery_id", delivery.ID),
+				)
+			}
+			continue
+		}
+
+		attempt := delivery.Attempts + 1
+		dead := attempt >= d.cfg.MaxAttempts
+		if dead {
+			metrics.IncWebhookDelivery("dead")
+		} else {
+			metrics.IncWebhookDelivery("failed")
+		}
+
+		if err = d.repo.MarkWebhookDeliveryFailed(ctx, delivery.ID, statusCode, sanitizeLastError(err.Error()), time.Now().Add(d.backoff(attempt)), dead); err != nil {
+			d.lg.Error("failed to mark webhook delivery failed", err,
+				log.AddMessage("delivery_id", delivery.ID),
+			)
+		}
+	}
+
+	return len(deliveries), nil
+}
+
+// send posts the signed delivery to the subscription URL and returns the response status code.
+func (d *Dispatcher) send(ctx context.Context, subscription dto.WebhookSubscription, delivery dto.WebhookDelivery) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, d.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderID, delivery.EventID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(subscription.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("client.Do: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxLastErrorLen))
+		return resp.StatusCode, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, body)
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return resp.StatusCode, nil
+}
+
+// sanitizeLastError makes a failure reason storable in a Postgres text column: invalid UTF-8 and NUL bytes,
+// which a subscriber response body may contain, are dropped and the result is cut to maxLastErrorLen bytes
+// without splitting a character.
+func sanitizeLastError(lastError string) string {
+	lastError = strings.ReplaceAll(strings.ToValidUTF8(lastError, ""), "\x00", "")
+	if len(lastError) <= maxLastErrorLen {
+		return lastError
+	}
+
+	cut := maxLastErrorLen
+	for cut > 0 && !utf8.RuneStart(lastError[cut]) {
+		cut--
+	}
+	return lastError[:cut]
+}
+
+// backoff returns the delay before the next attempt: RetryMinBackoff doubled for every failed attempt,
+// capped at RetryMaxBackoff.
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	delay := d.cfg.RetryMinBackoff
+	for i := 1; i < attempt && delay < d.cfg.RetryMaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, d.cfg.RetryMaxBackoff)
+}
@@ -0,0 +1,31 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+const (
+	// HeaderID carries the event ID, which stays the same across retries and replays.
+	HeaderID = "Wallets-Webhook-Id"
+	// HeaderTimestamp carries the Unix time the delivery attempt was signed at.
+	HeaderTimestamp = "Wallets-Webhook-Timestamp"
+	// HeaderSignature carries the signature of the delivery, see Sign.
+	HeaderSignature = "Wallets-Webhook-Signature"
+
+	signatureVersion = "v1="
+)
+
+// Sign returns the signature of a webhook delivery: "v1=" followed by the hex encoded HMAC-SHA256
+// of "<timestamp>.<body>" keyed by the subscription secret.
+//
+// Subscribers recompute it to authenticate the delivery and reject stale timestamps to prevent replays.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signatureVersion + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddUserWalletsProviderCheck, downAddUserWalletsProviderCheck)
+}
+
+func upAddUserWalletsProviderCheck(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+			ALTER TABLE user_wallets
+			  ADD CONSTRAINT user_wallets_provider_check CHECK (provider IN (
+			    'phantom',
+			    'solflare',
+			    'backpack',
+			    'glow',
+			    'ledger',
+			    'wallet_standard',
+			    'stellar',
+			    'cosmos',
+			    'aptos',
+			    'sui'
+			  ));
+`); err != nil {
+		return err
+	}
+	return nil
+}
+
+func downAddUserWalletsProviderCheck(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`ALTER TABLE user_wallets DROP CONSTRAINT IF EXISTS user_wallets_provider_check;`); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upInitWalletReclaimsTable, downInitWalletReclaimsTable)
+}
+
+func upInitWalletReclaimsTable(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+			CREATE TABLE wallet_reclaims (
+			  id BIGSERIAL PRIMARY KEY,
+			  wallet_id BIGINT NOT NULL,
+			  pubkey TEXT NOT NULL,
+			  provider TEXT NOT NULL,
+			  from_user_id BIGINT NOT NULL,
+			  to_user_id BIGINT NOT NULL,
+			  status TEXT NOT NULL,
+			  available_at TIMESTAMPTZ NOT NULL,
+			  resolved_at TIMESTAMPTZ,
+			  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+			  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+			);
+
+			-- Only one reclaim per wallet can be in progress at a time.
+			CREATE UNIQUE INDEX wallet_reclaims_pending_pubkey_idx ON wallet_reclaims (pubkey) WHERE status = 'pending';
+			CREATE INDEX wallet_reclaims_from_user_id_idx ON wallet_reclaims (from_user_id);
+			CREATE INDEX wallet_reclaims_to_user_id_idx ON wallet_reclaims (to_user_id);
+`); err != nil {
+		return err
+	}
+	return nil
+}
+
+func downInitWalletReclaimsTable(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`DROP TABLE IF EXISTS wallet_reclaims;`); err != nil {
+		return err
+	}
+	return nil
+}
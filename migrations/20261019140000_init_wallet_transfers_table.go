@@ -0,0 +1,47 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upInitWalletTransfersTable, downInitWalletTransfersTable)
+}
+
+func upInitWalletTransfersTable(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+			CREATE TABLE wallet_transfers (
+			  id BIGSERIAL PRIMARY KEY,
+			  wallet_id BIGINT NOT NULL,
+			  pubkey TEXT NOT NULL,
+			  provider TEXT NOT NULL,
+			  from_user_id BIGINT NOT NULL,
+			  to_user_id BIGINT NOT NULL,
+			  status TEXT NOT NULL,
+			  expires_at TIMESTAMPTZ NOT NULL,
+			  accepted_at TIMESTAMPTZ,
+			  resolved_at TIMESTAMPTZ,
+			  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+			  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+			);
+
+			-- Only one transfer per wallet can be in progress at a time.
+			CREATE UNIQUE INDEX wallet_transfers_active_wallet_id_idx ON wallet_transfers (wallet_id)
+			  WHERE status IN ('pending', 'accepted');
+			CREATE INDEX wallet_transfers_from_user_id_idx ON wallet_transfers (from_user_id);
+			CREATE INDEX wallet_transfers_to_user_id_idx ON wallet_transfers (to_user_id);
+`); err != nil {
+		return err
+	}
+	return nil
+}
+
+func downInitWalletTransfersTable(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`DROP TABLE IF EXISTS wallet_transfers;`); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upInitWalletLinkEventsTable, downInitWalletLinkEventsTable)
+}
+
+func upInitWalletLinkEventsTable(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+			CREATE TABLE wallet_link_events (
+			  id BIGSERIAL PRIMARY KEY,
+			  user_id BIGINT NOT NULL,
+			  pubkey TEXT NOT NULL,
+			  action TEXT NOT NULL CHECK (action IN ('link', 'unlink')),
+			  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+			);
+
+			CREATE INDEX wallet_link_events_pubkey_created_at_idx ON wallet_link_events (pubkey, created_at);
+			CREATE INDEX wallet_link_events_user_id_created_at_idx ON wallet_link_events (user_id, created_at);
+`); err != nil {
+		return err
+	}
+	return nil
+}
+
+func downInitWalletLinkEventsTable(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`DROP TABLE IF EXISTS wallet_link_events;`); err != nil {
+		return err
+	}
+	return nil
+}
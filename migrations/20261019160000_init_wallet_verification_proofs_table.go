@@ -0,0 +1,49 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upInitWalletVerificationProofsTable, downInitWalletVerificationProofsTable)
+}
+
+func upInitWalletVerificationProofsTable(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+			CREATE TABLE wallet_verification_proofs (
+			  id BIGSERIAL PRIMARY KEY,
+			  wallet_id BIGINT NOT NULL,
+			  user_id BIGINT NOT NULL,
+			  pubkey TEXT NOT NULL,
+			  provider TEXT NOT NULL,
+			  challenge_id TEXT NOT NULL UNIQUE,
+			  nonce TEXT NOT NULL,
+			  expires_at BIGINT NOT NULL,
+			  format TEXT NOT NULL DEFAULT '',
+			  message BYTEA NOT NULL,
+			  signature TEXT NOT NULL,
+			  encoding TEXT NOT NULL,
+			  verifier_version TEXT NOT NULL,
+			  ip_hash TEXT NOT NULL DEFAULT '',
+			  user_agent_hash TEXT NOT NULL DEFAULT '',
+			  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+			);
+
+			CREATE INDEX wallet_verification_proofs_wallet_id_idx ON wallet_verification_proofs (wallet_id);
+			CREATE INDEX wallet_verification_proofs_user_id_idx ON wallet_verification_proofs (user_id);
+			CREATE INDEX wallet_verification_proofs_pubkey_idx ON wallet_verification_proofs (pubkey);
+`); err != nil {
+		return err
+	}
+	return nil
+}
+
+func downInitWalletVerificationProofsTable(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`DROP TABLE IF EXISTS wallet_verification_proofs;`); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upInitWalletEventsTable, downInitWalletEventsTable)
+}
+
+func upInitWalletEventsTable(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+			CREATE TABLE wallet_events (
+			  id BIGSERIAL PRIMARY KEY,
+			  type TEXT NOT NULL CHECK (type IN ('added', 'verified', 'unlinked')),
+			  user_id BIGINT NOT NULL,
+			  wallet_id BIGINT NOT NULL,
+			  pubkey TEXT NOT NULL,
+			  provider TEXT NOT NULL,
+			  actor TEXT NOT NULL,
+			  request_id TEXT NOT NULL DEFAULT '',
+			  metadata JSONB NOT NULL DEFAULT '{}',
+			  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+			);
+
+			CREATE INDEX wallet_events_user_id_id_idx ON wallet_events (user_id, id);
+			CREATE INDEX wallet_events_wallet_id_id_idx ON wallet_events (wallet_id, id);
+			CREATE INDEX wallet_events_pubkey_id_idx ON wallet_events (pubkey, id);
+
+			-- The log is append-only.
+			CREATE FUNCTION wallet_events_append_only() RETURNS trigger AS $$
+			BEGIN
+			  RAISE EXCEPTION 'wallet_events is append-only';
+			END;
+			$$ LANGUAGE plpgsql;
+
+			CREATE TRIGGER wallet_events_append_only
+			  BEFORE UPDATE OR DELETE ON wallet_events
+			  FOR EACH ROW EXECUTE FUNCTION wallet_events_append_only();
+`); err != nil {
+		return err
+	}
+	return nil
+}
+
+func downInitWalletEventsTable(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+			DROP TABLE IF EXISTS wallet_events;
+			DROP FUNCTION IF EXISTS wallet_events_append_only();
+`); err != nil {
+		return err
+	}
+	return nil
+}
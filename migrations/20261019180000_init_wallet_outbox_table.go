@@ -0,0 +1,41 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upInitWalletOutboxTable, downInitWalletOutboxTable)
+}
+
+func upInitWalletOutboxTable(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+			CREATE TABLE wallet_outbox (
+			  id BIGSERIAL PRIMARY KEY,
+			  event_id TEXT NOT NULL UNIQUE,
+			  type TEXT NOT NULL,
+			  user_id BIGINT NOT NULL,
+			  payload JSONB NOT NULL,
+			  attempts INT NOT NULL DEFAULT 0,
+			  next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+			  last_error TEXT NOT NULL DEFAULT '',
+			  published_at TIMESTAMPTZ,
+			  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+			);
+
+			CREATE INDEX wallet_outbox_pending_user_id_id_idx ON wallet_outbox (user_id, id) WHERE published_at IS NULL;
+`); err != nil {
+		return err
+	}
+	return nil
+}
+
+func downInitWalletOutboxTable(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`DROP TABLE IF EXISTS wallet_outbox;`); err != nil {
+		return err
+	}
+	return nil
+}
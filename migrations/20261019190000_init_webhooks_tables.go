@@ -0,0 +1,59 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upInitWebhooksTables, downInitWebhooksTables)
+}
+
+func upInitWebhooksTables(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+			CREATE TABLE webhook_subscriptions (
+			  id BIGSERIAL PRIMARY KEY,
+			  url TEXT NOT NULL,
+			  event_types JSONB NOT NULL,
+			  secret TEXT NOT NULL,
+			  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+			  updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+			);
+
+			CREATE TABLE webhook_deliveries (
+			  id BIGSERIAL PRIMARY KEY,
+			  subscription_id BIGINT NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+			  event_id TEXT NOT NULL,
+			  event_type TEXT NOT NULL,
+			  payload JSONB NOT NULL,
+			  status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'dead')),
+			  attempts INT NOT NULL DEFAULT 0,
+			  next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+			  last_error TEXT NOT NULL DEFAULT '',
+			  last_status_code INT NOT NULL DEFAULT 0,
+			  delivered_at TIMESTAMPTZ,
+			  created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+			  updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+			  UNIQUE (subscription_id, event_id)
+			);
+
+			CREATE INDEX webhook_deliveries_pending_next_attempt_at_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+			CREATE INDEX wallet_outbox_created_at_idx ON wallet_outbox (created_at);
+`); err != nil {
+		return err
+	}
+	return nil
+}
+
+func downInitWebhooksTables(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+			DROP INDEX IF EXISTS wallet_outbox_created_at_idx;
+			DROP TABLE IF EXISTS webhook_deliveries;
+			DROP TABLE IF EXISTS webhook_subscriptions;
+`); err != nil {
+		return err
+	}
+	return nil
+}
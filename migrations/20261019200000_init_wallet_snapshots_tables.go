@@ -0,0 +1,64 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upInitWalletSnapshotsTables, downInitWalletSnapshotsTables)
+}
+
+func upInitWalletSnapshotsTables(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+			CREATE TABLE wallet_snapshots (
+			  id BIGSERIAL PRIMARY KEY,
+			  label TEXT NOT NULL DEFAULT '',
+			  taken_at TIMESTAMPTZ NOT NULL,
+			  wallet_count INT NOT NULL,
+			  content_hash TEXT NOT NULL,
+			  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+			);
+
+			CREATE TABLE wallet_snapshot_entries (
+			  snapshot_id BIGINT NOT NULL REFERENCES wallet_snapshots (id),
+			  user_id BIGINT NOT NULL,
+			  wallet_id BIGINT NOT NULL,
+			  pubkey TEXT NOT NULL,
+			  provider TEXT NOT NULL,
+			  verified_at TIMESTAMPTZ NOT NULL,
+			  PRIMARY KEY (snapshot_id, pubkey)
+			);
+
+			-- Snapshots are frozen once taken.
+			CREATE FUNCTION wallet_snapshots_immutable() RETURNS trigger AS $$
+			BEGIN
+			  RAISE EXCEPTION '% is immutable', TG_TABLE_NAME;
+			END;
+			$$ LANGUAGE plpgsql;
+
+			CREATE TRIGGER wallet_snapshots_immutable
+			  BEFORE UPDATE OR DELETE ON wallet_snapshots
+			  FOR EACH ROW EXECUTE FUNCTION wallet_snapshots_immutable();
+
+			CREATE TRIGGER wallet_snapshot_entries_immutable
+			  BEFORE UPDATE OR DELETE ON wallet_snapshot_entries
+			  FOR EACH ROW EXECUTE FUNCTION wallet_snapshots_immutable();
+`); err != nil {
+		return err
+	}
+	return nil
+}
+
+func downInitWalletSnapshotsTables(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+			DROP TABLE IF EXISTS wallet_snapshot_entries;
+			DROP TABLE IF EXISTS wallet_snapshots;
+			DROP FUNCTION IF EXISTS wallet_snapshots_immutable();
+`); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upInitAllowlistsTables, downInitAllowlistsTables)
+}
+
+func upInitAllowlistsTables(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+			CREATE TABLE allowlists (
+			  id BIGSERIAL PRIMARY KEY,
+			  snapshot_id BIGINT NOT NULL REFERENCES wallet_snapshots (id),
+			  label TEXT NOT NULL DEFAULT '',
+			  hash_function TEXT NOT NULL,
+			  leaf_encoding TEXT NOT NULL,
+			  root TEXT NOT NULL,
+			  leaf_count INT NOT NULL,
+			  created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+			);
+
+			CREATE TABLE allowlist_leaves (
+			  allowlist_id BIGINT NOT NULL REFERENCES allowlists (id),
+			  position INT NOT NULL,
+			  user_id BIGINT NOT NULL,
+			  wallet_id BIGINT NOT NULL,
+			  pubkey TEXT NOT NULL,
+			  provider TEXT NOT NULL,
+			  amount TEXT NOT NULL DEFAULT '',
+			  PRIMARY KEY (allowlist_id, pubkey)
+			);
+
+			CREATE TABLE allowlist_nodes (
+			  allowlist_id BIGINT NOT NULL REFERENCES allowlists (id),
+			  level INT NOT NULL,
+			  position INT NOT NULL,
+			  hash BYTEA NOT NULL,
+			  PRIMARY KEY (allowlist_id, level, position)
+			);
+
+			-- A root may already be published on-chain, so allowlists are frozen like their snapshots.
+			CREATE TRIGGER allowlists_immutable
+			  BEFORE UPDATE OR DELETE ON allowlists
+			  FOR EACH ROW EXECUTE FUNCTION wallet_snapshots_immutable();
+
+			CREATE TRIGGER allowlist_leaves_immutable
+			  BEFORE UPDATE OR DELETE ON allowlist_leaves
+			  FOR EACH ROW EXECUTE FUNCTION wallet_snapshots_immutable();
+
+			CREATE TRIGGER allowlist_nodes_immutable
+			  BEFORE UPDATE OR DELETE ON allowlist_nodes
+			  FOR EACH ROW EXECUTE FUNCTION wallet_snapshots_immutable();
+`); err != nil {
+		return err
+	}
+	return nil
+}
+
+func downInitAllowlistsTables(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+			DROP TABLE IF EXISTS allowlist_nodes;
+			DROP TABLE IF EXISTS allowlist_leaves;
+			DROP TABLE IF EXISTS allowlists;
+`); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddVerificationProofsSignedPayload, downAddVerificationProofsSignedPayload)
+}
+
+func upAddVerificationProofsSignedPayload(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+			ALTER TABLE wallet_verification_proofs
+			  ADD COLUMN signed_payload BYTEA NOT NULL DEFAULT '';
+`); err != nil {
+		return err
+	}
+	return nil
+}
+
+func downAddVerificationProofsSignedPayload(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`ALTER TABLE wallet_verification_proofs DROP COLUMN IF EXISTS signed_payload;`); err != nil {
+		return err
+	}
+	return nil
+}
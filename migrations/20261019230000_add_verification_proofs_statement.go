@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddVerificationProofsStatement, downAddVerificationProofsStatement)
+}
+
+func upAddVerificationProofsStatement(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+			ALTER TABLE wallet_verification_proofs
+			  ADD COLUMN statement TEXT NOT NULL DEFAULT '';
+`); err != nil {
+		return err
+	}
+	return nil
+}
+
+func downAddVerificationProofsStatement(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`ALTER TABLE wallet_verification_proofs DROP COLUMN IF EXISTS statement;`); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upAddVerificationProofsVerifiedAt, downAddVerificationProofsVerifiedAt)
+}
+
+func upAddVerificationProofsVerifiedAt(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`
+			ALTER TABLE wallet_verification_proofs
+			  ADD COLUMN verified_at TIMESTAMPTZ;
+`); err != nil {
+		return err
+	}
+	return nil
+}
+
+func downAddVerificationProofsVerifiedAt(_ context.Context, tx *sql.Tx) error {
+	if _, err := tx.Exec(`ALTER TABLE wallet_verification_proofs DROP COLUMN IF EXISTS verified_at;`); err != nil {
+		return err
+	}
+	return nil
+}
@@ -22,5 +22,5 @@ func (s *Cleaner) Clean() error {
 
 	// Postgres: wipe all service tables for a clean slate between tests.
 	// Note: RESTART IDENTITY makes BIGSERIAL deterministic across tests.
-	return s.db.Exec("TRUNCATE TABLE user_wallets RESTART IDENTITY CASCADE").Error
+	return s.db.Exec("TRUNCATE TABLE user_wallets, wallet_reclaims, wallet_transfers, wallet_link_events, wallet_verification_proofs, wallet_events, wallet_outbox, webhook_subscriptions, webhook_deliveries, wallet_snapshots, wallet_snapshot_entries, allowlists, allowlist_leaves, allowlist_nodes RESTART IDENTITY CASCADE").Error
 }
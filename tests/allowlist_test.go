@@ -0,0 +1,173 @@
+package wallets_test
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"strings"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/require"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/merkle"
+)
+
+func mustDecodeHexHash(t *require.Assertions, s string) []byte {
+	t.True(strings.HasPrefix(s, "0x"))
+	b, err := hex.DecodeString(s[2:])
+	t.NoError(err)
+	return b
+}
+
+func requireAllowlistProofValid(t *require.Assertions, proof dto.AllowlistProof, expectedLeaf []byte) {
+	t.Equal(expectedLeaf, mustDecodeHexHash(t, proof.Leaf.Hash))
+
+	siblings := make([][]byte, 0, len(proof.Proof))
+	for _, sibling := range proof.Proof {
+		siblings = append(siblings, mustDecodeHexHash(t, sibling))
+	}
+	t.True(merkle.Verify(proof.Allowlist.Hash, mustDecodeHexHash(t, proof.Allowlist.Root), expectedLeaf, siblings))
+}
+
+func (s *WalletsServiceTestSuite) TestAllowlist_ProofsVerifyAgainstRoot() {
+	t := s.Require()
+
+	// An odd number of leaves exercises promoted nodes.
+	pubkeys := make(map[uint]string)
+	for userID := uint(1); userID <= 5; userID++ {
+		pubkeys[userID], _ = s.mustAddVerifiedSolanaWallet(userID)
+	}
+
+	snapshot, err := s.svc.CreateWalletSnapshot(context.Background(), "")
+	t.NoError(err)
+
+	allowlist, err := s.svc.CreateAllowlist(context.Background(), dto.AllowlistSpec{
+		SnapshotID: snapshot.ID,
+		Label:      "claim",
+		Hash:       enum.MerkleHashKeccak256,
+		Encoding:   enum.LeafEncodingAddress,
+	})
+	t.NoError(err)
+	t.NotZero(allowlist.ID)
+	t.Equal(5, allowlist.LeafCount)
+	t.Len(mustDecodeHexHash(t, allowlist.Root), 32)
+
+	stored, err := s.svc.GetAllowlist(context.Background(), allowlist.ID)
+	t.NoError(err)
+	t.Equal(allowlist.Root, stored.Root)
+
+	for userID, pubkey := range pubkeys {
+		proof, err := s.svc.GetAllowlistProof(context.Background(), userID, allowlist.ID)
+		t.NoError(err)
+		t.Equal(pubkey, proof.Leaf.Pubkey)
+		t.Equal(userID, proof.Leaf.UserID)
+		t.Empty(proof.Leaf.Amount)
+
+		address, err := base58.Decode(pubkey)
+		t.NoError(err)
+		requireAllowlistProofValid(t, proof, merkle.Sum(enum.MerkleHashKeccak256, address))
+	}
+}
+
+func (s *WalletsServiceTestSuite) TestAllowlist_Amounts() {
+	t := s.Require()
+
+	pubkey1, _ := s.mustAddVerifiedSolanaWallet(1)
+	pubkey2, _ := s.mustAddVerifiedSolanaWallet(2)
+	s.mustAddVerifiedSolanaWallet(3)
+
+	snapshot, err := s.svc.CreateWalletSnapshot(context.Background(), "")
+	t.NoError(err)
+
+	allowlist, err := s.svc.CreateAllowlist(context.Background(), dto.AllowlistSpec{
+		SnapshotID: snapshot.ID,
+		Hash:       enum.MerkleHashSHA256,
+		Encoding:   enum.LeafEncodingAddressAmountU64LE,
+		Allocations: map[string]string{
+			pubkey1: "1000",
+			pubkey2: "25",
+		},
+	})
+	t.NoError(err)
+	// Wallets without an allocation get the zero default and are left out.
+	t.Equal(2, allowlist.LeafCount)
+
+	proof, err := s.svc.GetAllowlistProof(context.Background(), 1, allowlist.ID)
+	t.NoError(err)
+	t.Equal("1000", proof.Leaf.Amount)
+	address, err := base58.Decode(pubkey1)
+	t.NoError(err)
+	requireAllowlistProofValid(t, proof, merkle.Sum(enum.MerkleHashSHA256, address, binary.LittleEndian.AppendUint64(nil, 1000)))
+
+	_, err = s.svc.GetAllowlistProof(context.Background(), 3, allowlist.ID)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+
+	withDefault, err := s.svc.CreateAllowlist(context.Background(), dto.AllowlistSpec{
+		SnapshotID:    snapshot.ID,
+		Hash:          enum.MerkleHashKeccak256,
+		Encoding:      enum.LeafEncodingAddressAmountU256BE,
+		DefaultAmount: "7",
+		Allocations:   map[string]string{pubkey2: "0"},
+	})
+	t.NoError(err)
+	t.Equal(2, withDefault.LeafCount)
+
+	proof, err = s.svc.GetAllowlistProof(context.Background(), 3, withDefault.ID)
+	t.NoError(err)
+	t.Equal("7", proof.Leaf.Amount)
+
+	_, err = s.svc.GetAllowlistProof(context.Background(), 2, withDefault.ID)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+}
+
+func (s *WalletsServiceTestSuite) TestAllowlist_Errors() {
+	t := s.Require()
+
+	_, err := s.svc.CreateAllowlist(context.Background(), dto.AllowlistSpec{
+		SnapshotID: 42,
+		Hash:       enum.MerkleHashKeccak256,
+		Encoding:   enum.LeafEncodingAddress,
+	})
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+
+	pubkey, _ := s.mustAddVerifiedSolanaWallet(1)
+	snapshot, err := s.svc.CreateWalletSnapshot(context.Background(), "")
+	t.NoError(err)
+
+	for _, spec := range []dto.AllowlistSpec{
+		{SnapshotID: snapshot.ID, Hash: "md5", Encoding: enum.LeafEncodingAddress},
+		{SnapshotID: snapshot.ID, Hash: enum.MerkleHashSHA256, Encoding: "packed"},
+		{SnapshotID: snapshot.ID, Hash: enum.MerkleHashSHA256, Encoding: enum.LeafEncodingAddress, DefaultAmount: "1"},
+		{SnapshotID: snapshot.ID, Hash: enum.MerkleHashSHA256, Encoding: enum.LeafEncodingAddressAmountU64LE, DefaultAmount: "-1"},
+		{SnapshotID: snapshot.ID, Hash: enum.MerkleHashSHA256, Encoding: enum.LeafEncodingAddressAmountU64LE, DefaultAmount: "18446744073709551616"},
+		{SnapshotID: snapshot.ID, Hash: enum.MerkleHashSHA256, Encoding: enum.LeafEncodingAddressAmountU64LE, Allocations: map[string]string{"not-an-address": "1"}},
+		// Every wallet has a zero allocation, so the tree would be empty.
+		{SnapshotID: snapshot.ID, Hash: enum.MerkleHashSHA256, Encoding: enum.LeafEncodingAddressAmountU64LE, Allocations: map[string]string{pubkey: "0"}},
+		{SnapshotID: snapshot.ID, Hash: enum.MerkleHashSHA256, Encoding: enum.LeafEncodingAddress, Providers: []enum.Provider{enum.ProviderStellar}},
+	} {
+		_, err = s.svc.CreateAllowlist(context.Background(), spec)
+		requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+	}
+
+	allowlist, err := s.svc.CreateAllowlist(context.Background(), dto.AllowlistSpec{
+		SnapshotID: snapshot.ID,
+		Hash:       enum.MerkleHashSHA256,
+		Encoding:   enum.LeafEncodingAddress,
+	})
+	t.NoError(err)
+
+	unverified, _ := mustGenerateSolanaKeypair(t)
+	_, err = s.svc.AddWallet(context.Background(), 2, unverified, enum.ProviderPhantom)
+	t.NoError(err)
+	_, err = s.svc.GetAllowlistProof(context.Background(), 2, allowlist.ID)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrForbidden)
+
+	_, err = s.svc.GetAllowlistProof(context.Background(), 3, allowlist.ID)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+
+	_, err = s.svc.GetAllowlistProof(context.Background(), 1, 42)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+}
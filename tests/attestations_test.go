@@ -0,0 +1,210 @@
+package wallets_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	publicApi "github.com/knstch/wallets-ido-api/public"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wallets-service/config"
+	"wallets-service/internal/attestations"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets"
+)
+
+// mustGenerateAttestationKey returns a base64 encoded Ed25519 seed and public key.
+func mustGenerateAttestationKey(t *require.Assertions) (seed, pub string) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	t.NoError(err)
+	return base64.StdEncoding.EncodeToString(privateKey.Seed()), base64.StdEncoding.EncodeToString(publicKey)
+}
+
+func (s *WalletsServiceTestSuite) newServiceWithAttestations(cfg config.Config, attestationCfg config.AttestationConfig) *wallets.ServiceImpl {
+	t := s.Require()
+	signer, err := attestations.NewSigner(attestationCfg)
+	t.NoError(err)
+
+	svc := wallets.NewService(s.logger, s.dbRepo, cfg, s.rdb)
+	svc.SetAttestationSigner(signer)
+	return svc
+}
+
+func (s *WalletsServiceTestSuite) TestWalletAttestation_VerifiesOffline() {
+	t := s.Require()
+	seed, _ := mustGenerateAttestationKey(t)
+	svc := s.newServiceWithAttestations(s.cfg, config.AttestationConfig{SigningKey: seed, Issuer: "wallets-test", TTL: time.Hour})
+
+	pubkey, _ := s.mustAddVerifiedSolanaWallet(7)
+	w, err := svc.GetWallet(context.Background(), 7)
+	t.NoError(err)
+
+	attestation, err := svc.IssueWalletAttestation(context.Background(), 7)
+	t.NoError(err)
+	t.WithinDuration(time.Now().Add(time.Hour), attestation.ExpiresAt, 5*time.Second)
+
+	keySet := svc.AttestationKeySet(context.Background())
+	t.Len(keySet.Keys, 1)
+	t.Equal("OKP", keySet.Keys[0].Kty)
+	t.Equal("Ed25519", keySet.Keys[0].Crv)
+	t.Equal("EdDSA", keySet.Keys[0].Alg)
+
+	claims, err := keySet.Verify(attestation.Token, time.Now())
+	t.NoError(err)
+	t.Equal("wallets-test", claims.Issuer)
+	t.Equal(strconv.Itoa(7), claims.Subject)
+	t.Equal(uint(7), claims.UserID)
+	t.Equal(pubkey, claims.Pubkey)
+	t.Equal("phantom", claims.Provider)
+	t.Equal(w.VerifiedAt.Unix(), claims.VerifiedAt)
+	t.Equal(attestation.ExpiresAt.Unix(), claims.ExpiresAt)
+
+	_, err = keySet.Verify(attestation.Token, attestation.ExpiresAt)
+	t.True(errors.Is(err, attestations.ErrInvalidToken))
+
+	// Tokens signed with keys outside the set do not verify.
+	otherSeed, _ := mustGenerateAttestationKey(t)
+	other := s.newServiceWithAttestations(s.cfg, config.AttestationConfig{SigningKey: otherSeed, TTL: time.Hour})
+	forged, err := other.IssueWalletAttestation(context.Background(), 7)
+	t.NoError(err)
+	_, err = keySet.Verify(forged.Token, time.Now())
+	t.True(errors.Is(err, attestations.ErrInvalidToken))
+}
+
+func (s *WalletsServiceTestSuite) TestWalletAttestation_KeyRotation() {
+	t := s.Require()
+	oldSeed, oldPub := mustGenerateAttestationKey(t)
+	newSeed, _ := mustGenerateAttestationKey(t)
+	s.mustAddVerifiedSolanaWallet(1)
+
+	before := s.newServiceWithAttestations(s.cfg, config.AttestationConfig{SigningKey: oldSeed, TTL: time.Hour})
+	oldAttestation, err := before.IssueWalletAttestation(context.Background(), 1)
+	t.NoError(err)
+
+	rotated := s.newServiceWithAttestations(s.cfg, config.AttestationConfig{SigningKey: newSeed, RetiredKeys: []string{oldPub}, TTL: time.Hour})
+	keySet := rotated.AttestationKeySet(context.Background())
+	t.Len(keySet.Keys, 2)
+	t.Equal(before.AttestationKeySet(context.Background()).Keys[0], keySet.Keys[1])
+
+	_, err = keySet.Verify(oldAttestation.Token, time.Now())
+	t.NoError(err)
+
+	newAttestation, err := rotated.IssueWalletAttestation(context.Background(), 1)
+	t.NoError(err)
+	_, err = keySet.Verify(newAttestation.Token, time.Now())
+	t.NoError(err)
+
+	// Once the retired key is dropped, its attestations no longer verify.
+	after := s.newServiceWithAttestations(s.cfg, config.AttestationConfig{SigningKey: newSeed, TTL: time.Hour})
+	_, err = after.AttestationKeySet(context.Background()).Verify(oldAttestation.Token, time.Now())
+	t.True(errors.Is(err, attestations.ErrInvalidToken))
+}
+
+func (s *WalletsServiceTestSuite) TestWalletAttestation_CappedByVerificationExpiry() {
+	t := s.Require()
+	seed, _ := mustGenerateAttestationKey(t)
+	cfg := s.cfg
+	cfg.VerificationConfig.Validity = 30 * 24 * time.Hour
+	svc := s.newServiceWithAttestations(cfg, config.AttestationConfig{SigningKey: seed, TTL: 60 * 24 * time.Hour})
+
+	s.mustAddVerifiedSolanaWallet(1)
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+
+	attestation, err := svc.IssueWalletAttestation(context.Background(), 1)
+	t.NoError(err)
+	t.Equal(w.VerificationExpiresAt.Unix(), attestation.ExpiresAt.Unix())
+
+	s.backdateVerification(w.ID, 31*24*time.Hour)
+	_, err = svc.IssueWalletAttestation(context.Background(), 1)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrForbidden)
+}
+
+func (s *WalletsServiceTestSuite) TestWalletAttestation_Errors() {
+	t := s.Require()
+	s.mustAddVerifiedSolanaWallet(1)
+
+	// Attestations are disabled without a signing key.
+	_, err := s.svc.IssueWalletAttestation(context.Background(), 1)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+	t.Empty(s.svc.AttestationKeySet(context.Background()).Keys)
+
+	seed, _ := mustGenerateAttestationKey(t)
+	svc := s.newServiceWithAttestations(s.cfg, config.AttestationConfig{SigningKey: seed, TTL: time.Hour})
+
+	_, err = svc.IssueWalletAttestation(context.Background(), 2)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+
+	unverified, _ := mustGenerateSolanaKeypair(t)
+	_, err = svc.AddWallet(context.Background(), 3, unverified, enum.ProviderPhantom)
+	t.NoError(err)
+	_, err = svc.IssueWalletAttestation(context.Background(), 3)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrForbidden)
+
+	_, err = attestations.NewSigner(config.AttestationConfig{SigningKey: base64.StdEncoding.EncodeToString([]byte("short"))})
+	t.Error(err)
+	_, err = attestations.NewSigner(config.AttestationConfig{SigningKey: seed, RetiredKeys: []string{"not base64!"}})
+	t.Error(err)
+}
+
+func (s *WalletsServiceTestSuite) TestWalletAttestation_VerifyWithoutSigner_RejectedBeforeVerifying() {
+	t := s.Require()
+	client := s.startPublicGRPC()
+	ctx := s.bearerContext(s.cfg.JwtSecret, 7, time.Now().Add(time.Hour))
+
+	pubkey, priv := mustGenerateSolanaKeypair(t)
+	ch, err := s.svc.AddWallet(context.Background(), 7, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	req := &publicApi.VerifyWalletRequest{
+		ChallengeId:      ch.ChallengeID,
+		Signature:        mustSignBase64(priv, ch.MessageToSign),
+		Pubkey:           pubkey,
+		IssueAttestation: true,
+	}
+
+	_, err = client.VerifyWallet(ctx, req)
+	t.Equal(codes.InvalidArgument, status.Code(err))
+	w, err := s.svc.GetWallet(context.Background(), 7)
+	t.NoError(err)
+	t.Nil(w.VerifiedAt)
+
+	// The challenge was not redeemed, so the client can retry without the attestation.
+	req.IssueAttestation = false
+	_, err = client.VerifyWallet(ctx, req)
+	t.NoError(err)
+}
+
+func (s *WalletsServiceTestSuite) TestWalletAttestation_MintingFails_VerifySucceeds() {
+	t := s.Require()
+	// Verifications expire right away, so the attestation of the freshly verified wallet can't be minted.
+	cfg := s.cfg
+	cfg.VerificationConfig.Validity = time.Nanosecond
+	seed, _ := mustGenerateAttestationKey(t)
+	svc := s.newServiceWithAttestations(cfg, config.AttestationConfig{SigningKey: seed, TTL: time.Hour})
+	client := s.startPublicGRPCWithService(svc)
+
+	pubkey, priv := mustGenerateSolanaKeypair(t)
+	ch, err := svc.AddWallet(context.Background(), 7, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+
+	resp, err := client.VerifyWallet(s.bearerContext(s.cfg.JwtSecret, 7, time.Now().Add(time.Hour)), &publicApi.VerifyWalletRequest{
+		ChallengeId:      ch.ChallengeID,
+		Signature:        mustSignBase64(priv, ch.MessageToSign),
+		Pubkey:           pubkey,
+		IssueAttestation: true,
+	})
+	t.NoError(err)
+	t.Nil(resp.GetAttestation())
+
+	w, err := svc.GetWallet(context.Background(), 7)
+	t.NoError(err)
+	t.NotNil(w.VerifiedAt)
+}
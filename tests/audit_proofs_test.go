@@ -0,0 +1,174 @@
+package wallets_test
+
+import (
+	"context"
+	"strings"
+
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/proofaudit"
+	"wallets-service/internal/wallets/chains"
+)
+
+// runProofAudit audits the stored proofs with a small page size so paging is exercised.
+func (s *WalletsServiceTestSuite) runProofAudit() proofaudit.Report {
+	report, err := proofaudit.NewAuditor(s.dbRepo, chains.NewRegistry(s.cfg), 1).Run(context.Background())
+	s.Require().NoError(err)
+	return report
+}
+
+func (s *WalletsServiceTestSuite) TestAuditProofs_Clean() {
+	t := s.Require()
+	s.mustAddVerifiedSolanaWallet(1)
+	s.mustAddVerifiedSolanaWallet(2)
+
+	report := s.runProofAudit()
+	t.Equal(2, report.ProofsChecked)
+	t.Equal(2, report.WalletsChecked)
+	t.Empty(report.Findings)
+}
+
+func (s *WalletsServiceTestSuite) TestAuditProofs_Stellar_Clean() {
+	t := s.Require()
+	address, priv := mustGenerateStellarKeypair(t)
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderStellar)
+	t.NoError(err)
+	signed := mustCosignStellarChallenge(t, priv, s.cfg.StellarConfig.NetworkPassphrase, ch.MessageToSign)
+	t.NoError(s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, signed, address))
+
+	// Time bounds are checked as of the verification, so the proof stays valid after the challenge expires.
+	report := s.runProofAudit()
+	t.Equal(1, report.ProofsChecked)
+	t.Empty(report.Findings)
+}
+
+func (s *WalletsServiceTestSuite) TestAuditProofs_Stellar_RotatedServerKey_Clean() {
+	t := s.Require()
+	address, priv := mustGenerateStellarKeypair(t)
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderStellar)
+	t.NoError(err)
+	signed := mustCosignStellarChallenge(t, priv, s.cfg.StellarConfig.NetworkPassphrase, ch.MessageToSign)
+	t.NoError(s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, signed, address))
+
+	// The proof was countersigned with the server key of its time, not the current one.
+	cfg := s.cfg
+	cfg.StellarConfig.SigningSeed = mustGenerateStellarSeed(t)
+	report, err := proofaudit.NewAuditor(s.dbRepo, chains.NewRegistry(cfg), 1).Run(context.Background())
+	t.NoError(err)
+	t.Equal(1, report.ProofsChecked)
+	t.Empty(report.Findings)
+}
+
+func (s *WalletsServiceTestSuite) TestAuditProofs_Stellar_StoredAfterExpiry_Clean() {
+	t := s.Require()
+	address, priv := mustGenerateStellarKeypair(t)
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderStellar)
+	t.NoError(err)
+	signed := mustCosignStellarChallenge(t, priv, s.cfg.StellarConfig.NetworkPassphrase, ch.MessageToSign)
+	t.NoError(s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, signed, address))
+
+	// Time bounds are checked at the verification time, not at the time the proof row was written.
+	t.NoError(s.db.Exec("UPDATE wallet_verification_proofs SET created_at = to_timestamp(expires_at + 60)").Error)
+
+	report := s.runProofAudit()
+	t.Equal(1, report.ProofsChecked)
+	t.Empty(report.Findings)
+}
+
+func (s *WalletsServiceTestSuite) TestAuditProofs_TransferredWallet_Clean() {
+	t := s.Require()
+	_, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	transfer, err := s.svc.InitiateTransfer(context.Background(), 1, w.ID, 2)
+	t.NoError(err)
+	ch, err := s.svc.AcceptTransfer(context.Background(), 2, transfer.ID)
+	t.NoError(err)
+	_, err = s.svc.CompleteTransfer(context.Background(), 2, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign))
+	t.NoError(err)
+
+	report := s.runProofAudit()
+	t.Equal(2, report.ProofsChecked)
+	t.Empty(report.Findings)
+}
+
+func (s *WalletsServiceTestSuite) TestAuditProofs_TamperedSignature() {
+	t := s.Require()
+	_, otherPriv := mustGenerateSolanaKeypair(t)
+	s.mustAddVerifiedSolanaWallet(1)
+
+	proofs, err := s.svc.ListVerificationProofs(context.Background(), 0, 1, "")
+	t.NoError(err)
+	t.Len(proofs, 1)
+	forged := mustSignBase64(otherPriv, string(proofs[0].Message))
+	t.NoError(s.db.Exec("UPDATE wallet_verification_proofs SET signature = ? WHERE id = ?", forged, proofs[0].ID).Error)
+
+	report := s.runProofAudit()
+	t.Len(report.Findings, 2)
+	t.Equal(proofaudit.FindingSignatureMismatch, report.Findings[0].Kind)
+	t.Equal(proofs[0].ID, report.Findings[0].ProofID)
+	// The rejected proof doesn't back the verification either.
+	t.Equal(proofaudit.FindingMissingProof, report.Findings[1].Kind)
+}
+
+func (s *WalletsServiceTestSuite) TestAuditProofs_TamperedMessage() {
+	t := s.Require()
+	s.mustAddVerifiedSolanaWallet(1)
+
+	proofs, err := s.svc.ListVerificationProofs(context.Background(), 0, 1, "")
+	t.NoError(err)
+	t.Len(proofs, 1)
+	// The signature still fits the challenge, only the stored message text changed.
+	tampered := strings.Replace(string(proofs[0].Message), "verify your wallet", "transfer my funds", 1)
+	t.NotEqual(string(proofs[0].Message), tampered)
+	t.NoError(s.db.Exec("UPDATE wallet_verification_proofs SET message = ? WHERE id = ?", []byte(tampered), proofs[0].ID).Error)
+
+	report := s.runProofAudit()
+	t.Len(report.Findings, 2)
+	t.Equal(proofaudit.FindingMessageMismatch, report.Findings[0].Kind)
+	t.Equal(proofs[0].ID, report.Findings[0].ProofID)
+	t.Equal(proofaudit.FindingMissingProof, report.Findings[1].Kind)
+}
+
+func (s *WalletsServiceTestSuite) TestAuditProofs_TamperedSignedPayload() {
+	t := s.Require()
+	s.mustAddVerifiedSolanaWallet(1)
+
+	proofs, err := s.svc.ListVerificationProofs(context.Background(), 0, 1, "")
+	t.NoError(err)
+	t.Len(proofs, 1)
+	t.NoError(s.db.Exec("UPDATE wallet_verification_proofs SET signed_payload = ? WHERE id = ?", []byte("something else"), proofs[0].ID).Error)
+
+	report := s.runProofAudit()
+	t.Len(report.Findings, 2)
+	t.Equal(proofaudit.FindingMessageMismatch, report.Findings[0].Kind)
+	t.Equal(proofs[0].ID, report.Findings[0].ProofID)
+}
+
+func (s *WalletsServiceTestSuite) TestAuditProofs_VerifiedWithoutProof() {
+	t := s.Require()
+	pubkey, _ := mustGenerateSolanaKeypair(t)
+
+	_, err := s.svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	t.NoError(s.db.Exec("UPDATE user_wallets SET verified_at = NOW() WHERE pubkey = ?", pubkey).Error)
+
+	report := s.runProofAudit()
+	t.Len(report.Findings, 1)
+	t.Equal(proofaudit.FindingMissingProof, report.Findings[0].Kind)
+	t.Equal(pubkey, report.Findings[0].Pubkey)
+}
+
+func (s *WalletsServiceTestSuite) TestAuditProofs_OwnerChangedWithoutProof() {
+	t := s.Require()
+	pubkey, _ := s.mustAddVerifiedSolanaWallet(1)
+	t.NoError(s.db.Exec("UPDATE user_wallets SET user_id = ? WHERE pubkey = ?", 2, pubkey).Error)
+
+	report := s.runProofAudit()
+	t.Len(report.Findings, 1)
+	t.Equal(proofaudit.FindingVerifiedWithoutProof, report.Findings[0].Kind)
+	t.Equal(uint(2), report.Findings[0].UserID)
+}
@@ -0,0 +1,80 @@
+package wallets_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/enum"
+)
+
+func (s *WalletsServiceTestSuite) TestGetWalletByPubkey_HappyPath() {
+	t := s.Require()
+	pubkey, _ := s.mustAddVerifiedSolanaWallet(1)
+
+	w, err := s.svc.GetWalletByPubkey(context.Background(), pubkey)
+	t.NoError(err)
+	t.Equal(uint(1), w.UserID)
+	t.Equal(enum.ProviderPhantom, w.Provider)
+	t.Equal(enum.VerificationStatusVerified, w.VerificationStatus)
+	t.NotNil(w.VerifiedAt)
+	t.False(w.CreatedAt.IsZero())
+}
+
+func (s *WalletsServiceTestSuite) TestGetWalletByPubkey_NormalizesAddress() {
+	t := s.Require()
+
+	aptosAddress, _ := mustGenerateAptosKeypair(t)
+	_, err := s.svc.AddWallet(context.Background(), 1, aptosAddress, enum.ProviderAptos)
+	t.NoError(err)
+	stellarAddress, _ := mustGenerateStellarKeypair(t)
+	_, err = s.svc.AddWallet(context.Background(), 2, stellarAddress, enum.ProviderStellar)
+	t.NoError(err)
+
+	w, err := s.svc.GetWalletByPubkey(context.Background(), "0X"+strings.ToUpper(aptosAddress[2:]))
+	t.NoError(err)
+	t.Equal(uint(1), w.UserID)
+	t.Equal(aptosAddress, w.Pubkey)
+
+	w, err = s.svc.GetWalletByPubkey(context.Background(), strings.ToLower(stellarAddress))
+	t.NoError(err)
+	t.Equal(uint(2), w.UserID)
+	t.Equal(stellarAddress, w.Pubkey)
+}
+
+func (s *WalletsServiceTestSuite) TestGetWalletByPubkey_Errors() {
+	t := s.Require()
+	pubkey, _ := mustGenerateSolanaKeypair(t)
+
+	_, err := s.svc.GetWalletByPubkey(context.Background(), pubkey)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+
+	_, err = s.svc.GetWalletByPubkey(context.Background(), "not an address")
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
+
+func (s *WalletsServiceTestSuite) TestGetWalletsByPubkeys_Batch() {
+	t := s.Require()
+	linked, _ := s.mustAddVerifiedSolanaWallet(1)
+	unlinked, _ := mustGenerateSolanaKeypair(t)
+
+	lookups, err := s.svc.GetWalletsByPubkeys(context.Background(), []string{unlinked, linked, "0xzz", linked})
+	t.NoError(err)
+	t.Len(lookups, 3)
+
+	t.Equal(unlinked, lookups[0].Pubkey)
+	t.False(lookups[0].Found)
+
+	t.Equal(linked, lookups[1].Pubkey)
+	t.True(lookups[1].Found)
+	t.Equal(uint(1), lookups[1].Wallet.UserID)
+
+	t.Equal("0xzz", lookups[2].Pubkey)
+	t.False(lookups[2].Found)
+}
+
+func (s *WalletsServiceTestSuite) TestGetWalletsByPubkeys_BatchTooLarge_InvalidData() {
+	_, err := s.svc.GetWalletsByPubkeys(context.Background(), make([]string, 1001))
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
@@ -0,0 +1,66 @@
+package wallets_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/enum"
+)
+
+func (s *WalletsServiceTestSuite) TestGetWalletsByUserIDs_NotFoundMarkers() {
+	t := s.Require()
+	verifiedPubkey, _ := s.mustAddVerifiedSolanaWallet(1)
+	unverifiedPubkey, _ := mustGenerateSolanaKeypair(t)
+	_, err := s.svc.AddWallet(context.Background(), 2, unverifiedPubkey, enum.ProviderPhantom)
+	t.NoError(err)
+
+	lookups, err := s.svc.GetWalletsByUserIDs(context.Background(), []uint{3, 1, 2, 1}, false)
+	t.NoError(err)
+	t.Len(lookups, 3)
+
+	t.Equal(uint(3), lookups[0].UserID)
+	t.False(lookups[0].Found)
+
+	t.Equal(uint(1), lookups[1].UserID)
+	t.True(lookups[1].Found)
+	t.Equal(verifiedPubkey, lookups[1].Wallet.Pubkey)
+	t.Equal(enum.VerificationStatusVerified, lookups[1].Wallet.VerificationStatus)
+
+	t.Equal(uint(2), lookups[2].UserID)
+	t.True(lookups[2].Found)
+	t.Equal(unverifiedPubkey, lookups[2].Wallet.Pubkey)
+	t.Equal(enum.VerificationStatusUnverified, lookups[2].Wallet.VerificationStatus)
+}
+
+func (s *WalletsServiceTestSuite) TestGetWalletsByUserIDs_VerifiedOnly() {
+	t := s.Require()
+	svc := s.newServiceWithVerificationValidity()
+	s.mustAddVerifiedSolanaWallet(1)
+	s.mustAddVerifiedSolanaWallet(2)
+	unverifiedPubkey, _ := mustGenerateSolanaKeypair(t)
+	_, err := svc.AddWallet(context.Background(), 3, unverifiedPubkey, enum.ProviderPhantom)
+	t.NoError(err)
+
+	expired, err := svc.GetWallet(context.Background(), 2)
+	t.NoError(err)
+	s.backdateVerification(expired.ID, 31*24*time.Hour)
+
+	lookups, err := svc.GetWalletsByUserIDs(context.Background(), []uint{1, 2, 3}, true)
+	t.NoError(err)
+	t.Len(lookups, 3)
+	t.True(lookups[0].Found)
+	t.False(lookups[1].Found)
+	t.False(lookups[2].Found)
+}
+
+func (s *WalletsServiceTestSuite) TestGetWalletsByUserIDs_BatchTooLarge_InvalidData() {
+	userIDs := make([]uint, 1001)
+	for i := range userIDs {
+		userIDs[i] = uint(i + 1)
+	}
+
+	_, err := s.svc.GetWalletsByUserIDs(context.Background(), userIDs, false)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
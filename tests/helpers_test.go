@@ -1,19 +1,33 @@
 package wallets_test
 
 import (
+	"context"
 	"crypto/ed25519"
 	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha3"
+	"encoding/base32"
 	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"runtime"
 	"testing"
 
+	"github.com/cosmos/btcutil/bech32"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
 	knlog "github.com/knstch/knstch-libs/log"
 	"github.com/mr-tron/base58"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/ripemd160"
 
 	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/enum"
 )
 
 func envOr(key, def string) string {
@@ -67,6 +81,183 @@ func mustSignBase64(priv ed25519.PrivateKey, msg string) string {
 	return base64.StdEncoding.EncodeToString(sig)
 }
 
+// mustAddVerifiedSolanaWallet links a new Phantom wallet to the user and verifies it.
+func (s *WalletsServiceTestSuite) mustAddVerifiedSolanaWallet(userID uint) (pubkey string, priv ed25519.PrivateKey) {
+	t := s.Require()
+	pubkey, priv = mustGenerateSolanaKeypair(t)
+
+	ch, err := s.svc.AddWallet(context.Background(), userID, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	t.NoError(s.svc.VerifyWallet(context.Background(), userID, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign), pubkey))
+
+	return pubkey, priv
+}
+
+// mustSignSolanaOffchainMessage signs msg wrapped into a version 0 Solana off-chain message envelope,
+// like the Ledger Solana app does.
+func mustSignSolanaOffchainMessage(priv ed25519.PrivateKey, msg string) string {
+	format := byte(1) // limited UTF-8: challenge messages contain newlines
+	envelope := append([]byte("\xffsolana offchain"), 0, format)
+	envelope = binary.LittleEndian.AppendUint16(envelope, uint16(len(msg)))
+	envelope = append(envelope, msg...)
+	return base64.StdEncoding.EncodeToString(ed25519.Sign(priv, envelope))
+}
+
+// mustEncodeByteArray encodes b the way JSON.stringify(Array.from(uint8Array)) does.
+func mustEncodeByteArray(b []byte) string {
+	values := make([]int, len(b))
+	for i, v := range b {
+		values[i] = int(v)
+	}
+	raw, err := json.Marshal(values)
+	if err != nil {
+		panic(err)
+	}
+	return string(raw)
+}
+
+func mustGenerateStellarKeypair(t *require.Assertions) (address string, priv ed25519.PrivateKey) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	t.NoError(err)
+
+	// G-address: version byte 6 << 3.
+	return encodeStellarStrkey(6<<3, pub), priv
+}
+
+// mustGenerateStellarSeed returns a new S-seed, e.g. to rotate the server signing key.
+func mustGenerateStellarSeed(t *require.Assertions) string {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	t.NoError(err)
+
+	// S-seed: version byte 18 << 3.
+	return encodeStellarStrkey(18<<3, priv.Seed())
+}
+
+// encodeStellarStrkey returns base32(version byte || payload || crc16-xmodem little-endian).
+func encodeStellarStrkey(version byte, payload []byte) string {
+	raw := append([]byte{version}, payload...)
+	var crc uint16
+	for _, b := range raw {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	raw = binary.LittleEndian.AppendUint16(raw, crc)
+
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(raw)
+}
+
+// mustCosignStellarChallenge adds the client signature to a SEP-10 challenge the way a wallet does.
+// The challenge is expected to carry exactly one (server) signature.
+func mustCosignStellarChallenge(t *require.Assertions, priv ed25519.PrivateKey, networkPassphrase, challengeXDR string) string {
+	const serverSigLen = 4 + 4 + ed25519.SignatureSize
+
+	raw, err := base64.StdEncoding.DecodeString(challengeXDR)
+	t.NoError(err)
+
+	countOff := len(raw) - serverSigLen - 4
+	t.Equal(uint32(1), binary.BigEndian.Uint32(raw[countOff:]))
+
+	networkID := sha256.Sum256([]byte(networkPassphrase))
+	payload := append(append(networkID[:], 0, 0, 0, 2), raw[4:countOff]...)
+	hash := sha256.Sum256(payload)
+
+	pub := priv.Public().(ed25519.PublicKey)
+	signed := append([]byte{}, raw[:countOff]...)
+	signed = binary.BigEndian.AppendUint32(signed, 2)
+	signed = append(signed, raw[countOff+4:]...)
+	signed = append(signed, pub[len(pub)-4:]...)
+	signed = binary.BigEndian.AppendUint32(signed, ed25519.SignatureSize)
+	signed = append(signed, ed25519.Sign(priv, hash[:])...)
+
+	return base64.StdEncoding.EncodeToString(signed)
+}
+
+func mustGenerateCosmosKeypair(t *require.Assertions, prefix string) (address string, priv *secp256k1.PrivateKey) {
+	priv, err := secp256k1.GeneratePrivateKey()
+	t.NoError(err)
+
+	sha := sha256.Sum256(priv.PubKey().SerializeCompressed())
+	hasher := ripemd160.New()
+	hasher.Write(sha[:])
+
+	conv, err := bech32.ConvertBits(hasher.Sum(nil), 8, 5, true)
+	t.NoError(err)
+	address, err = bech32.Encode(prefix, conv)
+	t.NoError(err)
+
+	return address, priv
+}
+
+// mustSignADR036 signs msg the way Keplr's signArbitrary does and returns the StdSignature JSON.
+func mustSignADR036(t *require.Assertions, priv *secp256k1.PrivateKey, signer, msg string) string {
+	signDoc := `{"account_number":"0","chain_id":"","fee":{"amount":[],"gas":"0"},"memo":"",` +
+		`"msgs":[{"type":"sign/MsgSignData","value":{"data":"` + base64.StdEncoding.EncodeToString([]byte(msg)) +
+		`","signer":"` + signer + `"}}],"sequence":"0"}`
+	hash := sha256.Sum256([]byte(signDoc))
+
+	sig := ecdsa.Sign(priv, hash[:])
+	r, s := sig.R(), sig.S()
+	rBytes, sBytes := r.Bytes(), s.Bytes()
+
+	stdSig, err := json.Marshal(map[string]any{
+		"pub_key": map[string]string{
+			"type":  "tendermint/PubKeySecp256k1",
+			"value": base64.StdEncoding.EncodeToString(priv.PubKey().SerializeCompressed()),
+		},
+		"signature": base64.StdEncoding.EncodeToString(append(rBytes[:], sBytes[:]...)),
+	})
+	t.NoError(err)
+
+	return string(stdSig)
+}
+
+func mustGenerateAptosKeypair(t *require.Assertions) (address string, priv ed25519.PrivateKey) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	t.NoError(err)
+
+	authKey := sha3.Sum256(append(pub, 0x00))
+	return "0x" + hex.EncodeToString(authKey[:]), priv
+}
+
+// mustSignAptosMessage signs msg like the Aptos wallet standard signMessage does when the dapp
+// passes the challenge ID as nonce, and returns the proof JSON expected by VerifyWallet.
+func mustSignAptosMessage(t *require.Assertions, priv ed25519.PrivateKey, msg, nonce string) string {
+	fullMessage := "APTOS\nmessage: " + msg + "\nnonce: " + nonce
+
+	proof, err := json.Marshal(map[string]string{
+		"public_key": "0x" + hex.EncodeToString(priv.Public().(ed25519.PublicKey)),
+		"signature":  "0x" + hex.EncodeToString(ed25519.Sign(priv, []byte(fullMessage))),
+	})
+	t.NoError(err)
+
+	return string(proof)
+}
+
+func mustGenerateSuiKeypair(t *require.Assertions) (address string, priv ed25519.PrivateKey) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	t.NoError(err)
+
+	addr := blake2b.Sum256(append([]byte{0x00}, pub...))
+	return "0x" + hex.EncodeToString(addr[:]), priv
+}
+
+// mustSignSuiPersonalMessage signs msg like signPersonalMessage and returns the serialized signature.
+func mustSignSuiPersonalMessage(priv ed25519.PrivateKey, msg string) string {
+	intentMsg := binary.AppendUvarint([]byte{3, 0, 0}, uint64(len(msg)))
+	intentMsg = append(intentMsg, msg...)
+	digest := blake2b.Sum256(intentMsg)
+
+	serialized := append([]byte{0x00}, ed25519.Sign(priv, digest[:])...)
+	serialized = append(serialized, priv.Public().(ed25519.PublicKey)...)
+	return base64.StdEncoding.EncodeToString(serialized)
+}
+
 func requireSvcErrIs(t *testing.T, err error, target error) {
 	t.Helper()
 	switch target {
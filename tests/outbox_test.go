@@ -0,0 +1,207 @@
+package wallets_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/outbox"
+)
+
+// recordingPublisher keeps published messages and fails for the keys in failKeys.
+type recordingPublisher struct {
+	messages []outbox.Message
+	failKeys map[string]bool
+}
+
+func (p *recordingPublisher) Publish(_ context.Context, msg outbox.Message) error {
+	if p.failKeys[msg.Key] {
+		return errors.New("broker unavailable")
+	}
+	p.messages = append(p.messages, msg)
+	return nil
+}
+
+func (p *recordingPublisher) Close() error {
+	return nil
+}
+
+// newOutboxRelay returns a relay that retries failed messages right away.
+func (s *WalletsServiceTestSuite) newOutboxRelay(publisher outbox.EventPublisher) *outbox.Relay {
+	cfg := s.cfg.OutboxConfig
+	cfg.BatchSize = 100
+	cfg.RetryMinBackoff = 0
+	cfg.RetryMaxBackoff = 0
+	return outbox.NewRelay(s.logger, s.dbRepo, publisher, cfg)
+}
+
+// relayAll relays until nothing is left to publish.
+func (s *WalletsServiceTestSuite) relayAll(relay *outbox.Relay) {
+	for {
+		published, err := relay.RelayDue(context.Background())
+		s.Require().NoError(err)
+		if published == 0 {
+			return
+		}
+	}
+}
+
+func (s *WalletsServiceTestSuite) TestOutbox_WalletLifecycle_PublishedInOrder() {
+	t := s.Require()
+	s.mustAddVerifiedSolanaWallet(1)
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	_, err = s.svc.UnlinkWallet(context.Background(), w.ID, 1)
+	t.NoError(err)
+
+	publisher := &recordingPublisher{}
+	s.relayAll(s.newOutboxRelay(publisher))
+
+	t.Len(publisher.messages, 3)
+	t.Equal("wallet.added", publisher.messages[0].Type)
+	t.Equal("wallet.verified", publisher.messages[1].Type)
+	t.Equal("wallet.unlinked", publisher.messages[2].Type)
+
+	var event dto.WalletDomainEvent
+	t.NoError(json.Unmarshal(publisher.messages[1].Payload, &event))
+	t.Equal(publisher.messages[1].ID, event.EventID)
+	t.Equal("wallet.verified", event.Type)
+	t.Equal(uint(1), event.UserID)
+	t.Equal(w.ID, event.WalletID)
+	t.Equal(w.Pubkey, event.Pubkey)
+	t.Equal(enum.ProviderPhantom.String(), event.Provider)
+	t.Equal("1", publisher.messages[1].Key)
+
+	// Published messages are not sent again.
+	s.relayAll(s.newOutboxRelay(publisher))
+	t.Len(publisher.messages, 3)
+}
+
+func (s *WalletsServiceTestSuite) TestOutbox_FailedPublish_BlocksOnlyThatUser() {
+	t := s.Require()
+	s.mustAddVerifiedSolanaWallet(1)
+	s.mustAddVerifiedSolanaWallet(2)
+
+	publisher := &recordingPublisher{failKeys: map[string]bool{"1": true}}
+	relay := s.newOutboxRelay(publisher)
+	s.relayAll(relay)
+
+	t.Len(publisher.messages, 2)
+	for _, msg := range publisher.messages {
+		t.Equal("2", msg.Key)
+	}
+
+	// Once the broker accepts them, the events of user 1 follow in order.
+	publisher.failKeys = nil
+	s.relayAll(relay)
+
+	t.Len(publisher.messages, 4)
+	t.Equal("wallet.added", publisher.messages[2].Type)
+	t.Equal("1", publisher.messages[2].Key)
+	t.Equal("wallet.verified", publisher.messages[3].Type)
+}
+
+func (s *WalletsServiceTestSuite) TestOutbox_FailedPublish_Backoff() {
+	t := s.Require()
+	s.mustAddVerifiedSolanaWallet(1)
+
+	cfg := s.cfg.OutboxConfig
+	cfg.BatchSize = 100
+	cfg.RetryMinBackoff = time.Hour
+	cfg.RetryMaxBackoff = time.Hour
+	publisher := &recordingPublisher{failKeys: map[string]bool{"1": true}}
+	relay := outbox.NewRelay(s.logger, s.dbRepo, publisher, cfg)
+
+	published, err := relay.RelayDue(context.Background())
+	t.NoError(err)
+	t.Zero(published)
+
+	// The failed message is not retried before its backoff is over.
+	publisher.failKeys = nil
+	published, err = relay.RelayDue(context.Background())
+	t.NoError(err)
+	t.Zero(published)
+	t.Empty(publisher.messages)
+}
+
+func (s *WalletsServiceTestSuite) TestOutbox_FailedMutation_NoEvent() {
+	t := s.Require()
+	pubkey, _ := s.mustAddVerifiedSolanaWallet(1)
+
+	_, err := s.svc.AddWallet(context.Background(), 2, pubkey, enum.ProviderPhantom)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrConflict)
+
+	publisher := &recordingPublisher{}
+	s.relayAll(s.newOutboxRelay(publisher))
+	for _, msg := range publisher.messages {
+		t.Equal("1", msg.Key)
+	}
+}
+
+func (s *WalletsServiceTestSuite) TestOutbox_CompleteTransfer_PublishesMove() {
+	t := s.Require()
+	receiver := &webhookReceiver{status: http.StatusNoContent}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	_, err := s.svc.CreateWebhookSubscription(context.Background(), server.URL, []string{"wallet.unlinked"}, "")
+	t.NoError(err)
+
+	_, priv := s.mustAddVerifiedSolanaWallet(1)
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	t.NoError(s.transferWallet(s.svc, 1, 2, priv))
+
+	publisher := &recordingPublisher{}
+	s.relayAll(s.newOutboxRelay(publisher))
+
+	byUser := make(map[string][]outbox.Message)
+	for _, msg := range publisher.messages {
+		byUser[msg.Key] = append(byUser[msg.Key], msg)
+	}
+	t.Len(byUser["1"], 3)
+	t.Equal("wallet.unlinked", byUser["1"][2].Type)
+	t.Len(byUser["2"], 3)
+	t.Equal("wallet.transfer_initiated", byUser["2"][0].Type)
+	t.Equal("wallet.added", byUser["2"][1].Type)
+	t.Equal("wallet.verified", byUser["2"][2].Type)
+
+	var event dto.WalletDomainEvent
+	t.NoError(json.Unmarshal(byUser["2"][1].Payload, &event))
+	t.Equal(uint(2), event.UserID)
+	t.Equal(w.ID, event.WalletID)
+	t.Equal(w.Pubkey, event.Pubkey)
+	t.Equal("transfer", event.Metadata["move"])
+
+	sent, err := s.newWebhookDispatcher(3).DeliverDue(context.Background())
+	t.NoError(err)
+	t.Equal(1, sent)
+	t.Len(receiver.requests, 1)
+}
+
+func (s *WalletsServiceTestSuite) TestOutbox_RejectedTransfer_NoEvent() {
+	t := s.Require()
+	svc := s.newServiceWithRelink(time.Hour, 0)
+	_, priv := s.mustAddVerifiedSolanaWallet(1)
+	t.NoError(s.transferWallet(svc, 1, 2, priv))
+
+	err := s.transferWallet(svc, 2, 3, priv)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrForbidden)
+
+	publisher := &recordingPublisher{}
+	s.relayAll(s.newOutboxRelay(publisher))
+	t.Len(publisher.messages, 7)
+	for _, msg := range publisher.messages {
+		// Account 3 was only offered the wallet.
+		if msg.Key == "3" {
+			t.Equal("wallet.transfer_initiated", msg.Type)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package wallets_test
+
+import (
+	"context"
+	"errors"
+	"net"
+
+	privateApi "github.com/knstch/wallets-ido-api/private"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"wallets-service/config"
+	"wallets-service/internal/endpoints/interceptors"
+	"wallets-service/internal/endpoints/private"
+	"wallets-service/internal/health"
+)
+
+// startPrivateGRPC serves the private API like cmd/wallets does and returns a client connection and the health server.
+// Cancelling serverCtx starts the shutdown of the server, like the relay context of cmd/wallets.
+func (s *WalletsServiceTestSuite) startPrivateGRPC(serverCtx context.Context, cfg config.PrivateGRPCConfig) (*grpc.ClientConn, *grpchealth.Server) {
+	t := s.Require()
+
+	opts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(interceptors.UnaryErrorStatus(s.logger)),
+		grpc.ChainStreamInterceptor(interceptors.StreamErrorStatus(s.logger)),
+	}
+
+	serviceAuth, err := interceptors.NewServiceAuth(cfg, privateApi.WalletsPrivate_ServiceDesc)
+	t.NoError(err)
+	if serviceAuth != nil {
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(serviceAuth.Unary()),
+			grpc.ChainStreamInterceptor(serviceAuth.Stream()),
+		)
+	}
+
+	server := grpc.NewServer(opts...)
+	privateApi.RegisterWalletsPrivateServer(server, private.NewController(serverCtx, s.svc, s.logger, &s.cfg))
+
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	t.NoError(err)
+	go func() { _ = server.Serve(lis) }()
+	s.T().Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	t.NoError(err)
+	s.T().Cleanup(func() { _ = conn.Close() })
+
+	return conn, healthServer
+}
+
+func serviceTokenContext(token string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+func (s *WalletsServiceTestSuite) TestPrivateGRPC_ServiceAuth() {
+	t := s.Require()
+	conn, _ := s.startPrivateGRPC(context.Background(), config.PrivateGRPCConfig{
+		ServiceTokens: map[string]string{"notifications": "notifications-token", "admin": "admin-token"},
+		CallerMethods: map[string]string{"notifications": "GetWalletByUserID", "admin": "*"},
+	})
+	client := privateApi.NewWalletsPrivateClient(conn)
+	s.mustAddVerifiedSolanaWallet(7)
+
+	_, err := client.GetWalletByUserID(context.Background(), &privateApi.GetWalletByUserIDRequest{UserId: 7})
+	t.Equal(codes.Unauthenticated, status.Code(err))
+
+	_, err = client.GetWalletByUserID(serviceTokenContext("wrong-token"), &privateApi.GetWalletByUserIDRequest{UserId: 7})
+	t.Equal(codes.Unauthenticated, status.Code(err))
+
+	_, err = client.GetWalletByUserID(serviceTokenContext("notifications-token"), &privateApi.GetWalletByUserIDRequest{UserId: 7})
+	t.NoError(err)
+
+	_, err = client.CreateWalletSnapshot(serviceTokenContext("notifications-token"), &privateApi.CreateWalletSnapshotRequest{Label: "denied"})
+	t.Equal(codes.PermissionDenied, status.Code(err))
+
+	stream, err := client.WatchWalletChanges(serviceTokenContext("notifications-token"), &privateApi.WatchWalletChangesRequest{})
+	t.NoError(err)
+	_, err = stream.Recv()
+	t.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = client.ListWalletSnapshots(serviceTokenContext("admin-token"), &privateApi.ListWalletSnapshotsRequest{})
+	t.NoError(err)
+
+	// Health checks carry no service token.
+	_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	t.NoError(err)
+}
+
+func (s *WalletsServiceTestSuite) TestPrivateGRPC_ServiceAuthConfig() {
+	t := s.Require()
+
+	serviceAuth, err := interceptors.NewServiceAuth(config.PrivateGRPCConfig{}, privateApi.WalletsPrivate_ServiceDesc)
+	t.NoError(err)
+	t.Nil(serviceAuth)
+
+	_, err = interceptors.NewServiceAuth(config.PrivateGRPCConfig{
+		ServiceTokens: map[string]string{"notifications": "notifications-token"},
+		CallerMethods: map[string]string{"notifications": "GetWalletByUserId"},
+	}, privateApi.WalletsPrivate_ServiceDesc)
+	t.Error(err)
+
+	_, err = interceptors.NewServiceAuth(config.PrivateGRPCConfig{
+		ServiceTokens: map[string]string{"notifications": "notifications-token"},
+		CallerMethods: map[string]string{"admin": "*"},
+	}, privateApi.WalletsPrivate_ServiceDesc)
+	t.Error(err)
+
+	_, err = interceptors.NewServiceAuth(config.PrivateGRPCConfig{
+		ServiceTokens: map[string]string{"notifications": "shared-token", "admin": "shared-token"},
+	}, privateApi.WalletsPrivate_ServiceDesc)
+	t.Error(err)
+}
+
+func (s *WalletsServiceTestSuite) TestPrivateGRPC_ErrorStatus() {
+	t := s.Require()
+	conn, _ := s.startPrivateGRPC(context.Background(), config.PrivateGRPCConfig{})
+	client := privateApi.NewWalletsPrivateClient(conn)
+
+	_, err := client.GetWalletByUserID(context.Background(), &privateApi.GetWalletByUserIDRequest{UserId: 7})
+	st, ok := status.FromError(err)
+	t.True(ok)
+	t.Equal(codes.NotFound, st.Code())
+	t.Equal("wallet not found: data not found", st.Message())
+
+	_, err = client.GetWalletsByUserIDs(context.Background(), &privateApi.GetWalletsByUserIDsRequest{UserIds: []uint64{0}})
+	t.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *WalletsServiceTestSuite) TestPrivateGRPC_Health() {
+	t := s.Require()
+	conn, healthServer := s.startPrivateGRPC(context.Background(), config.PrivateGRPCConfig{})
+	client := healthpb.NewHealthClient(conn)
+	service := privateApi.WalletsPrivate_ServiceDesc.ServiceName
+
+	checker := health.NewChecker(s.logger, healthServer, map[string]health.Check{
+		"postgres": health.DBCheck(s.db),
+		"redis":    health.RedisCheck(s.rdb),
+	}, []string{service}, s.cfg.HealthConfig)
+	checker.CheckAll(context.Background())
+
+	resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	t.NoError(err)
+	t.Equal(healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+
+	failing := health.NewChecker(s.logger, healthServer, map[string]health.Check{
+		"postgres": health.DBCheck(s.db),
+		"broken": func(context.Context) error {
+			return errors.New("unreachable")
+		},
+	}, []string{service}, s.cfg.HealthConfig)
+	failing.CheckAll(context.Background())
+
+	resp, err = client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+	t.NoError(err)
+	t.Equal(healthpb.HealthCheckResponse_NOT_SERVING, resp.GetStatus())
+}
+
+func (s *WalletsServiceTestSuite) TestPrivateGRPC_WatchWalletChanges_ShutdownUnavailable() {
+	t := s.Require()
+	serverCtx, shutdown := context.WithCancel(context.Background())
+	defer shutdown()
+	conn, _ := s.startPrivateGRPC(serverCtx, config.PrivateGRPCConfig{})
+	client := privateApi.NewWalletsPrivateClient(conn)
+
+	stream, err := client.WatchWalletChanges(context.Background(), &privateApi.WatchWalletChangesRequest{})
+	t.NoError(err)
+	change, err := stream.Recv()
+	t.NoError(err)
+	t.Equal(privateApi.WalletChangeType_WALLET_CHANGE_TYPE_SNAPSHOT_END, change.GetType())
+
+	// The stream ends on shutdown, so a graceful stop isn't held up by it and the client knows to resume.
+	shutdown()
+	_, err = stream.Recv()
+	t.Equal(codes.Unavailable, status.Code(err))
+}
@@ -0,0 +1,118 @@
+package wallets_test
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/knstch/knstch-libs/auth"
+	publicApi "github.com/knstch/wallets-ido-api/public"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"wallets-service/internal/endpoints/interceptors"
+	"wallets-service/internal/endpoints/public"
+	"wallets-service/internal/wallets"
+)
+
+func (s *WalletsServiceTestSuite) startPublicGRPC() publicApi.WalletsClient {
+	return s.startPublicGRPCWithService(s.svc)
+}
+
+// startPublicGRPCWithService serves the public API of svc like cmd/wallets does and returns a client.
+func (s *WalletsServiceTestSuite) startPublicGRPCWithService(svc wallets.Service) publicApi.WalletsClient {
+	t := s.Require()
+
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		interceptors.UnaryErrorStatus(s.logger),
+		interceptors.UnaryBearerAuth(s.cfg.JwtSecret),
+		interceptors.UnaryRequestMeta(),
+	))
+	publicApi.RegisterWalletsServer(server, public.NewController(svc, s.logger, &s.cfg))
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	t.NoError(err)
+	go func() { _ = server.Serve(lis) }()
+	s.T().Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	t.NoError(err)
+	s.T().Cleanup(func() { _ = conn.Close() })
+
+	return publicApi.NewWalletsClient(conn)
+}
+
+func (s *WalletsServiceTestSuite) bearerToken(secret string, userID uint, expiresAt time.Time) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, auth.Claims{
+		UserID: strconv.FormatUint(uint64(userID), 10),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}).SignedString([]byte(secret))
+	s.Require().NoError(err)
+	return token
+}
+
+func (s *WalletsServiceTestSuite) bearerContext(secret string, userID uint, expiresAt time.Time) context.Context {
+	token := s.bearerToken(secret, userID, expiresAt)
+	return metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+}
+
+func (s *WalletsServiceTestSuite) TestPublicGRPC_GetWallet() {
+	t := s.Require()
+	client := s.startPublicGRPC()
+
+	s.mustAddVerifiedSolanaWallet(7)
+
+	resp, err := client.GetWallet(s.bearerContext(s.cfg.JwtSecret, 7, time.Now().Add(time.Hour)), &publicApi.GetWalletRequest{})
+	t.NoError(err)
+	t.True(resp.GetIsVerified())
+	t.Equal(publicApi.Provider_PROVIDER_PHANTOM, resp.GetProvider())
+
+	wallet, err := s.svc.GetWallet(context.Background(), 7)
+	t.NoError(err)
+	t.Equal(uint64(wallet.ID), resp.GetId())
+}
+
+func (s *WalletsServiceTestSuite) TestPublicGRPC_ErrorCodes() {
+	t := s.Require()
+	client := s.startPublicGRPC()
+
+	_, err := client.GetWallet(context.Background(), &publicApi.GetWalletRequest{})
+	t.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = client.GetWallet(s.bearerContext("wrong-secret", 7, time.Now().Add(time.Hour)), &publicApi.GetWalletRequest{})
+	t.Equal(codes.PermissionDenied, status.Code(err))
+
+	_, err = client.GetWallet(s.bearerContext(s.cfg.JwtSecret, 7, time.Now().Add(-time.Minute)), &publicApi.GetWalletRequest{})
+	t.Equal(codes.Unauthenticated, status.Code(err))
+
+	_, err = client.GetWallet(s.bearerContext(s.cfg.JwtSecret, 7, time.Now().Add(time.Hour)), &publicApi.GetWalletRequest{})
+	t.Equal(codes.NotFound, status.Code(err))
+
+	_, err = client.AddWallet(s.bearerContext(s.cfg.JwtSecret, 7, time.Now().Add(time.Hour)), &publicApi.AddWalletRequest{})
+	t.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *WalletsServiceTestSuite) TestPublicGRPC_GetWalletEvents_Paging() {
+	t := s.Require()
+	client := s.startPublicGRPC()
+	ctx := s.bearerContext(s.cfg.JwtSecret, 7, time.Now().Add(time.Hour))
+
+	s.mustAddVerifiedSolanaWallet(7)
+
+	page, err := client.GetWalletEvents(ctx, &publicApi.GetWalletEventsRequest{Limit: 1})
+	t.NoError(err)
+	t.Len(page.GetEvents(), 1)
+	t.Equal(publicApi.WalletEventType_WALLET_EVENT_TYPE_VERIFIED, page.GetEvents()[0].GetType())
+
+	page, err = client.GetWalletEvents(ctx, &publicApi.GetWalletEventsRequest{BeforeId: page.GetEvents()[0].GetId(), Limit: 1})
+	t.NoError(err)
+	t.Len(page.GetEvents(), 1)
+	t.Equal(publicApi.WalletEventType_WALLET_EVENT_TYPE_ADDED, page.GetEvents()[0].GetType())
+}
@@ -0,0 +1,236 @@
+package wallets_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets"
+)
+
+// newServiceWithoutReclaimWait returns a service whose reclaims can be completed right after confirmation.
+func (s *WalletsServiceTestSuite) newServiceWithoutReclaimWait() wallets.Service {
+	cfg := s.cfg
+	cfg.ReclaimConfig.WaitingPeriod = 0
+	return wallets.NewService(s.logger, s.dbRepo, cfg, s.rdb)
+}
+
+func (s *WalletsServiceTestSuite) TestReclaimWallet_HappyPath() {
+	t := s.Require()
+	svc := s.newServiceWithoutReclaimWait()
+	pubkey, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	ch, err := svc.RequestReclaim(context.Background(), 2, pubkey, enum.ProviderSolflare)
+	t.NoError(err)
+
+	reclaim, err := svc.ConfirmReclaim(context.Background(), 2, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign))
+	t.NoError(err)
+	t.Equal(enum.ReclaimStatusPending, reclaim.Status)
+	t.Equal(uint(1), reclaim.FromUserID)
+	t.Equal(uint(2), reclaim.ToUserID)
+
+	// The current owner sees the incoming reclaim.
+	ownerReclaims, err := svc.ListReclaims(context.Background(), 1)
+	t.NoError(err)
+	t.Len(ownerReclaims, 1)
+	t.Equal(reclaim.ID, ownerReclaims[0].ID)
+
+	t.NoError(svc.CompleteReclaim(context.Background(), 2, reclaim.ID))
+
+	w, err := svc.GetWallet(context.Background(), 2)
+	t.NoError(err)
+	t.Equal(pubkey, w.Pubkey)
+	t.Equal(enum.ProviderSolflare, w.Provider)
+	t.NotNil(w.VerifiedAt)
+
+	_, err = svc.GetWallet(context.Background(), 1)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+
+	claimantReclaims, err := svc.ListReclaims(context.Background(), 2)
+	t.NoError(err)
+	t.Len(claimantReclaims, 1)
+	t.Equal(enum.ReclaimStatusCompleted, claimantReclaims[0].Status)
+	t.NotNil(claimantReclaims[0].ResolvedAt)
+}
+
+func (s *WalletsServiceTestSuite) TestReclaimWallet_InvalidatesOutstandingChallenges() {
+	t := s.Require()
+	svc := s.newServiceWithoutReclaimWait()
+	pubkey, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	ch, err := svc.RequestReclaim(context.Background(), 2, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	reclaim, err := svc.ConfirmReclaim(context.Background(), 2, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign))
+	t.NoError(err)
+
+	// The previous owner hands the wallet to a third account meanwhile.
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	transfer, err := svc.InitiateTransfer(context.Background(), 1, w.ID, 3)
+	t.NoError(err)
+	stale, err := svc.AcceptTransfer(context.Background(), 3, transfer.ID)
+	t.NoError(err)
+
+	t.NoError(svc.CompleteReclaim(context.Background(), 2, reclaim.ID))
+
+	_, err = svc.CompleteTransfer(context.Background(), 3, stale.ChallengeID, mustSignBase64(priv, stale.MessageToSign))
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+
+	exists, err := s.rdb.Exists(context.Background(), wallets.GetChallengesByPubkeyKey(pubkey)).Result()
+	t.NoError(err)
+	t.Zero(exists)
+}
+
+func (s *WalletsServiceTestSuite) TestReclaimWallet_WaitingPeriodNotOver_Forbidden() {
+	t := s.Require()
+	pubkey, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	ch, err := s.svc.RequestReclaim(context.Background(), 2, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	reclaim, err := s.svc.ConfirmReclaim(context.Background(), 2, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign))
+	t.NoError(err)
+
+	err = s.svc.CompleteReclaim(context.Background(), 2, reclaim.ID)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrForbidden)
+
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	t.Equal(pubkey, w.Pubkey)
+}
+
+func (s *WalletsServiceTestSuite) TestReclaimWallet_Contested_Conflict() {
+	t := s.Require()
+	svc := s.newServiceWithoutReclaimWait()
+	pubkey, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	ch, err := svc.RequestReclaim(context.Background(), 2, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	reclaim, err := svc.ConfirmReclaim(context.Background(), 2, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign))
+	t.NoError(err)
+
+	// Only the current owner can contest.
+	err = svc.ContestReclaim(context.Background(), 2, reclaim.ID)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+	t.NoError(svc.ContestReclaim(context.Background(), 1, reclaim.ID))
+
+	err = svc.CompleteReclaim(context.Background(), 2, reclaim.ID)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrConflict)
+
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	t.Equal(pubkey, w.Pubkey)
+}
+
+func (s *WalletsServiceTestSuite) TestReclaimWallet_AlreadyPending_Conflict() {
+	t := s.Require()
+	pubkey, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	ch, err := s.svc.RequestReclaim(context.Background(), 2, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	_, err = s.svc.ConfirmReclaim(context.Background(), 2, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign))
+	t.NoError(err)
+
+	_, err = s.svc.RequestReclaim(context.Background(), 3, pubkey, enum.ProviderPhantom)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrConflict)
+}
+
+func (s *WalletsServiceTestSuite) TestReclaimWallet_OwnWallet_Conflict() {
+	pubkey, _ := s.mustAddVerifiedSolanaWallet(1)
+
+	_, err := s.svc.RequestReclaim(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrConflict)
+}
+
+func (s *WalletsServiceTestSuite) TestReclaimWallet_UnknownWallet_NotFound() {
+	pubkey, _ := mustGenerateSolanaKeypair(s.Require())
+
+	_, err := s.svc.RequestReclaim(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+}
+
+func (s *WalletsServiceTestSuite) TestReclaimWallet_InvalidSignature_InvalidData() {
+	t := s.Require()
+	pubkey, _ := s.mustAddVerifiedSolanaWallet(1)
+	_, otherPriv := mustGenerateSolanaKeypair(t)
+
+	ch, err := s.svc.RequestReclaim(context.Background(), 2, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+
+	_, err = s.svc.ConfirmReclaim(context.Background(), 2, ch.ChallengeID, mustSignBase64(otherPriv, ch.MessageToSign))
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
+
+func (s *WalletsServiceTestSuite) TestReclaimWallet_ChallengeNotUsableForVerify_NotFound() {
+	t := s.Require()
+	pubkey, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	ch, err := s.svc.RequestReclaim(context.Background(), 2, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+
+	err = s.svc.VerifyWallet(context.Background(), 2, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign), pubkey)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+}
+
+func (s *WalletsServiceTestSuite) TestReclaimWallet_WalletUnlinkedMeanwhile_Gone() {
+	t := s.Require()
+	svc := s.newServiceWithoutReclaimWait()
+	pubkey, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	ch, err := svc.RequestReclaim(context.Background(), 2, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	reclaim, err := svc.ConfirmReclaim(context.Background(), 2, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign))
+	t.NoError(err)
+
+	_, err = svc.UnlinkWallet(context.Background(), reclaim.WalletID, 1)
+	t.NoError(err)
+
+	err = svc.CompleteReclaim(context.Background(), 2, reclaim.ID)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrGone)
+
+	reclaims, err := svc.ListReclaims(context.Background(), 2)
+	t.NoError(err)
+	t.Equal(enum.ReclaimStatusCancelled, reclaims[0].Status)
+}
+
+func (s *WalletsServiceTestSuite) TestReclaimWallet_OwnerNotified() {
+	t := s.Require()
+	receiver := &webhookReceiver{status: http.StatusNoContent}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	_, err := s.svc.CreateWebhookSubscription(context.Background(), server.URL, []string{"wallet.reclaim_requested"}, "")
+	t.NoError(err)
+
+	pubkey, priv := s.mustAddVerifiedSolanaWallet(1)
+	ch, err := s.svc.RequestReclaim(context.Background(), 2, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	reclaim, err := s.svc.ConfirmReclaim(context.Background(), 2, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign))
+	t.NoError(err)
+
+	publisher := &recordingPublisher{}
+	s.relayAll(s.newOutboxRelay(publisher))
+
+	// wallet.added and wallet.verified come first.
+	t.Len(publisher.messages, 3)
+	t.Equal("wallet.reclaim_requested", publisher.messages[2].Type)
+	t.Equal("1", publisher.messages[2].Key)
+
+	var event dto.WalletDomainEvent
+	t.NoError(json.Unmarshal(publisher.messages[2].Payload, &event))
+	t.Equal(uint(1), event.UserID)
+	t.Equal(reclaim.WalletID, event.WalletID)
+	t.Equal(pubkey, event.Pubkey)
+	t.Equal(strconv.FormatUint(uint64(reclaim.ID), 10), event.Metadata["reclaim_id"])
+	t.Equal("2", event.Metadata["claimant_user_id"])
+
+	sent, err := s.newWebhookDispatcher(3).DeliverDue(context.Background())
+	t.NoError(err)
+	t.Equal(1, sent)
+	t.Len(receiver.requests, 1)
+}
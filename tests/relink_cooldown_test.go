@@ -0,0 +1,255 @@
+package wallets_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	httptransport "github.com/go-kit/kit/transport/http"
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/knstch/knstch-libs/transport"
+	publicApi "github.com/knstch/wallets-ido-api/public"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/endpoints/interceptors"
+	"wallets-service/internal/endpoints/public"
+	"wallets-service/internal/wallets"
+)
+
+// newServiceWithRelink returns a service with the given relink cooldown and churn limit.
+func (s *WalletsServiceTestSuite) newServiceWithRelink(cooldown time.Duration, churnLimit int) wallets.Service {
+	cfg := s.cfg
+	cfg.RelinkConfig.Cooldown = cooldown
+	cfg.RelinkConfig.ChurnWindow = time.Hour
+	cfg.RelinkConfig.ChurnLimit = churnLimit
+	return wallets.NewService(s.logger, s.dbRepo, cfg, s.rdb)
+}
+
+func (s *WalletsServiceTestSuite) TestRelink_OtherUserDuringCooldown_Forbidden() {
+	t := s.Require()
+	svc := s.newServiceWithRelink(time.Hour, 0)
+	pubkey, _ := mustGenerateSolanaKeypair(t)
+
+	_, err := svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	_, err = svc.UnlinkWallet(context.Background(), w.ID, 1)
+	t.NoError(err)
+
+	_, err = svc.AddWallet(context.Background(), 2, pubkey, enum.ProviderPhantom)
+	requireSvcErrIs(s.T(), err, wallets.ErrRelinkCooldown)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrForbidden)
+
+	_, err = svc.GetWallet(context.Background(), 2)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+}
+
+func (s *WalletsServiceTestSuite) TestRelink_ErrorCodes() {
+	t := s.Require()
+	svc := s.newServiceWithRelink(time.Hour, 0)
+	pubkey, _ := mustGenerateSolanaKeypair(t)
+
+	_, err := svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	_, err = svc.UnlinkWallet(context.Background(), w.ID, 1)
+	t.NoError(err)
+
+	_, err = svc.AddWallet(context.Background(), 2, pubkey, enum.ProviderPhantom)
+	st, ok := status.FromError(interceptors.StatusError(err))
+	t.True(ok)
+	t.Equal(codes.PermissionDenied, st.Code())
+	t.Len(st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	t.True(ok)
+	t.Equal(wallets.ErrorCodeRelinkCooldown, info.GetReason())
+
+	var addWallet *httptransport.Server
+	for _, ep := range public.NewController(svc, s.logger, &s.cfg).Endpoints() {
+		if ep.Path != "/addWallet" {
+			continue
+		}
+		handler := ep.Handler
+		for _, mw := range ep.Mdw {
+			handler = mw(handler)
+		}
+		addWallet = httptransport.NewServer(handler, ep.Decoder, ep.Encoder,
+			httptransport.ServerErrorEncoder(transport.EncodeError),
+			httptransport.ServerBefore(httptransport.PopulateRequestContext),
+		)
+	}
+	t.NotNil(addWallet)
+
+	body, err := json.Marshal(&publicApi.AddWalletRequest{Pubkey: pubkey, Provider: publicApi.Provider_PROVIDER_PHANTOM})
+	t.NoError(err)
+	req := httptest.NewRequest(http.MethodPost, "/addWallet", bytes.NewReader(body))
+	req.Header.Set("Authorization", "Bearer "+s.bearerToken(s.cfg.JwtSecret, 2, time.Now().Add(time.Hour)))
+	rec := httptest.NewRecorder()
+	addWallet.ServeHTTP(rec, req)
+
+	t.Equal(http.StatusForbidden, rec.Code)
+	var resp struct {
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}
+	t.NoError(json.Unmarshal(rec.Body.Bytes(), &resp))
+	t.Equal(wallets.ErrorCodeRelinkCooldown, resp.Code)
+	t.NotEmpty(resp.Error)
+}
+
+func (s *WalletsServiceTestSuite) TestRelink_PreviousOwnerDuringCooldown_Allowed() {
+	t := s.Require()
+	svc := s.newServiceWithRelink(time.Hour, 0)
+	pubkey, _ := mustGenerateSolanaKeypair(t)
+
+	_, err := svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	_, err = svc.UnlinkWallet(context.Background(), w.ID, 1)
+	t.NoError(err)
+
+	_, err = svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+}
+
+func (s *WalletsServiceTestSuite) TestRelink_CooldownDisabled_OtherUserAllowed() {
+	t := s.Require()
+	svc := s.newServiceWithRelink(0, 0)
+	pubkey, _ := mustGenerateSolanaKeypair(t)
+
+	_, err := svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	_, err = svc.UnlinkWallet(context.Background(), w.ID, 1)
+	t.NoError(err)
+
+	_, err = svc.AddWallet(context.Background(), 2, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+}
+
+func (s *WalletsServiceTestSuite) TestRelink_ChurnLimit_Forbidden() {
+	t := s.Require()
+	svc := s.newServiceWithRelink(0, 4)
+
+	// Two link/unlink rounds use up the limit of four events.
+	for i := 0; i < 2; i++ {
+		pubkey, _ := mustGenerateSolanaKeypair(t)
+		_, err := svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+		t.NoError(err)
+		w, err := svc.GetWallet(context.Background(), 1)
+		t.NoError(err)
+		_, err = svc.UnlinkWallet(context.Background(), w.ID, 1)
+		t.NoError(err)
+	}
+
+	pubkey, _ := mustGenerateSolanaKeypair(t)
+	_, err := svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	requireSvcErrIs(s.T(), err, wallets.ErrLinkChurnLimit)
+
+	_, err = svc.GetWallet(context.Background(), 1)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+
+	// Other users are not affected.
+	_, err = svc.AddWallet(context.Background(), 2, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+}
+
+func (s *WalletsServiceTestSuite) TestRelink_ReissueChallenge_NotCounted() {
+	t := s.Require()
+	svc := s.newServiceWithRelink(0, 1)
+	pubkey, _ := mustGenerateSolanaKeypair(t)
+
+	_, err := svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+
+	// Re-issuing a challenge for the same unverified wallet is not a new link.
+	_, err = svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+}
+
+// transferWallet moves the wallet of fromUserID to toUserID through a full transfer.
+func (s *WalletsServiceTestSuite) transferWallet(svc wallets.Service, fromUserID, toUserID uint, priv ed25519.PrivateKey) error {
+	t := s.Require()
+
+	w, err := svc.GetWallet(context.Background(), fromUserID)
+	t.NoError(err)
+	transfer, err := svc.InitiateTransfer(context.Background(), fromUserID, w.ID, toUserID)
+	t.NoError(err)
+	ch, err := svc.AcceptTransfer(context.Background(), toUserID, transfer.ID)
+	t.NoError(err)
+
+	_, err = svc.CompleteTransfer(context.Background(), toUserID, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign))
+	return err
+}
+
+func (s *WalletsServiceTestSuite) TestRelink_TransferToThirdUserDuringCooldown_Forbidden() {
+	t := s.Require()
+	svc := s.newServiceWithRelink(time.Hour, 0)
+	_, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	t.NoError(s.transferWallet(svc, 1, 2, priv))
+
+	// The wallet left account 1 within the cooldown, so it can't be passed on to another account.
+	err := s.transferWallet(svc, 2, 3, priv)
+	requireSvcErrIs(s.T(), err, wallets.ErrRelinkCooldown)
+
+	_, err = svc.GetWallet(context.Background(), 2)
+	t.NoError(err)
+}
+
+func (s *WalletsServiceTestSuite) TestRelink_TransferBackAndForth_ChurnLimit() {
+	t := s.Require()
+	svc := s.newServiceWithRelink(0, 2)
+	_, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	// Account 1 linked and then unlinked the wallet by transferring it away.
+	t.NoError(s.transferWallet(svc, 1, 2, priv))
+
+	err := s.transferWallet(svc, 2, 1, priv)
+	requireSvcErrIs(s.T(), err, wallets.ErrLinkChurnLimit)
+
+	_, err = svc.GetWallet(context.Background(), 2)
+	t.NoError(err)
+}
+
+func (s *WalletsServiceTestSuite) TestRelink_ReclaimOverChurnLimit_Forbidden() {
+	t := s.Require()
+	cfg := s.cfg
+	cfg.ReclaimConfig.WaitingPeriod = 0
+	cfg.RelinkConfig.ChurnWindow = time.Hour
+	cfg.RelinkConfig.ChurnLimit = 2
+	svc := wallets.NewService(s.logger, s.dbRepo, cfg, s.rdb)
+
+	pubkey, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	// Account 2 uses up its limit with another wallet.
+	other, _ := mustGenerateSolanaKeypair(t)
+	_, err := svc.AddWallet(context.Background(), 2, other, enum.ProviderPhantom)
+	t.NoError(err)
+	w, err := svc.GetWallet(context.Background(), 2)
+	t.NoError(err)
+	_, err = svc.UnlinkWallet(context.Background(), w.ID, 2)
+	t.NoError(err)
+
+	ch, err := svc.RequestReclaim(context.Background(), 2, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	reclaim, err := svc.ConfirmReclaim(context.Background(), 2, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign))
+	t.NoError(err)
+
+	err = svc.CompleteReclaim(context.Background(), 2, reclaim.ID)
+	requireSvcErrIs(s.T(), err, wallets.ErrLinkChurnLimit)
+
+	_, err = svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+}
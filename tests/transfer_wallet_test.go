@@ -0,0 +1,246 @@
+package wallets_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets"
+)
+
+// newServiceWithExpiredTransfers returns a service whose transfers expire as soon as they are initiated.
+func (s *WalletsServiceTestSuite) newServiceWithExpiredTransfers() wallets.Service {
+	cfg := s.cfg
+	cfg.TransferConfig.TTL = 0
+	return wallets.NewService(s.logger, s.dbRepo, cfg, s.rdb)
+}
+
+func (s *WalletsServiceTestSuite) TestTransferWallet_HappyPath() {
+	t := s.Require()
+	pubkey, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+
+	transfer, err := s.svc.InitiateTransfer(context.Background(), 1, w.ID, 2)
+	t.NoError(err)
+	t.Equal(enum.TransferStatusPending, transfer.Status)
+	t.Equal(pubkey, transfer.Pubkey)
+
+	// The target account sees the incoming transfer.
+	targetTransfers, err := s.svc.ListTransfers(context.Background(), 2)
+	t.NoError(err)
+	t.Len(targetTransfers, 1)
+	t.Equal(transfer.ID, targetTransfers[0].ID)
+
+	ch, err := s.svc.AcceptTransfer(context.Background(), 2, transfer.ID)
+	t.NoError(err)
+
+	completed, err := s.svc.CompleteTransfer(context.Background(), 2, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign))
+	t.NoError(err)
+	t.Equal(enum.TransferStatusCompleted, completed.Status)
+	t.NotNil(completed.AcceptedAt)
+	t.NotNil(completed.ResolvedAt)
+
+	moved, err := s.svc.GetWallet(context.Background(), 2)
+	t.NoError(err)
+	t.Equal(pubkey, moved.Pubkey)
+	t.NotNil(moved.VerifiedAt)
+
+	_, err = s.svc.GetWallet(context.Background(), 1)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+}
+
+func (s *WalletsServiceTestSuite) TestTransferWallet_TargetNotified() {
+	t := s.Require()
+	receiver := &webhookReceiver{status: http.StatusNoContent}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	_, err := s.svc.CreateWebhookSubscription(context.Background(), server.URL, []string{"wallet.transfer_initiated"}, "")
+	t.NoError(err)
+
+	pubkey, _ := s.mustAddVerifiedSolanaWallet(1)
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	transfer, err := s.svc.InitiateTransfer(context.Background(), 1, w.ID, 2)
+	t.NoError(err)
+
+	publisher := &recordingPublisher{}
+	s.relayAll(s.newOutboxRelay(publisher))
+
+	// wallet.added and wallet.verified of the owner come first.
+	t.Len(publisher.messages, 3)
+	t.Equal("wallet.transfer_initiated", publisher.messages[2].Type)
+	t.Equal("2", publisher.messages[2].Key)
+
+	var event dto.WalletDomainEvent
+	t.NoError(json.Unmarshal(publisher.messages[2].Payload, &event))
+	t.Equal(uint(2), event.UserID)
+	t.Equal(w.ID, event.WalletID)
+	t.Equal(pubkey, event.Pubkey)
+	t.Equal(strconv.FormatUint(uint64(transfer.ID), 10), event.Metadata["transfer_id"])
+	t.Equal("1", event.Metadata["owner_user_id"])
+
+	sent, err := s.newWebhookDispatcher(3).DeliverDue(context.Background())
+	t.NoError(err)
+	t.Equal(1, sent)
+	t.Len(receiver.requests, 1)
+}
+
+func (s *WalletsServiceTestSuite) TestTransferWallet_InvalidatesOutstandingChallenges() {
+	t := s.Require()
+	pubkey, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	transfer, err := s.svc.InitiateTransfer(context.Background(), 1, w.ID, 2)
+	t.NoError(err)
+
+	stale, err := s.svc.AcceptTransfer(context.Background(), 2, transfer.ID)
+	t.NoError(err)
+	ch, err := s.svc.AcceptTransfer(context.Background(), 2, transfer.ID)
+	t.NoError(err)
+
+	_, err = s.svc.CompleteTransfer(context.Background(), 2, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign))
+	t.NoError(err)
+
+	_, err = s.svc.CompleteTransfer(context.Background(), 2, stale.ChallengeID, mustSignBase64(priv, stale.MessageToSign))
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+
+	// The moved wallet is still verified by its new owner.
+	moved, err := s.svc.GetWallet(context.Background(), 2)
+	t.NoError(err)
+	t.Equal(pubkey, moved.Pubkey)
+}
+
+func (s *WalletsServiceTestSuite) TestTransferWallet_ToSelf_InvalidData() {
+	t := s.Require()
+	s.mustAddVerifiedSolanaWallet(1)
+
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+
+	_, err = s.svc.InitiateTransfer(context.Background(), 1, w.ID, 1)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
+
+func (s *WalletsServiceTestSuite) TestTransferWallet_Unverified_Forbidden() {
+	t := s.Require()
+	pubkey, _ := mustGenerateSolanaKeypair(t)
+
+	_, err := s.svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+
+	_, err = s.svc.InitiateTransfer(context.Background(), 1, w.ID, 2)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrForbidden)
+}
+
+func (s *WalletsServiceTestSuite) TestTransferWallet_NotOwner_NotFound() {
+	t := s.Require()
+	s.mustAddVerifiedSolanaWallet(1)
+
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+
+	_, err = s.svc.InitiateTransfer(context.Background(), 3, w.ID, 2)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+}
+
+func (s *WalletsServiceTestSuite) TestTransferWallet_AlreadyInProgress_Conflict() {
+	t := s.Require()
+	s.mustAddVerifiedSolanaWallet(1)
+
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+
+	_, err = s.svc.InitiateTransfer(context.Background(), 1, w.ID, 2)
+	t.NoError(err)
+
+	_, err = s.svc.InitiateTransfer(context.Background(), 1, w.ID, 3)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrConflict)
+}
+
+func (s *WalletsServiceTestSuite) TestTransferWallet_AcceptByOtherUser_NotFound() {
+	t := s.Require()
+	s.mustAddVerifiedSolanaWallet(1)
+
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	transfer, err := s.svc.InitiateTransfer(context.Background(), 1, w.ID, 2)
+	t.NoError(err)
+
+	_, err = s.svc.AcceptTransfer(context.Background(), 3, transfer.ID)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+}
+
+func (s *WalletsServiceTestSuite) TestTransferWallet_WrongSigner_Rejected() {
+	t := s.Require()
+	s.mustAddVerifiedSolanaWallet(1)
+	_, otherPriv := mustGenerateSolanaKeypair(t)
+
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	transfer, err := s.svc.InitiateTransfer(context.Background(), 1, w.ID, 2)
+	t.NoError(err)
+	ch, err := s.svc.AcceptTransfer(context.Background(), 2, transfer.ID)
+	t.NoError(err)
+
+	_, err = s.svc.CompleteTransfer(context.Background(), 2, ch.ChallengeID, mustSignBase64(otherPriv, ch.MessageToSign))
+	t.Error(err)
+
+	owned, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	t.Equal(w.ID, owned.ID)
+}
+
+func (s *WalletsServiceTestSuite) TestTransferWallet_CancelledByOwner_Conflict() {
+	t := s.Require()
+	_, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	transfer, err := s.svc.InitiateTransfer(context.Background(), 1, w.ID, 2)
+	t.NoError(err)
+	ch, err := s.svc.AcceptTransfer(context.Background(), 2, transfer.ID)
+	t.NoError(err)
+
+	t.NoError(s.svc.CancelTransfer(context.Background(), 1, transfer.ID))
+
+	_, err = s.svc.CompleteTransfer(context.Background(), 2, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign))
+	requireSvcErrIs(s.T(), err, svcerrs.ErrConflict)
+
+	err = s.svc.CancelTransfer(context.Background(), 2, transfer.ID)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrConflict)
+
+	// A new transfer can be initiated once the previous one is cancelled.
+	_, err = s.svc.InitiateTransfer(context.Background(), 1, w.ID, 2)
+	t.NoError(err)
+}
+
+func (s *WalletsServiceTestSuite) TestTransferWallet_Expired_Gone() {
+	t := s.Require()
+	svc := s.newServiceWithExpiredTransfers()
+	s.mustAddVerifiedSolanaWallet(1)
+
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	transfer, err := svc.InitiateTransfer(context.Background(), 1, w.ID, 2)
+	t.NoError(err)
+
+	_, err = svc.AcceptTransfer(context.Background(), 2, transfer.ID)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrGone)
+
+	transfers, err := svc.ListTransfers(context.Background(), 1)
+	t.NoError(err)
+	t.Len(transfers, 1)
+	t.Equal(enum.TransferStatusExpired, transfers[0].Status)
+}
@@ -0,0 +1,128 @@
+package wallets_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets"
+)
+
+// staticSecondFactor accepts a single code for every user.
+type staticSecondFactor struct {
+	code string
+}
+
+func (f staticSecondFactor) VerifySecondFactor(_ context.Context, _ uint, code string) error {
+	if code != f.code {
+		return fmt.Errorf("wrong second factor code: %w", svcerrs.ErrForbidden)
+	}
+	return nil
+}
+
+// newServiceWithStepUpUnlink returns a service that requires confirmation to unlink verified wallets.
+func (s *WalletsServiceTestSuite) newServiceWithStepUpUnlink() *wallets.ServiceImpl {
+	cfg := s.cfg
+	cfg.UnlinkConfig.StepUp = true
+	return wallets.NewService(s.logger, s.dbRepo, cfg, s.rdb)
+}
+
+func (s *WalletsServiceTestSuite) TestUnlinkWalletStepUp_Signature_HappyPath() {
+	t := s.Require()
+	svc := s.newServiceWithStepUpUnlink()
+	_, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+
+	result, err := svc.UnlinkWallet(context.Background(), w.ID, 1)
+	t.NoError(err)
+	t.False(result.Unlinked)
+	t.NotEmpty(result.Challenge.ChallengeID)
+
+	// The wallet stays linked until the challenge is confirmed.
+	_, err = svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+
+	t.NoError(svc.ConfirmUnlink(context.Background(), 1, result.Challenge.ChallengeID, mustSignBase64(priv, result.Challenge.MessageToSign), ""))
+
+	_, err = svc.GetWallet(context.Background(), 1)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+}
+
+func (s *WalletsServiceTestSuite) TestUnlinkWalletStepUp_Unverified_DeletedDirectly() {
+	t := s.Require()
+	svc := s.newServiceWithStepUpUnlink()
+	pubkey, _ := mustGenerateSolanaKeypair(t)
+
+	_, err := svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+
+	result, err := svc.UnlinkWallet(context.Background(), w.ID, 1)
+	t.NoError(err)
+	t.True(result.Unlinked)
+
+	_, err = svc.GetWallet(context.Background(), 1)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+}
+
+func (s *WalletsServiceTestSuite) TestUnlinkWalletStepUp_WrongSigner_NotDeleted() {
+	t := s.Require()
+	svc := s.newServiceWithStepUpUnlink()
+	s.mustAddVerifiedSolanaWallet(1)
+	_, otherPriv := mustGenerateSolanaKeypair(t)
+
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	result, err := svc.UnlinkWallet(context.Background(), w.ID, 1)
+	t.NoError(err)
+
+	err = svc.ConfirmUnlink(context.Background(), 1, result.Challenge.ChallengeID, mustSignBase64(otherPriv, result.Challenge.MessageToSign), "")
+	t.Error(err)
+
+	_, err = svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+}
+
+func (s *WalletsServiceTestSuite) TestUnlinkWalletStepUp_OtherUser_NotFound() {
+	t := s.Require()
+	svc := s.newServiceWithStepUpUnlink()
+	_, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	result, err := svc.UnlinkWallet(context.Background(), w.ID, 1)
+	t.NoError(err)
+
+	err = svc.ConfirmUnlink(context.Background(), 2, result.Challenge.ChallengeID, mustSignBase64(priv, result.Challenge.MessageToSign), "")
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+}
+
+func (s *WalletsServiceTestSuite) TestUnlinkWalletStepUp_SecondFactor() {
+	t := s.Require()
+	svc := s.newServiceWithStepUpUnlink()
+	s.mustAddVerifiedSolanaWallet(1)
+
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	result, err := svc.UnlinkWallet(context.Background(), w.ID, 1)
+	t.NoError(err)
+
+	// Without a verifier second factor codes are rejected.
+	err = svc.ConfirmUnlink(context.Background(), 1, result.Challenge.ChallengeID, "", "123456")
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+
+	svc.SetSecondFactorVerifier(staticSecondFactor{code: "123456"})
+
+	err = svc.ConfirmUnlink(context.Background(), 1, result.Challenge.ChallengeID, "", "000000")
+	requireSvcErrIs(s.T(), err, svcerrs.ErrForbidden)
+
+	t.NoError(svc.ConfirmUnlink(context.Background(), 1, result.Challenge.ChallengeID, "", "123456"))
+
+	_, err = svc.GetWallet(context.Background(), 1)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+}
@@ -17,7 +17,8 @@ func (s *WalletsServiceTestSuite) TestUnlinkWallet_HappyPath() {
 	w, err := s.svc.GetWallet(context.Background(), 1)
 	t.NoError(err)
 
-	t.NoError(s.svc.UnlinkWallet(context.Background(), w.ID, 1))
+	_, err = s.svc.UnlinkWallet(context.Background(), w.ID, 1)
+	t.NoError(err)
 
 	_, err = s.svc.GetWallet(context.Background(), 1)
 	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
@@ -32,7 +33,7 @@ func (s *WalletsServiceTestSuite) TestUnlinkWallet_WrongUser_NotFound() {
 	w, err := s.svc.GetWallet(context.Background(), 1)
 	t.NoError(err)
 
-	err = s.svc.UnlinkWallet(context.Background(), w.ID, 2)
+	_, err = s.svc.UnlinkWallet(context.Background(), w.ID, 2)
 	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
 }
 
@@ -45,8 +46,9 @@ func (s *WalletsServiceTestSuite) TestUnlinkWallet_IdempotentSecondDelete_NotFou
 	w, err := s.svc.GetWallet(context.Background(), 1)
 	t.NoError(err)
 
-	t.NoError(s.svc.UnlinkWallet(context.Background(), w.ID, 1))
-	err = s.svc.UnlinkWallet(context.Background(), w.ID, 1)
+	_, err = s.svc.UnlinkWallet(context.Background(), w.ID, 1)
+	t.NoError(err)
+	_, err = s.svc.UnlinkWallet(context.Background(), w.ID, 1)
 	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
 }
 
@@ -0,0 +1,116 @@
+package wallets_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets"
+)
+
+// newServiceWithVerificationValidity returns a service whose verifications are valid for 30 days
+// and can be renewed during the last 7 of them.
+func (s *WalletsServiceTestSuite) newServiceWithVerificationValidity() wallets.Service {
+	cfg := s.cfg
+	cfg.VerificationConfig.Validity = 30 * 24 * time.Hour
+	cfg.VerificationConfig.RenewalWindow = 7 * 24 * time.Hour
+	return wallets.NewService(s.logger, s.dbRepo, cfg, s.rdb)
+}
+
+// backdateVerification moves the verification time of the wallet into the past.
+func (s *WalletsServiceTestSuite) backdateVerification(walletID uint, age time.Duration) {
+	s.Require().NoError(s.db.Exec("UPDATE user_wallets SET verified_at = ? WHERE id = ?", time.Now().Add(-age), walletID).Error)
+}
+
+func (s *WalletsServiceTestSuite) TestVerificationStatus_Derived() {
+	t := s.Require()
+	svc := s.newServiceWithVerificationValidity()
+
+	pubkey, _ := mustGenerateSolanaKeypair(t)
+	_, err := svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	t.Equal(enum.VerificationStatusUnverified, w.VerificationStatus)
+	t.Nil(w.VerificationExpiresAt)
+
+	s.mustAddVerifiedSolanaWallet(2)
+	w, err = svc.GetWallet(context.Background(), 2)
+	t.NoError(err)
+	t.Equal(enum.VerificationStatusVerified, w.VerificationStatus)
+	t.NotNil(w.VerificationExpiresAt)
+
+	s.backdateVerification(w.ID, 25*24*time.Hour)
+	w, err = svc.GetWallet(context.Background(), 2)
+	t.NoError(err)
+	t.Equal(enum.VerificationStatusExpiringSoon, w.VerificationStatus)
+
+	s.backdateVerification(w.ID, 31*24*time.Hour)
+	w, err = svc.GetWallet(context.Background(), 2)
+	t.NoError(err)
+	t.Equal(enum.VerificationStatusExpired, w.VerificationStatus)
+	t.True(w.VerificationExpiresAt.Before(time.Now()))
+}
+
+func (s *WalletsServiceTestSuite) TestVerificationStatus_NoValidity_NeverExpires() {
+	t := s.Require()
+	cfg := s.cfg
+	cfg.VerificationConfig.Validity = 0
+	svc := wallets.NewService(s.logger, s.dbRepo, cfg, s.rdb)
+
+	s.mustAddVerifiedSolanaWallet(1)
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	s.backdateVerification(w.ID, 10*365*24*time.Hour)
+
+	w, err = svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	t.Equal(enum.VerificationStatusVerified, w.VerificationStatus)
+	t.Nil(w.VerificationExpiresAt)
+}
+
+func (s *WalletsServiceTestSuite) TestAddWallet_ExpiringSoon_IssuesReverification() {
+	t := s.Require()
+	svc := s.newServiceWithVerificationValidity()
+	pubkey, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	s.backdateVerification(w.ID, 25*24*time.Hour)
+
+	ch, err := svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	t.NoError(svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign), pubkey))
+
+	w, err = svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	t.Equal(enum.VerificationStatusVerified, w.VerificationStatus)
+}
+
+func (s *WalletsServiceTestSuite) TestAddWallet_Expired_IssuesReverification() {
+	t := s.Require()
+	svc := s.newServiceWithVerificationValidity()
+	pubkey, _ := s.mustAddVerifiedSolanaWallet(1)
+
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	s.backdateVerification(w.ID, 31*24*time.Hour)
+
+	_, err = svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+}
+
+func (s *WalletsServiceTestSuite) TestAddWallet_VerificationValid_Conflict() {
+	t := s.Require()
+	svc := s.newServiceWithVerificationValidity()
+	pubkey, _ := s.mustAddVerifiedSolanaWallet(1)
+
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	s.backdateVerification(w.ID, 10*24*time.Hour)
+
+	_, err = svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrConflict)
+}
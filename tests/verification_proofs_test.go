@@ -0,0 +1,135 @@
+package wallets_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets"
+	"wallets-service/internal/wallets/chains"
+)
+
+func (s *WalletsServiceTestSuite) TestVerificationProofs_StoredOnVerify() {
+	t := s.Require()
+	pubkey, priv := mustGenerateSolanaKeypair(t)
+
+	ctx := wallets.WithRequestMeta(context.Background(), dto.RequestMeta{
+		IP:        "203.0.113.7",
+		UserAgent: "test-agent",
+	})
+
+	ch, err := s.svc.AddWallet(ctx, 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	signature := mustSignBase64(priv, ch.MessageToSign)
+	t.NoError(s.svc.VerifyWallet(ctx, 1, ch.ChallengeID, signature, pubkey))
+
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+
+	proofs, err := s.svc.ListVerificationProofs(context.Background(), w.ID, 0, "")
+	t.NoError(err)
+	t.Len(proofs, 1)
+
+	proof := proofs[0]
+	t.Equal(w.ID, proof.WalletID)
+	t.Equal(uint(1), proof.UserID)
+	t.Equal(pubkey, proof.Pubkey)
+	t.Equal(enum.ProviderPhantom, proof.Provider)
+	t.Equal(ch.ChallengeID, proof.ChallengeID)
+	t.Equal(ch.MessageToSign, string(proof.Message))
+	// Phantom signs the message as is.
+	t.Equal(ch.MessageToSign, string(proof.SignedPayload))
+	t.Equal(signature, proof.Signature)
+	t.NotEmpty(proof.Encoding)
+	t.Equal(chains.VerifierVersion, proof.VerifierVersion)
+
+	// Client identifiers are stored hashed.
+	t.NotEmpty(proof.IPHash)
+	t.NotEqual("203.0.113.7", proof.IPHash)
+	t.NotEmpty(proof.UserAgentHash)
+	t.NotEqual("test-agent", proof.UserAgentHash)
+}
+
+func (s *WalletsServiceTestSuite) TestVerificationProofs_NoHashKey_ClientHashesEmpty() {
+	t := s.Require()
+	cfg := s.cfg
+	cfg.ProofsConfig.ClientHashKey = ""
+	svc := wallets.NewService(s.logger, s.dbRepo, cfg, s.rdb)
+	pubkey, priv := mustGenerateSolanaKeypair(t)
+
+	ctx := wallets.WithRequestMeta(context.Background(), dto.RequestMeta{
+		IP:        "203.0.113.7",
+		UserAgent: "test-agent",
+	})
+
+	ch, err := svc.AddWallet(ctx, 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	t.NoError(svc.VerifyWallet(ctx, 1, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign), pubkey))
+
+	proofs, err := svc.ListVerificationProofs(context.Background(), 0, 1, "")
+	t.NoError(err)
+	t.Len(proofs, 1)
+	t.Empty(proofs[0].IPHash)
+	t.Empty(proofs[0].UserAgentHash)
+}
+
+func (s *WalletsServiceTestSuite) TestVerificationProofs_StoresSignedPayload() {
+	t := s.Require()
+	address, priv := mustGenerateAptosKeypair(t)
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderAptos)
+	t.NoError(err)
+	t.NoError(s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, mustSignAptosMessage(t, priv, ch.MessageToSign, ch.ChallengeID), address))
+
+	proofs, err := s.svc.ListVerificationProofs(context.Background(), 0, 1, "")
+	t.NoError(err)
+	t.Len(proofs, 1)
+
+	// The display message and the bytes the wallet signed differ, and the proof verifies on its own.
+	proof := proofs[0]
+	t.Equal(ch.MessageToSign, string(proof.Message))
+	t.Equal("APTOS\nmessage: "+ch.MessageToSign+"\nnonce: "+ch.ChallengeID, string(proof.SignedPayload))
+
+	var sig struct {
+		PublicKey string `json:"public_key"`
+		Signature string `json:"signature"`
+	}
+	t.NoError(json.Unmarshal([]byte(proof.Signature), &sig))
+	pub, err := hex.DecodeString(strings.TrimPrefix(sig.PublicKey, "0x"))
+	t.NoError(err)
+	sigBytes, err := hex.DecodeString(strings.TrimPrefix(sig.Signature, "0x"))
+	t.NoError(err)
+	t.True(ed25519.Verify(pub, proof.SignedPayload, sigBytes))
+}
+
+func (s *WalletsServiceTestSuite) TestVerificationProofs_KeptAcrossReverification() {
+	t := s.Require()
+	svc := s.newServiceWithVerificationValidity()
+	pubkey, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	s.backdateVerification(w.ID, 31*24*time.Hour)
+
+	ch, err := svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	t.NoError(svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign), pubkey))
+
+	proofs, err := svc.ListVerificationProofs(context.Background(), 0, 0, pubkey)
+	t.NoError(err)
+	t.Len(proofs, 2)
+	t.Less(proofs[0].ID, proofs[1].ID)
+	t.Empty(proofs[0].IPHash)
+}
+
+func (s *WalletsServiceTestSuite) TestVerificationProofs_NoFilter_InvalidData() {
+	_, err := s.svc.ListVerificationProofs(context.Background(), 0, 0, "")
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
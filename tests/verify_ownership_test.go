@@ -0,0 +1,96 @@
+package wallets_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/enum"
+)
+
+func (s *WalletsServiceTestSuite) TestVerifyOwnership_HappyPath() {
+	t := s.Require()
+	pubkey, priv := s.mustAddVerifiedSolanaWallet(1)
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+
+	const statement = "I accept the terms of service v3."
+	ch, err := s.svc.CreateOwnershipChallenge(context.Background(), 1, statement, "terms:v3")
+	t.NoError(err)
+	// Phantom signs Sign In With Solana messages, which carry the statement after the account.
+	t.True(strings.HasPrefix(ch.MessageToSign, s.cfg.SolanaConfig.SIWSDomain+" wants you to sign in with your Solana account:\n"+pubkey+"\n\n"+statement+"\n"))
+
+	proof, err := s.svc.VerifyOwnership(context.Background(), 1, ch.ChallengeID, "terms:v3", mustSignBase64(priv, ch.MessageToSign))
+	t.NoError(err)
+	t.Equal(w.ID, proof.WalletID)
+	t.Equal(pubkey, proof.Pubkey)
+	t.Equal(enum.ProviderPhantom, proof.Provider)
+	t.Equal("terms:v3", proof.Purpose)
+	t.Equal(statement, proof.Statement)
+	t.Equal(ch.MessageToSign, proof.Message)
+	t.Equal("base64", proof.Encoding)
+
+	// The challenge is redeemed.
+	_, err = s.svc.VerifyOwnership(context.Background(), 1, ch.ChallengeID, "terms:v3", mustSignBase64(priv, ch.MessageToSign))
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyOwnership_CannotBeCrossUsed() {
+	t := s.Require()
+	_, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	ch, err := s.svc.CreateOwnershipChallenge(context.Background(), 1, "Claim vesting schedule 7.", "vesting.claim")
+	t.NoError(err)
+	signature := mustSignBase64(priv, ch.MessageToSign)
+
+	_, err = s.svc.VerifyOwnership(context.Background(), 1, ch.ChallengeID, "terms:v3", signature)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+
+	_, err = s.svc.VerifyOwnership(context.Background(), 2, ch.ChallengeID, "vesting.claim", signature)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+
+	// Ownership challenges can't stand in for built-in ones either.
+	err = s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, signature, "")
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+
+	_, err = s.svc.VerifyOwnership(context.Background(), 1, ch.ChallengeID, "vesting.claim", mustSignBase64(priv, "something else"))
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+
+	_, err = s.svc.VerifyOwnership(context.Background(), 1, ch.ChallengeID, "vesting.claim", signature)
+	t.NoError(err)
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyOwnership_Errors() {
+	t := s.Require()
+
+	_, err := s.svc.CreateOwnershipChallenge(context.Background(), 1, "statement", "terms")
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+
+	unverified, _ := mustGenerateSolanaKeypair(t)
+	_, err = s.svc.AddWallet(context.Background(), 1, unverified, enum.ProviderPhantom)
+	t.NoError(err)
+	_, err = s.svc.CreateOwnershipChallenge(context.Background(), 1, "statement", "terms")
+	requireSvcErrIs(s.T(), err, svcerrs.ErrForbidden)
+
+	s.mustAddVerifiedSolanaWallet(2)
+	for _, tc := range []struct{ statement, purpose string }{
+		{"", "terms"},
+		{strings.Repeat("x", 2001), "terms"},
+		{"statement", ""},
+		{"statement", "terms v3"},
+		{"statement", strings.Repeat("p", 101)},
+		// SIWS statements are a single line.
+		{"line 1\nline 2", "terms"},
+	} {
+		_, err = s.svc.CreateOwnershipChallenge(context.Background(), 2, tc.statement, tc.purpose)
+		requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+	}
+
+	address, priv := mustGenerateStellarKeypair(t)
+	ch, err := s.svc.AddWallet(context.Background(), 3, address, enum.ProviderStellar)
+	t.NoError(err)
+	t.NoError(s.svc.VerifyWallet(context.Background(), 3, ch.ChallengeID, mustCosignStellarChallenge(t, priv, s.cfg.StellarConfig.NetworkPassphrase, ch.MessageToSign), address))
+	_, err = s.svc.CreateOwnershipChallenge(context.Background(), 3, "statement", "terms")
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
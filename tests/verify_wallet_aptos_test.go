@@ -0,0 +1,59 @@
+package wallets_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/enum"
+)
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletAptos_HappyPath() {
+	t := s.Require()
+	address, priv := mustGenerateAptosKeypair(t)
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderAptos)
+	t.NoError(err)
+
+	sig := mustSignAptosMessage(t, priv, ch.MessageToSign, ch.ChallengeID)
+	t.NoError(s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, sig, address))
+
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	t.Equal(enum.ProviderAptos, w.Provider)
+	t.NotNil(w.VerifiedAt)
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletAptos_NonCanonicalAddress_InvalidData() {
+	t := s.Require()
+	address, _ := mustGenerateAptosKeypair(t)
+
+	_, err := s.svc.AddWallet(context.Background(), 1, strings.ToUpper(address[2:]), enum.ProviderAptos)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletAptos_PubkeyDoesNotMatchAddress_InvalidData() {
+	t := s.Require()
+	address, _ := mustGenerateAptosKeypair(t)
+	_, otherPriv := mustGenerateAptosKeypair(t)
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderAptos)
+	t.NoError(err)
+
+	sig := mustSignAptosMessage(t, otherPriv, ch.MessageToSign, ch.ChallengeID)
+	err = s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, sig, address)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletAptos_WrongNonce_InvalidData() {
+	t := s.Require()
+	address, priv := mustGenerateAptosKeypair(t)
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderAptos)
+	t.NoError(err)
+
+	sig := mustSignAptosMessage(t, priv, ch.MessageToSign, "another-nonce")
+	err = s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, sig, address)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
@@ -0,0 +1,96 @@
+package wallets_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/enum"
+)
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletCosmos_HappyPath() {
+	t := s.Require()
+	address, priv := mustGenerateCosmosKeypair(t, "cosmos")
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderCosmos)
+	t.NoError(err)
+
+	sig := mustSignADR036(t, priv, address, ch.MessageToSign)
+	t.NoError(s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, sig, address))
+
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	t.Equal(enum.ProviderCosmos, w.Provider)
+	t.NotNil(w.VerifiedAt)
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletCosmos_UnknownPrefix_InvalidData() {
+	t := s.Require()
+	address, _ := mustGenerateCosmosKeypair(t, "evil")
+
+	_, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderCosmos)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletCosmos_PubkeyDoesNotMatchAddress_InvalidData() {
+	t := s.Require()
+	address, _ := mustGenerateCosmosKeypair(t, "cosmos")
+	_, otherPriv := mustGenerateCosmosKeypair(t, "cosmos")
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderCosmos)
+	t.NoError(err)
+
+	// Valid signature, but made by a key that doesn't hash to the claimed address.
+	sig := mustSignADR036(t, otherPriv, address, ch.MessageToSign)
+	err = s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, sig, address)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletCosmos_TamperedMessage_InvalidData() {
+	t := s.Require()
+	address, priv := mustGenerateCosmosKeypair(t, "cosmos")
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderCosmos)
+	t.NoError(err)
+
+	sig := mustSignADR036(t, priv, address, ch.MessageToSign+"tampered")
+	err = s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, sig, address)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletCosmos_UnsupportedPubkeyType_InvalidData() {
+	t := s.Require()
+	address, priv := mustGenerateCosmosKeypair(t, "cosmos")
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderCosmos)
+	t.NoError(err)
+
+	var stdSig map[string]any
+	t.NoError(json.Unmarshal([]byte(mustSignADR036(t, priv, address, ch.MessageToSign)), &stdSig))
+	stdSig["pub_key"].(map[string]any)["type"] = "tendermint/PubKeyEd25519"
+	raw, err := json.Marshal(stdSig)
+	t.NoError(err)
+
+	err = s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, string(raw), address)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletCosmos_UncompressedPubkey_InvalidData() {
+	t := s.Require()
+	address, priv := mustGenerateCosmosKeypair(t, "cosmos")
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderCosmos)
+	t.NoError(err)
+
+	// Same key and a valid signature, but in the uncompressed form the Cosmos SDK rejects.
+	var stdSig map[string]any
+	t.NoError(json.Unmarshal([]byte(mustSignADR036(t, priv, address, ch.MessageToSign)), &stdSig))
+	stdSig["pub_key"].(map[string]any)["value"] = base64.StdEncoding.EncodeToString(priv.PubKey().SerializeUncompressed())
+	raw, err := json.Marshal(stdSig)
+	t.NoError(err)
+
+	err = s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, string(raw), address)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
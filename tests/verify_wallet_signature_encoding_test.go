@@ -0,0 +1,90 @@
+package wallets_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+	"github.com/mr-tron/base58"
+
+	"wallets-service/internal/domain/enum"
+)
+
+// ambiguousSignature is valid base58 and valid unpadded base64, and both decode to 64 different bytes.
+const ambiguousSignature = "1WjicSwoNwcUL6SbVbndj7t6JmxgmQtGWgnF8no53nfvrMSamWsFXYFXsdH1dxbWZ1bK1kvyumwc1RRL27potg"
+
+func (s *WalletsServiceTestSuite) TestVerifyWallet_SignatureEncodings() {
+	encoders := map[string]func(sig []byte) string{
+		"base58":        base58.Encode,
+		"hex":           hex.EncodeToString,
+		"0x hex":        func(sig []byte) string { return "0x" + hex.EncodeToString(sig) },
+		"base64":        base64.StdEncoding.EncodeToString,
+		"raw base64":    base64.RawStdEncoding.EncodeToString,
+		"base64url":     base64.URLEncoding.EncodeToString,
+		"raw base64url": base64.RawURLEncoding.EncodeToString,
+		"byte array":    mustEncodeByteArray,
+		"whitespace":    func(sig []byte) string { return " " + base58.Encode(sig) + "\n" },
+	}
+
+	userID := uint(1)
+	for name, encode := range encoders {
+		s.Run(name, func() {
+			t := s.Require()
+			pubkey, priv := mustGenerateSolanaKeypair(t)
+
+			ch, err := s.svc.AddWallet(context.Background(), userID, pubkey, enum.ProviderPhantom)
+			t.NoError(err)
+
+			sig := encode(ed25519.Sign(priv, []byte(ch.MessageToSign)))
+			t.NoError(s.svc.VerifyWallet(context.Background(), userID, ch.ChallengeID, sig, pubkey))
+
+			w, err := s.svc.GetWallet(context.Background(), userID)
+			t.NoError(err)
+			t.NotNil(w.VerifiedAt)
+		})
+		userID++
+	}
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyWallet_AmbiguousSignature_InvalidData() {
+	t := s.Require()
+	pubkey, _ := mustGenerateSolanaKeypair(t)
+	ch, err := s.svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+
+	err = s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, ambiguousSignature, pubkey)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+	t.ErrorContains(err, "ambiguous")
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyWallet_ByteArrayOutOfRange_InvalidData() {
+	t := s.Require()
+	pubkey, priv := mustGenerateSolanaKeypair(t)
+	ch, err := s.svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+
+	values := make([]int, ed25519.SignatureSize)
+	for i, b := range ed25519.Sign(priv, []byte(ch.MessageToSign)) {
+		values[i] = int(b)
+	}
+	values[0] = 256
+	raw, err := json.Marshal(values)
+	t.NoError(err)
+
+	err = s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, string(raw), pubkey)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyWallet_SignatureWrongLength_InvalidData() {
+	t := s.Require()
+	pubkey, priv := mustGenerateSolanaKeypair(t)
+	ch, err := s.svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+
+	sig := ed25519.Sign(priv, []byte(ch.MessageToSign))
+	err = s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, "0x"+hex.EncodeToString(sig[:63]), pubkey)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
@@ -0,0 +1,69 @@
+package wallets_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/enum"
+)
+
+func (s *WalletsServiceTestSuite) TestAddWallet_SolanaProviders_MessageFormat() {
+	cases := map[enum.Provider]enum.MessageFormat{
+		enum.ProviderPhantom:        enum.MessageFormatSIWS,
+		enum.ProviderSolflare:       enum.MessageFormatSIWS,
+		enum.ProviderBackpack:       enum.MessageFormatSIWS,
+		enum.ProviderGlow:           enum.MessageFormatText,
+		enum.ProviderLedger:         enum.MessageFormatSolanaOffchain,
+		enum.ProviderWalletStandard: enum.MessageFormatText,
+	}
+
+	userID := uint(1)
+	for provider, format := range cases {
+		s.Run(provider.String(), func() {
+			t := s.Require()
+			pubkey, priv := mustGenerateSolanaKeypair(t)
+
+			ch, err := s.svc.AddWallet(context.Background(), userID, pubkey, provider)
+			t.NoError(err)
+			t.Equal(format, ch.MessageFormat)
+
+			sig := mustSignBase64(priv, ch.MessageToSign)
+			if format == enum.MessageFormatSolanaOffchain {
+				sig = mustSignSolanaOffchainMessage(priv, ch.MessageToSign)
+			}
+			t.NoError(s.svc.VerifyWallet(context.Background(), userID, ch.ChallengeID, sig, pubkey))
+
+			w, err := s.svc.GetWallet(context.Background(), userID)
+			t.NoError(err)
+			t.Equal(provider, w.Provider)
+			t.NotNil(w.VerifiedAt)
+		})
+		userID++
+	}
+}
+
+func (s *WalletsServiceTestSuite) TestAddWallet_SIWSMessage() {
+	t := s.Require()
+	pubkey, _ := mustGenerateSolanaKeypair(t)
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+
+	t.True(strings.HasPrefix(ch.MessageToSign, s.cfg.SolanaConfig.SIWSDomain+" wants you to sign in with your Solana account:\n"+pubkey+"\n\n"))
+	t.Contains(ch.MessageToSign, "\nURI: "+s.cfg.SolanaConfig.SIWSURI)
+	t.Contains(ch.MessageToSign, "\nVersion: 1\nChain ID: mainnet\nNonce: ")
+	t.Contains(ch.MessageToSign, "\nRequest ID: "+ch.ChallengeID)
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletLedger_UnwrappedSignature_InvalidData() {
+	t := s.Require()
+	pubkey, priv := mustGenerateSolanaKeypair(t)
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderLedger)
+	t.NoError(err)
+
+	err = s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign), pubkey)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
@@ -0,0 +1,70 @@
+package wallets_test
+
+import (
+	"context"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/enum"
+)
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletStellar_HappyPath() {
+	t := s.Require()
+	address, priv := mustGenerateStellarKeypair(t)
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderStellar)
+	t.NoError(err)
+
+	signed := mustCosignStellarChallenge(t, priv, s.cfg.StellarConfig.NetworkPassphrase, ch.MessageToSign)
+	t.NoError(s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, signed, address))
+
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	t.Equal(enum.ProviderStellar, w.Provider)
+	t.NotNil(w.VerifiedAt)
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletStellar_InvalidAddress_InvalidData() {
+	t := s.Require()
+	pubkey, _ := mustGenerateSolanaKeypair(t)
+
+	_, err := s.svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderStellar)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletStellar_NotCosigned_InvalidData() {
+	t := s.Require()
+	address, _ := mustGenerateStellarKeypair(t)
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderStellar)
+	t.NoError(err)
+
+	// Returning the challenge untouched only carries the server signature.
+	err = s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, ch.MessageToSign, address)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletStellar_SignedByAnotherAccount_InvalidData() {
+	t := s.Require()
+	address, _ := mustGenerateStellarKeypair(t)
+	_, otherPriv := mustGenerateStellarKeypair(t)
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderStellar)
+	t.NoError(err)
+
+	signed := mustCosignStellarChallenge(t, otherPriv, s.cfg.StellarConfig.NetworkPassphrase, ch.MessageToSign)
+	err = s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, signed, address)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletStellar_WrongNetwork_InvalidData() {
+	t := s.Require()
+	address, priv := mustGenerateStellarKeypair(t)
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderStellar)
+	t.NoError(err)
+
+	signed := mustCosignStellarChallenge(t, priv, "Public Global Stellar Network ; September 2015", ch.MessageToSign)
+	err = s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, signed, address)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
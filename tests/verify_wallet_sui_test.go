@@ -0,0 +1,50 @@
+package wallets_test
+
+import (
+	"context"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/enum"
+)
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletSui_HappyPath() {
+	t := s.Require()
+	address, priv := mustGenerateSuiKeypair(t)
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderSui)
+	t.NoError(err)
+
+	sig := mustSignSuiPersonalMessage(priv, ch.MessageToSign)
+	t.NoError(s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, sig, address))
+
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	t.Equal(enum.ProviderSui, w.Provider)
+	t.NotNil(w.VerifiedAt)
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletSui_PubkeyDoesNotMatchAddress_InvalidData() {
+	t := s.Require()
+	address, _ := mustGenerateSuiKeypair(t)
+	_, otherPriv := mustGenerateSuiKeypair(t)
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderSui)
+	t.NoError(err)
+
+	sig := mustSignSuiPersonalMessage(otherPriv, ch.MessageToSign)
+	err = s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, sig, address)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
+
+func (s *WalletsServiceTestSuite) TestVerifyWalletSui_TamperedMessage_InvalidData() {
+	t := s.Require()
+	address, priv := mustGenerateSuiKeypair(t)
+
+	ch, err := s.svc.AddWallet(context.Background(), 1, address, enum.ProviderSui)
+	t.NoError(err)
+
+	sig := mustSignSuiPersonalMessage(priv, ch.MessageToSign+"tampered")
+	err = s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, sig, address)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
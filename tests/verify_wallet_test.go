@@ -171,7 +171,8 @@ func (s *WalletsServiceTestSuite) TestVerifyWallet_WalletDeletedBeforeVerify_Not
 
 	w, err := s.svc.GetWallet(context.Background(), 1)
 	t.NoError(err)
-	t.NoError(s.svc.UnlinkWallet(context.Background(), w.ID, 1))
+	_, err = s.svc.UnlinkWallet(context.Background(), w.ID, 1)
+	t.NoError(err)
 
 	sig := mustSignBase64(priv, ch.MessageToSign)
 	err = s.svc.VerifyWallet(context.Background(), 1, ch.ChallengeID, sig, pubkey)
@@ -0,0 +1,133 @@
+package wallets_test
+
+import (
+	"context"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets"
+)
+
+func (s *WalletsServiceTestSuite) TestWalletEvents_Lifecycle() {
+	t := s.Require()
+	pubkey, priv := mustGenerateSolanaKeypair(t)
+	ctx := wallets.WithRequestMeta(context.Background(), dto.RequestMeta{
+		RequestID: "req-1",
+	})
+
+	ch, err := s.svc.AddWallet(ctx, 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+	t.NoError(s.svc.VerifyWallet(ctx, 1, ch.ChallengeID, mustSignBase64(priv, ch.MessageToSign), pubkey))
+	w, err := s.svc.GetWallet(ctx, 1)
+	t.NoError(err)
+	_, err = s.svc.UnlinkWallet(ctx, w.ID, 1)
+	t.NoError(err)
+
+	events, err := s.svc.ListWalletEvents(context.Background(), 1, 0, "", 0, 0)
+	t.NoError(err)
+	t.Len(events, 3)
+
+	// Newest first.
+	t.Equal(enum.WalletEventUnlinked, events[0].Type)
+	t.Equal("none", events[0].Metadata["confirmation"])
+	t.Equal(enum.WalletEventVerified, events[1].Type)
+	t.Equal(ch.ChallengeID, events[1].Metadata["challenge_id"])
+	t.NotEmpty(events[1].Metadata["signature_encoding"])
+	t.Equal(enum.WalletEventAdded, events[2].Type)
+
+	for _, event := range events {
+		t.Equal(uint(1), event.UserID)
+		t.Equal(w.ID, event.WalletID)
+		t.Equal(pubkey, event.Pubkey)
+		t.Equal(enum.ProviderPhantom, event.Provider)
+		t.Equal(enum.WalletEventActorUser, event.Actor)
+		t.Equal("req-1", event.RequestID)
+	}
+}
+
+func (s *WalletsServiceTestSuite) TestWalletEvents_StepUpUnlink_RecordsConfirmation() {
+	t := s.Require()
+	svc := s.newServiceWithStepUpUnlink()
+	_, priv := s.mustAddVerifiedSolanaWallet(1)
+
+	w, err := svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	result, err := svc.UnlinkWallet(context.Background(), w.ID, 1)
+	t.NoError(err)
+
+	// Issuing the unlink challenge changes nothing yet.
+	events, err := svc.ListWalletEvents(context.Background(), 0, w.ID, "", 0, 0)
+	t.NoError(err)
+	t.Len(events, 2)
+
+	t.NoError(svc.ConfirmUnlink(context.Background(), 1, result.Challenge.ChallengeID, mustSignBase64(priv, result.Challenge.MessageToSign), ""))
+
+	events, err = svc.ListWalletEvents(context.Background(), 0, w.ID, "", 0, 0)
+	t.NoError(err)
+	t.Len(events, 3)
+	t.Equal(enum.WalletEventUnlinked, events[0].Type)
+	t.Equal("signature", events[0].Metadata["confirmation"])
+}
+
+func (s *WalletsServiceTestSuite) TestWalletEvents_Transfer_RecordsMove() {
+	t := s.Require()
+	_, priv := s.mustAddVerifiedSolanaWallet(1)
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+
+	t.NoError(s.transferWallet(s.svc, 1, 2, priv))
+
+	events, err := s.svc.ListWalletEvents(context.Background(), 1, 0, "", 0, 0)
+	t.NoError(err)
+	t.Len(events, 3)
+	t.Equal(enum.WalletEventUnlinked, events[0].Type)
+	t.Equal(w.ID, events[0].WalletID)
+	t.Equal("transfer", events[0].Metadata["move"])
+	t.Equal("2", events[0].Metadata["moved_to_user_id"])
+
+	events, err = s.svc.ListWalletEvents(context.Background(), 2, 0, "", 0, 0)
+	t.NoError(err)
+	t.Len(events, 2)
+	t.Equal(enum.WalletEventVerified, events[0].Type)
+	t.Equal("transfer", events[0].Metadata["move"])
+	t.Equal(enum.WalletEventAdded, events[1].Type)
+	t.Equal("1", events[1].Metadata["moved_from_user_id"])
+	for _, event := range events {
+		t.Equal(w.ID, event.WalletID)
+		t.Equal(w.Pubkey, event.Pubkey)
+	}
+}
+
+func (s *WalletsServiceTestSuite) TestWalletEvents_FailedAdd_NotRecorded() {
+	t := s.Require()
+	pubkey, _ := s.mustAddVerifiedSolanaWallet(1)
+
+	_, err := s.svc.AddWallet(context.Background(), 2, pubkey, enum.ProviderPhantom)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrConflict)
+
+	events, err := s.svc.ListWalletEvents(context.Background(), 2, 0, "", 0, 0)
+	t.NoError(err)
+	t.Empty(events)
+}
+
+func (s *WalletsServiceTestSuite) TestWalletEvents_Paging() {
+	t := s.Require()
+	s.mustAddVerifiedSolanaWallet(1)
+
+	page, err := s.svc.ListWalletEvents(context.Background(), 1, 0, "", 0, 1)
+	t.NoError(err)
+	t.Len(page, 1)
+	t.Equal(enum.WalletEventVerified, page[0].Type)
+
+	page, err = s.svc.ListWalletEvents(context.Background(), 1, 0, "", page[0].ID, 1)
+	t.NoError(err)
+	t.Len(page, 1)
+	t.Equal(enum.WalletEventAdded, page[0].Type)
+}
+
+func (s *WalletsServiceTestSuite) TestWalletEvents_NoFilter_InvalidData() {
+	_, err := s.svc.ListWalletEvents(context.Background(), 0, 0, "", 0, 0)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
@@ -0,0 +1,119 @@
+package wallets_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/enum"
+)
+
+func (s *WalletsServiceTestSuite) TestWalletSnapshot_CapturesVerifiedWallets() {
+	t := s.Require()
+	svc := s.newServiceWithVerificationValidity()
+
+	var pubkeys []string
+	for userID := uint(1); userID <= 3; userID++ {
+		pubkey, _ := s.mustAddVerifiedSolanaWallet(userID)
+		pubkeys = append(pubkeys, pubkey)
+	}
+	sort.Strings(pubkeys)
+
+	unverified, _ := mustGenerateSolanaKeypair(t)
+	_, err := svc.AddWallet(context.Background(), 4, unverified, enum.ProviderPhantom)
+	t.NoError(err)
+
+	expiredPubkey, _ := s.mustAddVerifiedSolanaWallet(5)
+	expired, err := svc.GetWallet(context.Background(), 5)
+	t.NoError(err)
+	s.backdateVerification(expired.ID, 31*24*time.Hour)
+
+	snapshot, err := svc.CreateWalletSnapshot(context.Background(), "round 1")
+	t.NoError(err)
+	t.NotZero(snapshot.ID)
+	t.Equal("round 1", snapshot.Label)
+	t.Equal(3, snapshot.WalletCount)
+
+	var csvExport bytes.Buffer
+	t.NoError(svc.ExportWalletSnapshot(context.Background(), snapshot.ID, enum.SnapshotFormatCSV, &csvExport))
+	sum := sha256.Sum256(csvExport.Bytes())
+	t.Equal(snapshot.ContentHash, hex.EncodeToString(sum[:]))
+
+	lines := strings.Split(strings.TrimSuffix(csvExport.String(), "\n"), "\n")
+	t.Len(lines, 4)
+	t.Equal("user_id,pubkey,provider,verified_at", lines[0])
+	for i, pubkey := range pubkeys {
+		t.Contains(lines[i+1], ","+pubkey+",phantom,")
+	}
+	t.NotContains(csvExport.String(), unverified)
+	t.NotContains(csvExport.String(), expiredPubkey)
+
+	var jsonlExport bytes.Buffer
+	t.NoError(svc.ExportWalletSnapshot(context.Background(), snapshot.ID, enum.SnapshotFormatJSONLines, &jsonlExport))
+	dec := json.NewDecoder(&jsonlExport)
+	for _, pubkey := range pubkeys {
+		var line map[string]any
+		t.NoError(dec.Decode(&line))
+		t.Equal(pubkey, line["pubkey"])
+		t.Equal("phantom", line["provider"])
+	}
+	t.False(dec.More())
+}
+
+func (s *WalletsServiceTestSuite) TestWalletSnapshot_FrozenAndDeterministic() {
+	t := s.Require()
+	s.mustAddVerifiedSolanaWallet(1)
+	s.mustAddVerifiedSolanaWallet(2)
+
+	first, err := s.svc.CreateWalletSnapshot(context.Background(), "")
+	t.NoError(err)
+	second, err := s.svc.CreateWalletSnapshot(context.Background(), "")
+	t.NoError(err)
+	t.NotEqual(first.ID, second.ID)
+	t.Equal(first.ContentHash, second.ContentHash)
+
+	// Later changes don't affect taken snapshots.
+	w, err := s.svc.GetWallet(context.Background(), 1)
+	t.NoError(err)
+	_, err = s.svc.UnlinkWallet(context.Background(), w.ID, 1)
+	t.NoError(err)
+
+	stored, err := s.svc.GetWalletSnapshot(context.Background(), first.ID)
+	t.NoError(err)
+	t.Equal(2, stored.WalletCount)
+
+	var export bytes.Buffer
+	t.NoError(s.svc.ExportWalletSnapshot(context.Background(), first.ID, enum.SnapshotFormatCSV, &export))
+	sum := sha256.Sum256(export.Bytes())
+	t.Equal(first.ContentHash, hex.EncodeToString(sum[:]))
+	t.Contains(export.String(), w.Pubkey)
+
+	third, err := s.svc.CreateWalletSnapshot(context.Background(), "")
+	t.NoError(err)
+	t.Equal(1, third.WalletCount)
+	t.NotEqual(first.ContentHash, third.ContentHash)
+
+	snapshots, err := s.svc.ListWalletSnapshots(context.Background())
+	t.NoError(err)
+	t.Len(snapshots, 3)
+	t.Equal(third.ID, snapshots[0].ID)
+}
+
+func (s *WalletsServiceTestSuite) TestWalletSnapshot_Errors() {
+	var export bytes.Buffer
+	err := s.svc.ExportWalletSnapshot(context.Background(), 42, enum.SnapshotFormatCSV, &export)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+
+	err = s.svc.ExportWalletSnapshot(context.Background(), 42, "parquet", &export)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+
+	_, err = s.svc.CreateWalletSnapshot(context.Background(), strings.Repeat("x", 201))
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+}
@@ -0,0 +1,116 @@
+package wallets_test
+
+import (
+	"context"
+	"time"
+
+	"wallets-service/internal/domain/dto"
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/wallets"
+)
+
+// newServiceWithFastWatch returns a service whose wallet change streams poll every few milliseconds.
+func (s *WalletsServiceTestSuite) newServiceWithFastWatch() wallets.Service {
+	cfg := s.cfg
+	cfg.WatchConfig.PollInterval = 10 * time.Millisecond
+	cfg.WatchConfig.BatchSize = 2
+	return wallets.NewService(s.logger, s.dbRepo, cfg, s.rdb)
+}
+
+// watchWalletChanges starts a stream in the background and returns its changes and a stop function.
+func (s *WalletsServiceTestSuite) watchWalletChanges(svc wallets.Service, verifiedOnly bool, fromSequence uint) (<-chan dto.WalletChange, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	changes := make(chan dto.WalletChange, 100)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+		s.NoError(svc.WatchWalletChanges(ctx, "", verifiedOnly, fromSequence, func(change dto.WalletChange) error {
+			changes <- change
+			return nil
+		}))
+	}()
+
+	return changes, func() {
+		cancel()
+		<-done
+	}
+}
+
+func (s *WalletsServiceTestSuite) nextWalletChange(changes <-chan dto.WalletChange) dto.WalletChange {
+	select {
+	case change := <-changes:
+		return change
+	case <-time.After(5 * time.Second):
+		s.FailNow("no wallet change received")
+		return dto.WalletChange{}
+	}
+}
+
+func (s *WalletsServiceTestSuite) TestWatchWalletChanges_SnapshotThenLive() {
+	t := s.Require()
+	svc := s.newServiceWithFastWatch()
+	for userID := uint(1); userID <= 3; userID++ {
+		s.mustAddVerifiedSolanaWallet(userID)
+	}
+	unverified, _ := mustGenerateSolanaKeypair(t)
+	_, err := svc.AddWallet(context.Background(), 4, unverified, enum.ProviderPhantom)
+	t.NoError(err)
+
+	changes, stop := s.watchWalletChanges(svc, true, 0)
+	defer stop()
+
+	// The snapshot is read in batches and leaves out the unverified wallet.
+	var snapshotSequence uint
+	for i := 0; i < 3; i++ {
+		change := s.nextWalletChange(changes)
+		t.Equal(enum.WalletChangeSnapshot, change.Type)
+		t.NotEqual(unverified, change.Wallet.Pubkey)
+		snapshotSequence = change.Sequence
+	}
+	end := s.nextWalletChange(changes)
+	t.Equal(enum.WalletChangeSnapshotEnd, end.Type)
+	t.Equal(snapshotSequence, end.Sequence)
+
+	pubkey, _ := s.mustAddVerifiedSolanaWallet(5)
+	verified := s.nextWalletChange(changes)
+	t.Equal(enum.WalletChangeVerified, verified.Type)
+	t.Equal(pubkey, verified.Wallet.Pubkey)
+	t.Equal(uint(5), verified.Wallet.UserID)
+	t.Greater(verified.Sequence, end.Sequence)
+
+	w, err := svc.GetWallet(context.Background(), 5)
+	t.NoError(err)
+	_, err = svc.UnlinkWallet(context.Background(), w.ID, 5)
+	t.NoError(err)
+	unlinked := s.nextWalletChange(changes)
+	t.Equal(enum.WalletChangeUnlinked, unlinked.Type)
+	t.Equal(pubkey, unlinked.Wallet.Pubkey)
+	t.Greater(unlinked.Sequence, verified.Sequence)
+}
+
+func (s *WalletsServiceTestSuite) TestWatchWalletChanges_Resume() {
+	t := s.Require()
+	svc := s.newServiceWithFastWatch()
+	s.mustAddVerifiedSolanaWallet(1)
+
+	changes, stop := s.watchWalletChanges(svc, false, 0)
+	snapshot := s.nextWalletChange(changes)
+	t.Equal(enum.WalletChangeSnapshot, snapshot.Type)
+	end := s.nextWalletChange(changes)
+	t.Equal(enum.WalletChangeSnapshotEnd, end.Type)
+	stop()
+
+	// Changes made while disconnected are sent after resuming, without a snapshot.
+	pubkey, _ := mustGenerateSolanaKeypair(t)
+	_, err := svc.AddWallet(context.Background(), 2, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+
+	changes, stop = s.watchWalletChanges(svc, false, end.Sequence)
+	defer stop()
+
+	added := s.nextWalletChange(changes)
+	t.Equal(enum.WalletChangeAdded, added.Type)
+	t.Equal(pubkey, added.Wallet.Pubkey)
+	t.Equal(enum.VerificationStatusUnverified, added.Wallet.VerificationStatus)
+}
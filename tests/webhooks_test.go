@@ -0,0 +1,226 @@
+package wallets_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/knstch/knstch-libs/svcerrs"
+
+	"wallets-service/internal/domain/enum"
+	"wallets-service/internal/webhooks"
+)
+
+// webhookReceiver records the deliveries it receives and answers with status and response.
+type webhookReceiver struct {
+	mu       sync.Mutex
+	status   int
+	response []byte
+	requests []receivedWebhook
+}
+
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, receivedWebhook{header: req.Header.Clone(), body: body})
+	w.WriteHeader(r.status)
+	_, _ = w.Write(r.response)
+}
+
+// newWebhookDispatcher returns a dispatcher that retries failed deliveries right away.
+func (s *WalletsServiceTestSuite) newWebhookDispatcher(maxAttempts int) *webhooks.Dispatcher {
+	cfg := s.cfg.WebhooksConfig
+	cfg.BatchSize = 100
+	cfg.Timeout = 5 * time.Second
+	cfg.MaxAttempts = maxAttempts
+	cfg.RetryMinBackoff = 0
+	cfg.RetryMaxBackoff = 0
+	return webhooks.NewDispatcher(s.logger, s.dbRepo, nil, cfg)
+}
+
+func (s *WalletsServiceTestSuite) TestWebhooks_SignedDelivery() {
+	t := s.Require()
+	receiver := &webhookReceiver{status: http.StatusNoContent}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	subscription, err := s.svc.CreateWebhookSubscription(context.Background(), server.URL, []string{"wallet.verified"}, "")
+	t.NoError(err)
+	t.NotEmpty(subscription.Secret)
+
+	s.mustAddVerifiedSolanaWallet(1)
+
+	sent, err := s.newWebhookDispatcher(3).DeliverDue(context.Background())
+	t.NoError(err)
+	t.Equal(1, sent)
+
+	// Only the subscribed event type is delivered.
+	t.Len(receiver.requests, 1)
+	got := receiver.requests[0]
+	timestamp, err := strconv.ParseInt(got.header.Get(webhooks.HeaderTimestamp), 10, 64)
+	t.NoError(err)
+	t.Equal(webhooks.Sign(subscription.Secret, timestamp, got.body), got.header.Get(webhooks.HeaderSignature))
+	t.NotEmpty(got.header.Get(webhooks.HeaderID))
+	t.Equal("application/json", got.header.Get("Content-Type"))
+
+	deliveries, err := s.svc.ListWebhookDeliveries(context.Background(), subscription.ID, "", 0)
+	t.NoError(err)
+	t.Len(deliveries, 1)
+	t.Equal(enum.WebhookDeliveryStatusDelivered, deliveries[0].Status)
+	t.Equal(http.StatusNoContent, deliveries[0].LastStatusCode)
+	t.NotNil(deliveries[0].DeliveredAt)
+
+	// Delivered events are not sent again.
+	sent, err = s.newWebhookDispatcher(3).DeliverDue(context.Background())
+	t.NoError(err)
+	t.Zero(sent)
+}
+
+func (s *WalletsServiceTestSuite) TestWebhooks_FailingEndpoint_DeadAfterMaxAttempts() {
+	t := s.Require()
+	receiver := &webhookReceiver{status: http.StatusInternalServerError}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	subscription, err := s.svc.CreateWebhookSubscription(context.Background(), server.URL, []string{"wallet.added"}, "secret")
+	t.NoError(err)
+
+	pubkey, _ := mustGenerateSolanaKeypair(t)
+	_, err = s.svc.AddWallet(context.Background(), 1, pubkey, enum.ProviderPhantom)
+	t.NoError(err)
+
+	dispatcher := s.newWebhookDispatcher(3)
+	for i := 0; i < 5; i++ {
+		_, err = dispatcher.DeliverDue(context.Background())
+		t.NoError(err)
+	}
+	t.Len(receiver.requests, 3)
+
+	dead, err := s.svc.ListWebhookDeliveries(context.Background(), subscription.ID, enum.WebhookDeliveryStatusDead, 0)
+	t.NoError(err)
+	t.Len(dead, 1)
+	t.Equal(3, dead[0].Attempts)
+	t.Equal(http.StatusInternalServerError, dead[0].LastStatusCode)
+	t.NotEmpty(dead[0].LastError)
+
+	// A replay brings dead deliveries back.
+	receiver.mu.Lock()
+	receiver.status = http.StatusOK
+	receiver.mu.Unlock()
+	scheduled, err := s.svc.ReplayWebhookSubscription(context.Background(), subscription.ID, time.Now().Add(-time.Hour))
+	t.NoError(err)
+	t.Equal(int64(1), scheduled)
+
+	_, err = dispatcher.DeliverDue(context.Background())
+	t.NoError(err)
+	t.Len(receiver.requests, 4)
+	t.Equal(receiver.requests[0].header.Get(webhooks.HeaderID), receiver.requests[3].header.Get(webhooks.HeaderID))
+
+	delivered, err := s.svc.ListWebhookDeliveries(context.Background(), subscription.ID, enum.WebhookDeliveryStatusDelivered, 0)
+	t.NoError(err)
+	t.Len(delivered, 1)
+}
+
+func (s *WalletsServiceTestSuite) TestWebhooks_FailingEndpoint_LastErrorSanitized() {
+	t := s.Require()
+	// A NUL byte and invalid UTF-8 can't be stored in a text column; the multi-byte runes cross the length cap.
+	receiver := &webhookReceiver{
+		status:   http.StatusBadGateway,
+		response: []byte("\x00bad\xff gateway " + strings.Repeat("é", 600)),
+	}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	subscription, err := s.svc.CreateWebhookSubscription(context.Background(), server.URL, []string{"wallet.added"}, "secret")
+	t.NoError(err)
+
+	for userID := uint(1); userID <= 2; userID++ {
+		pubkey, _ := mustGenerateSolanaKeypair(t)
+		_, err = s.svc.AddWallet(context.Background(), userID, pubkey, enum.ProviderPhantom)
+		t.NoError(err)
+	}
+
+	sent, err := s.newWebhookDispatcher(3).DeliverDue(context.Background())
+	t.NoError(err)
+	t.Equal(2, sent)
+
+	failed, err := s.svc.ListWebhookDeliveries(context.Background(), subscription.ID, enum.WebhookDeliveryStatusPending, 0)
+	t.NoError(err)
+	t.Len(failed, 2)
+	for _, delivery := range failed {
+		t.Equal(1, delivery.Attempts)
+		t.True(utf8.ValidString(delivery.LastError))
+		t.NotContains(delivery.LastError, "\x00")
+		t.LessOrEqual(len(delivery.LastError), 512)
+		t.Contains(delivery.LastError, "unexpected status 502: bad gateway é")
+	}
+}
+
+func (s *WalletsServiceTestSuite) TestWebhooks_Replay_SchedulesPastEvents() {
+	t := s.Require()
+	receiver := &webhookReceiver{status: http.StatusOK}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	// Events that happened before the subscription existed are only delivered by a replay.
+	s.mustAddVerifiedSolanaWallet(1)
+
+	subscription, err := s.svc.CreateWebhookSubscription(context.Background(), server.URL, []string{"wallet.added", "wallet.verified"}, "")
+	t.NoError(err)
+
+	sent, err := s.newWebhookDispatcher(3).DeliverDue(context.Background())
+	t.NoError(err)
+	t.Zero(sent)
+
+	scheduled, err := s.svc.ReplayWebhookSubscription(context.Background(), subscription.ID, time.Now().Add(-time.Hour))
+	t.NoError(err)
+	t.Equal(int64(2), scheduled)
+
+	scheduled, err = s.svc.ReplayWebhookSubscription(context.Background(), subscription.ID, time.Now().Add(time.Hour))
+	t.NoError(err)
+	t.Zero(scheduled)
+
+	sent, err = s.newWebhookDispatcher(3).DeliverDue(context.Background())
+	t.NoError(err)
+	t.Equal(2, sent)
+	t.Len(receiver.requests, 2)
+}
+
+func (s *WalletsServiceTestSuite) TestWebhooks_Subscriptions() {
+	t := s.Require()
+
+	_, err := s.svc.CreateWebhookSubscription(context.Background(), "ftp://example.com", []string{"wallet.added"}, "")
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+
+	_, err = s.svc.CreateWebhookSubscription(context.Background(), "https://example.com/hook", []string{"wallet.deleted"}, "")
+	requireSvcErrIs(s.T(), err, svcerrs.ErrInvalidData)
+
+	subscription, err := s.svc.CreateWebhookSubscription(context.Background(), "https://example.com/hook", []string{"wallet.added", "wallet.added"}, "")
+	t.NoError(err)
+	t.Equal([]string{"wallet.added"}, subscription.EventTypes)
+
+	subscriptions, err := s.svc.ListWebhookSubscriptions(context.Background())
+	t.NoError(err)
+	t.Len(subscriptions, 1)
+	t.Empty(subscriptions[0].Secret)
+
+	t.NoError(s.svc.DeleteWebhookSubscription(context.Background(), subscription.ID))
+	err = s.svc.DeleteWebhookSubscription(context.Background(), subscription.ID)
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+
+	_, err = s.svc.ReplayWebhookSubscription(context.Background(), subscription.ID, time.Now())
+	requireSvcErrIs(s.T(), err, svcerrs.ErrDataNotFound)
+}